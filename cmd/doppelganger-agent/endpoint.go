@@ -27,6 +27,10 @@ func housekeep() {
 	local.HousekeepCaches()
 
 	local.HousekeepStaging()
+
+	local.HousekeepTrash()
+
+	local.HousekeepVersions()
 }
 
 func housekeepRegularly(context context.Context) {
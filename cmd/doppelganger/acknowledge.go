@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
+)
+
+func acknowledgeMain(command *cobra.Command, arguments []string) error {
+
+	if len(arguments) != 1 {
+		return errors.New("a single session must be specified")
+	}
+	session := arguments[0]
+
+	var acknowledgement sessionpkg.HaltAcknowledgement
+	if acknowledgeConfiguration.propagate && acknowledgeConfiguration.reseed {
+		return errors.New("--propagate and --reseed both specified")
+	} else if acknowledgeConfiguration.propagate {
+		acknowledgement = sessionpkg.HaltAcknowledgement_HaltAcknowledgementPropagate
+	} else if acknowledgeConfiguration.reseed {
+		acknowledgement = sessionpkg.HaltAcknowledgement_HaltAcknowledgementReseed
+	} else {
+		return errors.New("one of --propagate or --reseed must be specified")
+	}
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	acknowledgeContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := sessionService.Acknowledge(acknowledgeContext)
+	if err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to invoke acknowledge")
+	}
+
+	request := &sessionsvcpkg.AcknowledgeRequest{
+		Session:         session,
+		Acknowledgement: acknowledgement,
+	}
+	if err := stream.Send(request); err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send acknowledge request")
+	}
+
+	statusLinePrinter := &cmd.StatusLinePrinter{}
+
+	for {
+		if response, err := stream.Recv(); err != nil {
+			statusLinePrinter.BreakIfNonEmpty()
+			return errors.Wrap(peelAwayRPCErrorLayer(err), "acknowledge failed")
+		} else if err = response.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid acknowledge response received")
+		} else if response.Message == "" {
+			statusLinePrinter.Clear()
+			return nil
+		} else if response.Message != "" {
+			statusLinePrinter.Print(response.Message)
+			if err := stream.Send(&sessionsvcpkg.AcknowledgeRequest{}); err != nil {
+				statusLinePrinter.BreakIfNonEmpty()
+				return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send message response")
+			}
+		}
+	}
+}
+
+var acknowledgeCommand = &cobra.Command{
+	Use:   "acknowledge <session>",
+	Short: "Authorizes a session halted on a root deletion or type change to continue",
+	Run:   cmd.Mainify(acknowledgeMain),
+}
+
+var acknowledgeConfiguration struct {
+	help      bool
+	propagate bool
+	reseed    bool
+}
+
+func init() {
+	flags := acknowledgeCommand.Flags()
+	flags.BoolVarP(&acknowledgeConfiguration.help, "help", "h", false, "Show help information")
+	flags.BoolVar(&acknowledgeConfiguration.propagate, "propagate", false, "Propagate the root deletion or type change to the other endpoint")
+	flags.BoolVar(&acknowledgeConfiguration.reseed, "reseed", false, "Restore the root from the endpoint where it is unchanged")
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/RokyErickson/doppelganger/cmd"
 	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
 	promptpkg "github.com/RokyErickson/doppelganger/pkg/prompt"
+	"github.com/RokyErickson/doppelganger/pkg/selection"
 	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
 	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
 	"github.com/RokyErickson/doppelganger/pkg/sync"
@@ -64,6 +67,40 @@ func createMain(command *cobra.Command, arguments []string) error {
 		}
 	}
 
+	var deletionMode, deletionModeAlpha, deletionModeBeta sync.DeletionMode
+	if createConfiguration.deletionMode != "" {
+		if err := deletionMode.UnmarshalText([]byte(createConfiguration.deletionMode)); err != nil {
+			return errors.Wrap(err, "unable to parse deletion mode")
+		}
+	}
+	if createConfiguration.deletionModeAlpha != "" {
+		if err := deletionModeAlpha.UnmarshalText([]byte(createConfiguration.deletionModeAlpha)); err != nil {
+			return errors.Wrap(err, "unable to parse deletion mode for alpha")
+		}
+	}
+	if createConfiguration.deletionModeBeta != "" {
+		if err := deletionModeBeta.UnmarshalText([]byte(createConfiguration.deletionModeBeta)); err != nil {
+			return errors.Wrap(err, "unable to parse deletion mode for beta")
+		}
+	}
+
+	var versioningMode, versioningModeAlpha, versioningModeBeta sync.VersioningMode
+	if createConfiguration.versioningMode != "" {
+		if err := versioningMode.UnmarshalText([]byte(createConfiguration.versioningMode)); err != nil {
+			return errors.Wrap(err, "unable to parse versioning mode")
+		}
+	}
+	if createConfiguration.versioningModeAlpha != "" {
+		if err := versioningModeAlpha.UnmarshalText([]byte(createConfiguration.versioningModeAlpha)); err != nil {
+			return errors.Wrap(err, "unable to parse versioning mode for alpha")
+		}
+	}
+	if createConfiguration.versioningModeBeta != "" {
+		if err := versioningModeBeta.UnmarshalText([]byte(createConfiguration.versioningModeBeta)); err != nil {
+			return errors.Wrap(err, "unable to parse versioning mode for beta")
+		}
+	}
+
 	var symbolicLinkMode sync.SymlinkMode
 	if createConfiguration.symbolicLinkMode != "" {
 		if err := symbolicLinkMode.UnmarshalText([]byte(createConfiguration.symbolicLinkMode)); err != nil {
@@ -71,6 +108,13 @@ func createMain(command *cobra.Command, arguments []string) error {
 		}
 	}
 
+	var permissionsMode sync.PermissionsMode
+	if createConfiguration.permissionsMode != "" {
+		if err := permissionsMode.UnmarshalText([]byte(createConfiguration.permissionsMode)); err != nil {
+			return errors.Wrap(err, "unable to parse permissions mode")
+		}
+	}
+
 	var watchMode, watchModeAlpha, watchModeBeta fs.WatchMode
 	if createConfiguration.watchMode != "" {
 		if err := watchMode.UnmarshalText([]byte(createConfiguration.watchMode)); err != nil {
@@ -94,6 +138,59 @@ func createMain(command *cobra.Command, arguments []string) error {
 		}
 	}
 
+	var massDeletionThresholdCount uint64
+	var massDeletionThresholdPercentage uint32
+	if createConfiguration.massDeletionThreshold != "" {
+		if c, p, err := sync.ParseDeletionThreshold(createConfiguration.massDeletionThreshold); err != nil {
+			return errors.Wrap(err, "unable to parse mass deletion threshold")
+		} else {
+			massDeletionThresholdCount = c
+			massDeletionThresholdPercentage = p
+		}
+	}
+
+	for _, include := range createConfiguration.includes {
+		if !sync.ValidIncludePattern(include) {
+			return errors.Errorf("invalid include pattern: %s", include)
+		}
+	}
+
+	var ignoreFilesMode sync.IgnoreFilesMode
+	if createConfiguration.ignoreFiles != "" {
+		if err := ignoreFilesMode.UnmarshalText([]byte(createConfiguration.ignoreFiles)); err != nil {
+			return errors.Wrap(err, "unable to parse ignore files mode")
+		}
+	}
+
+	var ignoreMaximumFileSize uint64
+	if createConfiguration.ignoreLargerThan != "" {
+		if s, err := humanize.ParseBytes(createConfiguration.ignoreLargerThan); err != nil {
+			return errors.Wrap(err, "unable to parse maximum file size")
+		} else {
+			ignoreMaximumFileSize = s
+		}
+	}
+
+	var ignoreMaximumFileAge uint64
+	if createConfiguration.ignoreOlderThan != "" {
+		if d, err := time.ParseDuration(createConfiguration.ignoreOlderThan); err != nil {
+			return errors.Wrap(err, "unable to parse maximum file age")
+		} else if d < time.Second {
+			return errors.New("maximum file age must be at least one second")
+		} else {
+			ignoreMaximumFileAge = uint64(d / time.Second)
+		}
+	}
+
+	var modificationTimesMode sync.ModificationTimesMode
+	if createConfiguration.preserveModificationTimes && createConfiguration.noPreserveModificationTimes {
+		return errors.New("conflicting modification time preservation behavior specified")
+	} else if createConfiguration.preserveModificationTimes {
+		modificationTimesMode = sync.ModificationTimesMode_PreserveModificationTimes
+	} else if createConfiguration.noPreserveModificationTimes {
+		modificationTimesMode = sync.ModificationTimesMode_DiscardModificationTimes
+	}
+
 	var ignoreVCSMode sync.IgnoreVCSMode
 	if createConfiguration.ignoreVCS && createConfiguration.noIgnoreVCS {
 		return errors.New("conflicting VCS ignore behavior specified")
@@ -193,6 +290,30 @@ func createMain(command *cobra.Command, arguments []string) error {
 		}
 	}
 
+	if createConfiguration.name != "" {
+		if err := sessionpkg.EnsureNameValid(createConfiguration.name); err != nil {
+			return errors.Wrap(err, "invalid session name")
+		}
+	}
+
+	var labels map[string]string
+	if len(createConfiguration.labels) > 0 {
+		labels = make(map[string]string, len(createConfiguration.labels))
+		for _, label := range createConfiguration.labels {
+			components := strings.SplitN(label, "=", 2)
+			key, value := components[0], ""
+			if len(components) == 2 {
+				value = components[1]
+			}
+			if err := selection.EnsureLabelKeyValid(key); err != nil {
+				return errors.Wrap(err, "invalid label key")
+			} else if err := selection.EnsureLabelValueValid(value); err != nil {
+				return errors.Wrap(err, "invalid label value")
+			}
+			labels[key] = value
+		}
+	}
+
 	daemonConnection, err := createDaemonClientConnection()
 	if err != nil {
 		return errors.Wrap(err, "unable to connect to daemon")
@@ -201,31 +322,39 @@ func createMain(command *cobra.Command, arguments []string) error {
 
 	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
 
-	createContext, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	stream, err := sessionService.Create(createContext)
-	if err != nil {
-		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to invoke create")
-	}
-
 	request := &sessionsvcpkg.CreateRequest{
-		Alpha: alpha,
-		Beta:  beta,
+		Alpha:  alpha,
+		Beta:   beta,
+		Name:   createConfiguration.name,
+		Labels: labels,
 		Configuration: &sessionpkg.Configuration{
-			SynchronizationMode:    synchronizationMode,
-			MaximumEntryCount:      createConfiguration.maximumEntryCount,
-			MaximumStagingFileSize: maximumStagingFileSize,
-			SymlinkMode:            symbolicLinkMode,
-			WatchMode:              watchMode,
-			WatchPollingInterval:   createConfiguration.watchPollingInterval,
-			Ignores:                createConfiguration.ignores,
-			IgnoreVCSMode:          ignoreVCSMode,
-			DefaultFileMode:        defaultFileMode,
-			DefaultDirectoryMode:   defaultDirectoryMode,
-			DefaultOwner:           createConfiguration.defaultOwner,
-			DefaultGroup:           createConfiguration.defaultGroup,
+			SynchronizationMode:             synchronizationMode,
+			MaximumEntryCount:               createConfiguration.maximumEntryCount,
+			MaximumStagingFileSize:          maximumStagingFileSize,
+			DeletionMode:                    deletionMode,
+			VersioningMode:                  versioningMode,
+			MassDeletionThresholdCount:      massDeletionThresholdCount,
+			MassDeletionThresholdPercentage: massDeletionThresholdPercentage,
+			ModificationTimesMode:           modificationTimesMode,
+			ExtendedAttributeNamespaces:     createConfiguration.extendedAttributes,
+			SymlinkMode:                     symbolicLinkMode,
+			WatchMode:                       watchMode,
+			WatchPollingInterval:            createConfiguration.watchPollingInterval,
+			Ignores:                         createConfiguration.ignores,
+			Includes:                        createConfiguration.includes,
+			IgnoreFilesMode:                 ignoreFilesMode,
+			IgnoreMaximumFileSize:           ignoreMaximumFileSize,
+			IgnoreMaximumFileAge:            ignoreMaximumFileAge,
+			IgnoreVCSMode:                   ignoreVCSMode,
+			PermissionsMode:                 permissionsMode,
+			DefaultFileMode:                 defaultFileMode,
+			DefaultDirectoryMode:            defaultDirectoryMode,
+			DefaultOwner:                    createConfiguration.defaultOwner,
+			DefaultGroup:                    createConfiguration.defaultGroup,
 		},
 		ConfigurationAlpha: &sessionpkg.Configuration{
+			DeletionMode:         deletionModeAlpha,
+			VersioningMode:       versioningModeAlpha,
 			WatchMode:            watchModeAlpha,
 			WatchPollingInterval: createConfiguration.watchPollingIntervalAlpha,
 			DefaultFileMode:      defaultFileModeAlpha,
@@ -234,6 +363,8 @@ func createMain(command *cobra.Command, arguments []string) error {
 			DefaultGroup:         createConfiguration.defaultGroupAlpha,
 		},
 		ConfigurationBeta: &sessionpkg.Configuration{
+			DeletionMode:         deletionModeBeta,
+			VersioningMode:       versioningModeBeta,
 			WatchMode:            watchModeBeta,
 			WatchPollingInterval: createConfiguration.watchPollingIntervalBeta,
 			DefaultFileMode:      defaultFileModeBeta,
@@ -242,8 +373,20 @@ func createMain(command *cobra.Command, arguments []string) error {
 			DefaultGroup:         createConfiguration.defaultGroupBeta,
 		},
 	}
+	_, err = createSession(sessionService, request)
+	return err
+}
+
+func createSession(sessionService sessionsvcpkg.SessionsClient, request *sessionsvcpkg.CreateRequest) (string, error) {
+	createContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := sessionService.Create(createContext)
+	if err != nil {
+		return "", errors.Wrap(peelAwayRPCErrorLayer(err), "unable to invoke create")
+	}
+
 	if err := stream.Send(request); err != nil {
-		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send create request")
+		return "", errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send create request")
 	}
 
 	statusLinePrinter := &cmd.StatusLinePrinter{}
@@ -251,23 +394,23 @@ func createMain(command *cobra.Command, arguments []string) error {
 
 	for {
 		if response, err := stream.Recv(); err != nil {
-			return errors.Wrap(peelAwayRPCErrorLayer(err), "create failed")
+			return "", errors.Wrap(peelAwayRPCErrorLayer(err), "create failed")
 		} else if err = response.EnsureValid(); err != nil {
-			return errors.Wrap(err, "invalid create response received")
+			return "", errors.Wrap(err, "invalid create response received")
 		} else if response.Session != "" {
 			statusLinePrinter.Print(fmt.Sprintf("Created session %s", response.Session))
-			return nil
+			return response.Session, nil
 		} else if response.Message != "" {
 			statusLinePrinter.Print(response.Message)
 			if err := stream.Send(&sessionsvcpkg.CreateRequest{}); err != nil {
-				return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send message response")
+				return "", errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send message response")
 			}
 		} else if response.Prompt != "" {
 			statusLinePrinter.BreakIfNonEmpty()
 			if response, err := promptpkg.PromptCommandLine(response.Prompt); err != nil {
-				return errors.Wrap(err, "unable to perform prompting")
+				return "", errors.Wrap(err, "unable to perform prompting")
 			} else if err = stream.Send(&sessionsvcpkg.CreateRequest{Response: response}); err != nil {
-				return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send prompt response")
+				return "", errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send prompt response")
 			}
 		}
 	}
@@ -280,32 +423,49 @@ var createCommand = &cobra.Command{
 }
 
 var createConfiguration struct {
-	help                      bool
-	synchronizationMode       string
-	maximumEntryCount         uint64
-	maximumStagingFileSize    string
-	symbolicLinkMode          string
-	watchMode                 string
-	watchModeAlpha            string
-	watchModeBeta             string
-	watchPollingInterval      uint32
-	watchPollingIntervalAlpha uint32
-	watchPollingIntervalBeta  uint32
-	ignores                   []string
-	ignoreVCS                 bool
-	noIgnoreVCS               bool
-	defaultFileMode           string
-	defaultFileModeAlpha      string
-	defaultFileModeBeta       string
-	defaultDirectoryMode      string
-	defaultDirectoryModeAlpha string
-	defaultDirectoryModeBeta  string
-	defaultOwner              string
-	defaultOwnerAlpha         string
-	defaultOwnerBeta          string
-	defaultGroup              string
-	defaultGroupAlpha         string
-	defaultGroupBeta          string
+	help                        bool
+	synchronizationMode         string
+	maximumEntryCount           uint64
+	maximumStagingFileSize      string
+	deletionMode                string
+	deletionModeAlpha           string
+	deletionModeBeta            string
+	versioningMode              string
+	versioningModeAlpha         string
+	versioningModeBeta          string
+	massDeletionThreshold       string
+	preserveModificationTimes   bool
+	noPreserveModificationTimes bool
+	extendedAttributes          []string
+	symbolicLinkMode            string
+	watchMode                   string
+	watchModeAlpha              string
+	watchModeBeta               string
+	watchPollingInterval        uint32
+	watchPollingIntervalAlpha   uint32
+	watchPollingIntervalBeta    uint32
+	ignores                     []string
+	includes                    []string
+	ignoreFiles                 string
+	ignoreLargerThan            string
+	ignoreOlderThan             string
+	ignoreVCS                   bool
+	noIgnoreVCS                 bool
+	permissionsMode             string
+	defaultFileMode             string
+	defaultFileModeAlpha        string
+	defaultFileModeBeta         string
+	defaultDirectoryMode        string
+	defaultDirectoryModeAlpha   string
+	defaultDirectoryModeBeta    string
+	defaultOwner                string
+	defaultOwnerAlpha           string
+	defaultOwnerBeta            string
+	defaultGroup                string
+	defaultGroupAlpha           string
+	defaultGroupBeta            string
+	name                        string
+	labels                      []string
 }
 
 func init() {
@@ -313,11 +473,28 @@ func init() {
 
 	flags.BoolVarP(&createConfiguration.help, "help", "h", false, "Show help information")
 
-	flags.StringVarP(&createConfiguration.synchronizationMode, "sync-mode", "m", "", "Specify synchronization mode (two-way-safe|two-way-resolved|one-way-safe|one-way-replica)")
+	flags.StringVarP(&createConfiguration.name, "name", "n", "", "Specify a unique name for the session")
+	flags.StringSliceVar(&createConfiguration.labels, "label", nil, "Specify labels for the session (key=value)")
+
+	flags.StringVarP(&createConfiguration.synchronizationMode, "sync-mode", "m", "", "Specify synchronization mode (two-way-safe|two-way-resolved|two-way-preserve|one-way-safe|one-way-replica)")
 	flags.Uint64Var(&createConfiguration.maximumEntryCount, "max-entry-count", 0, "Specify the maximum number of entries that endpoints will manage")
 	flags.StringVar(&createConfiguration.maximumStagingFileSize, "max-staging-file-size", "", "Specify the maximum (individual) file size that endpoints will stage")
 
-	flags.StringVar(&createConfiguration.symbolicLinkMode, "symlink-mode", "", "Specify symlink mode (ignore|portable|posix-raw)")
+	flags.StringVar(&createConfiguration.deletionMode, "deletion-mode", "", "Specify deletion mode (permanent|trash)")
+	flags.StringVar(&createConfiguration.deletionModeAlpha, "deletion-mode-alpha", "", "Specify deletion mode for alpha (permanent|trash)")
+	flags.StringVar(&createConfiguration.deletionModeBeta, "deletion-mode-beta", "", "Specify deletion mode for beta (permanent|trash)")
+
+	flags.StringVar(&createConfiguration.versioningMode, "versioning", "", "Specify file versioning mode (disabled|staggered)")
+	flags.StringVar(&createConfiguration.versioningModeAlpha, "versioning-alpha", "", "Specify file versioning mode for alpha (disabled|staggered)")
+	flags.StringVar(&createConfiguration.versioningModeBeta, "versioning-beta", "", "Specify file versioning mode for beta (disabled|staggered)")
+
+	flags.StringVar(&createConfiguration.massDeletionThreshold, "mass-deletion-threshold", "", "Halt synchronization cycles that would delete more than the specified number (or percentage, e.g. 25%) of entries")
+
+	flags.BoolVar(&createConfiguration.preserveModificationTimes, "preserve-modification-times", false, "Preserve file modification times when propagating content")
+	flags.BoolVar(&createConfiguration.noPreserveModificationTimes, "no-preserve-modification-times", false, "Don't preserve file modification times when propagating content")
+	flags.StringSliceVar(&createConfiguration.extendedAttributes, "extended-attributes", nil, "Synchronize extended attributes in the specified namespaces (e.g. user,security,system.posix_acl_access)")
+
+	flags.StringVar(&createConfiguration.symbolicLinkMode, "symlink-mode", "", "Specify symlink mode (ignore|portable|posix-raw|follow|follow-within-root)")
 
 	flags.StringVar(&createConfiguration.watchMode, "watch-mode", "", "Specify watch mode (portable|force-poll|no-watch)")
 	flags.StringVar(&createConfiguration.watchModeAlpha, "watch-mode-alpha", "", "Specify watch mode for alpha (portable|force-poll|no-watch)")
@@ -327,9 +504,14 @@ func init() {
 	flags.Uint32Var(&createConfiguration.watchPollingIntervalBeta, "watch-polling-interval-beta", 0, "Specify watch polling interval in seconds for beta")
 
 	flags.StringSliceVarP(&createConfiguration.ignores, "ignore", "i", nil, "Specify ignore paths")
+	flags.StringSliceVar(&createConfiguration.includes, "include", nil, "Specify include paths (only matching paths are synchronized)")
+	flags.StringVar(&createConfiguration.ignoreFiles, "ignore-files", "", "Specify in-tree ignore files to read (disabled|doppelganger|git)")
+	flags.StringVar(&createConfiguration.ignoreLargerThan, "ignore-larger-than", "", "Ignore files larger than the specified size")
+	flags.StringVar(&createConfiguration.ignoreOlderThan, "ignore-older-than", "", "Ignore files not modified within the specified duration (e.g. 720h)")
 	flags.BoolVar(&createConfiguration.ignoreVCS, "ignore-vcs", false, "Ignore VCS directories")
 	flags.BoolVar(&createConfiguration.noIgnoreVCS, "no-ignore-vcs", false, "Propagate VCS directories")
 
+	flags.StringVar(&createConfiguration.permissionsMode, "permissions-mode", "", "Specify permissions mode (portable|preserve|preserve-numeric|preserve-named)")
 	flags.StringVar(&createConfiguration.defaultFileMode, "default-file-mode", "", "Specify default file permission mode")
 	flags.StringVar(&createConfiguration.defaultFileModeAlpha, "default-file-mode-alpha", "", "Specify default file permission mode for alpha")
 	flags.StringVar(&createConfiguration.defaultFileModeBeta, "default-file-mode-beta", "", "Specify default file permission mode for beta")
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+	"net"
 	"os"
 	"os/signal"
 
@@ -11,6 +13,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/RokyErickson/doppelganger/cmd"
+	"github.com/RokyErickson/doppelganger/pkg/configuration"
 	"github.com/RokyErickson/doppelganger/pkg/daemon"
 	mgrpc "github.com/RokyErickson/doppelganger/pkg/grpc"
 	daemonsvc "github.com/RokyErickson/doppelganger/pkg/service/daemon"
@@ -49,6 +52,9 @@ func daemonRunMain(command *cobra.Command, arguments []string) error {
 	}
 	sessionsvc.RegisterSessionsServer(server, sessionsServer)
 	defer sessionsServer.Shutdown()
+	for _, err := range sessionsServer.LoadErrors() {
+		cmd.Warning(err.Error())
+	}
 
 	listener, err := daemon.NewListener()
 	if err != nil {
@@ -61,6 +67,28 @@ func daemonRunMain(command *cobra.Command, arguments []string) error {
 		serverErrors <- server.Serve(listener)
 	}()
 
+	metricsAddress := daemonRunConfiguration.metricsAddress
+	if metricsAddress == "" {
+		if globalConfiguration, err := configuration.Load(); err != nil {
+			cmd.Warning(fmt.Sprintf("unable to load global configuration, metrics disabled: %v", err))
+		} else {
+			metricsAddress = globalConfiguration.Daemon.MetricsAddress
+		}
+	}
+
+	metricsErrors := make(chan error, 1)
+	if metricsAddress != "" {
+		metricsListener, err := net.Listen("tcp", metricsAddress)
+		if err != nil {
+			return errors.Wrap(err, "unable to create metrics listener")
+		}
+		defer metricsListener.Close()
+
+		go func() {
+			metricsErrors <- sessionsServer.ServeMetrics(metricsListener)
+		}()
+	}
+
 	select {
 	case sig := <-signalTermination:
 		return errors.Errorf("terminated by signal: %s", sig)
@@ -68,6 +96,8 @@ func daemonRunMain(command *cobra.Command, arguments []string) error {
 		return nil
 	case err = <-serverErrors:
 		return errors.Wrap(err, "premature server termination")
+	case err = <-metricsErrors:
+		return errors.Wrap(err, "premature metrics server termination")
 	}
 }
 
@@ -79,10 +109,12 @@ var daemonRunCommand = &cobra.Command{
 }
 
 var daemonRunConfiguration struct {
-	help bool
+	help           bool
+	metricsAddress string
 }
 
 func init() {
 	flags := daemonRunCommand.Flags()
 	flags.BoolVarP(&daemonRunConfiguration.help, "help", "h", false, "Show help information")
+	flags.StringVar(&daemonRunConfiguration.metricsAddress, "metrics-address", "", "Serve Prometheus metrics on the specified TCP address")
 }
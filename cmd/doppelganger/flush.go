@@ -16,9 +16,13 @@ func flushMain(command *cobra.Command, arguments []string) error {
 	if len(arguments) > 0 {
 		if flushConfiguration.all {
 			return errors.New("-a/--all specified with specific sessions")
+		} else if flushConfiguration.labelSelector != "" {
+			return errors.New("--label-selector specified with specific sessions")
 		}
 		specifications = arguments
-	} else if !flushConfiguration.all {
+	} else if flushConfiguration.all && flushConfiguration.labelSelector != "" {
+		return errors.New("-a/--all specified with --label-selector")
+	} else if !flushConfiguration.all && flushConfiguration.labelSelector == "" {
 		return errors.New("no sessions specified")
 	}
 
@@ -39,6 +43,7 @@ func flushMain(command *cobra.Command, arguments []string) error {
 
 	request := &sessionsvcpkg.FlushRequest{
 		Specifications: specifications,
+		LabelSelector:  flushConfiguration.labelSelector,
 		SkipWait:       flushConfiguration.skipWait,
 	}
 	if err := stream.Send(request); err != nil {
@@ -73,14 +78,16 @@ var flushCommand = &cobra.Command{
 }
 
 var flushConfiguration struct {
-	help     bool
-	all      bool
-	skipWait bool
+	help          bool
+	all           bool
+	skipWait      bool
+	labelSelector string
 }
 
 func init() {
 	flags := flushCommand.Flags()
 	flags.BoolVarP(&flushConfiguration.help, "help", "h", false, "Show help information")
 	flags.BoolVarP(&flushConfiguration.all, "all", "a", false, "Flush all sessions")
+	flags.StringVar(&flushConfiguration.labelSelector, "label-selector", "", "Flush sessions matching the specified label selector")
 	flags.BoolVar(&flushConfiguration.skipWait, "skip-wait", false, "Avoid waiting for the resulting synchronization cycle to complete")
 }
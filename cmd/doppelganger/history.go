@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/fatih/color"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+func printHistoryChanges(name string, changes []*sessionpkg.HistoryChange, bytesStaged uint64, problems []*sync.Problem) {
+	fmt.Printf("%s changes (%s staged):\n", name, humanize.Bytes(bytesStaged))
+	if len(changes) == 0 {
+		fmt.Println("\tNone")
+	}
+	for _, c := range changes {
+		fmt.Printf("\t%s %s\n", c.Kind.Description(), formatPath(c.Path))
+	}
+
+	if len(problems) > 0 {
+		color.Red("%s problems:\n", name)
+		for _, p := range problems {
+			color.Red("\t%s: %v\n", formatPath(p.Path), p.Error)
+		}
+	}
+}
+
+func printHistoryRecord(record *sessionpkg.HistoryRecord) {
+	startDescription := "<unknown>"
+	if t, err := ptypes.Timestamp(record.StartTime); err == nil {
+		startDescription = t.Local().Format(time.RFC3339)
+	}
+	endDescription := "<unknown>"
+	if t, err := ptypes.Timestamp(record.EndTime); err == nil {
+		endDescription = t.Local().Format(time.RFC3339)
+	}
+	fmt.Printf("Cycle: %s - %s\n", startDescription, endDescription)
+
+	printHistoryChanges("Alpha", record.AlphaChanges, record.AlphaBytesStaged, record.AlphaProblems)
+	printHistoryChanges("Beta", record.BetaChanges, record.BetaBytesStaged, record.BetaProblems)
+
+	if len(record.Conflicts) > 0 {
+		printConflicts(record.Conflicts)
+	}
+}
+
+func historyMain(command *cobra.Command, arguments []string) error {
+
+	if len(arguments) != 1 {
+		return errors.New("a single session must be specified")
+	}
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	request := &sessionsvcpkg.HistoryRequest{
+		Session: arguments[0],
+		Path:    historyConfiguration.path,
+	}
+	response, err := sessionService.History(context.Background(), request)
+	if err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "history retrieval failed")
+	} else if err = response.EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid history response received")
+	}
+
+	if len(response.Records) == 0 {
+		fmt.Println("No recorded synchronization cycles")
+		return nil
+	}
+
+	for _, record := range response.Records {
+		fmt.Println(delimiterLine)
+		printHistoryRecord(record)
+	}
+	fmt.Println(delimiterLine)
+
+	return nil
+}
+
+var historyCommand = &cobra.Command{
+	Use:   "history <session>",
+	Short: "Shows the changes applied by past synchronization cycles",
+	Run:   cmd.Mainify(historyMain),
+}
+
+var historyConfiguration struct {
+	help bool
+	path string
+}
+
+func init() {
+
+	flags := historyCommand.Flags()
+	flags.BoolVarP(&historyConfiguration.help, "help", "h", false, "Show help information")
+	flags.StringVar(&historyConfiguration.path, "path", "", "Only show changes to paths matching the specified glob pattern")
+}
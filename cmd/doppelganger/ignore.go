@@ -0,0 +1,33 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+)
+
+func ignoreMain(command *cobra.Command, arguments []string) error {
+
+	command.Help()
+	return nil
+}
+
+var ignoreCommand = &cobra.Command{
+	Use:   "ignore",
+	Short: "Inspects the ignore rules applied to synchronization sessions",
+	Run:   cmd.Mainify(ignoreMain),
+}
+
+var ignoreConfiguration struct {
+	help bool
+}
+
+func init() {
+
+	flags := ignoreCommand.Flags()
+	flags.BoolVarP(&ignoreConfiguration.help, "help", "h", false, "Show help information")
+
+	ignoreCommand.AddCommand(
+		ignoreCheckCommand,
+	)
+}
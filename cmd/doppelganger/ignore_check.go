@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+	urlpkg "github.com/RokyErickson/doppelganger/pkg/url"
+)
+
+func ignoreCheckMain(command *cobra.Command, arguments []string) error {
+
+	if len(arguments) < 2 {
+		return errors.New("session and at least one path must be specified")
+	}
+	session := arguments[0]
+	paths := arguments[1:]
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	request := &sessionsvcpkg.ListRequest{
+		Specifications: []string{session},
+	}
+	response, err := sessionService.List(context.Background(), request)
+	if err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "list failed")
+	} else if err = response.EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid list response received")
+	} else if len(response.SessionStates) != 1 {
+		return errors.New("invalid list response")
+	} else if err = response.SessionStates[0].EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid session state detected in response")
+	}
+
+	state := response.SessionStates[0]
+	endpoint, endpointConfiguration := state.Session.Alpha, state.Session.ConfigurationAlpha
+	if ignoreCheckConfiguration.beta {
+		endpoint, endpointConfiguration = state.Session.Beta, state.Session.ConfigurationBeta
+	}
+	configuration := sessionpkg.MergeConfigurations(state.Session.Configuration, endpointConfiguration)
+	options := sessionpkg.IgnoreScanOptions(state.Session.Version, configuration)
+
+	var root string
+	if endpoint.Protocol == urlpkg.Protocol_Local {
+		root = endpoint.Path
+	}
+
+	for _, path := range paths {
+		explanation, problems, err := sync.ExplainIgnored(root, path, ignoreCheckConfiguration.directory, options)
+		if err != nil {
+			return errors.Wrap(err, "unable to evaluate ignore rules")
+		}
+		for _, p := range problems {
+			cmd.Warning(fmt.Sprintf("%s: %s", p.Path, p.Error))
+		}
+
+		var subject string
+		if explanation.DecidingPath != explanation.Path {
+			subject = fmt.Sprintf("parent %s ", explanation.DecidingPath)
+		}
+
+		if explanation.NotIncluded {
+			fmt.Printf("%s: Ignored (%snot matched by any include pattern)\n", path, subject)
+		} else if explanation.Predicate != "" {
+			fmt.Printf("%s: Ignored (%s%s)\n", path, subject, explanation.Predicate)
+		} else if explanation.Ignored {
+			fmt.Printf("%s: Ignored (%smatched pattern \"%s\" from %s)\n", path, subject, explanation.Pattern, explanation.Source)
+		} else if explanation.Pattern != "" {
+			fmt.Printf("%s: Not ignored (matched pattern \"%s\" from %s)\n", path, explanation.Pattern, explanation.Source)
+		} else {
+			fmt.Printf("%s: Not ignored\n", path)
+		}
+	}
+
+	if root == "" && (len(options.IgnoreFileNames) > 0 || options.Predicates != nil) {
+		fmt.Println("Note: In-tree ignore files and ignore predicates are only evaluated for local endpoints")
+	}
+
+	return nil
+}
+
+var ignoreCheckCommand = &cobra.Command{
+	Use:   "check <session> <path>...",
+	Short: "Reports whether paths are ignored and which pattern decided the result",
+	Run:   cmd.Mainify(ignoreCheckMain),
+}
+
+var ignoreCheckConfiguration struct {
+	help      bool
+	directory bool
+	beta      bool
+}
+
+func init() {
+
+	flags := ignoreCheckCommand.Flags()
+	flags.BoolVarP(&ignoreCheckConfiguration.help, "help", "h", false, "Show help information")
+	flags.BoolVarP(&ignoreCheckConfiguration.directory, "directory", "d", false, "Treat the specified paths as directories if they don't exist")
+	flags.BoolVar(&ignoreCheckConfiguration.beta, "beta", false, "Evaluate paths against beta's configuration and contents instead of alpha's")
+}
@@ -31,9 +31,9 @@ func formatConnectionStatus(connected bool) string {
 	return "Disconnected"
 }
 
-func printEndpointStatus(name string, url *urlpkg.URL, connected bool, problems []*sync.Problem) {
+func printEndpointStatus(name string, url *urlpkg.URL, connected bool, problems []*sync.Problem, long bool) {
 	fmt.Printf("%s:\n", name)
-	if !listConfiguration.long {
+	if !long {
 		fmt.Println("\tURL:", url.Format("\n\t\t"))
 	}
 
@@ -108,6 +108,15 @@ func printConflicts(conflicts []*sync.Conflict) {
 
 func listMain(command *cobra.Command, arguments []string) error {
 
+	if len(arguments) > 0 && listConfiguration.labelSelector != "" {
+		return errors.New("--label-selector specified with specific sessions")
+	}
+
+	renderer, err := newStateRenderer(listConfiguration.output, listConfiguration.template)
+	if err != nil {
+		return err
+	}
+
 	daemonConnection, err := createDaemonClientConnection()
 	if err != nil {
 		return errors.Wrap(err, "unable to connect to daemon")
@@ -118,6 +127,7 @@ func listMain(command *cobra.Command, arguments []string) error {
 
 	request := &sessionsvcpkg.ListRequest{
 		Specifications: arguments,
+		LabelSelector:  listConfiguration.labelSelector,
 	}
 	response, err := sessionService.List(context.Background(), request)
 	if err != nil {
@@ -126,24 +136,32 @@ func listMain(command *cobra.Command, arguments []string) error {
 		return errors.Wrap(err, "invalid list response received")
 	}
 
-	for _, s := range response.SessionStates {
-		if err = s.EnsureValid(); err != nil {
+	return printSessionStates(response.SessionStates, renderer, listConfiguration.long)
+}
+
+func printSessionStates(states []*sessionpkg.State, renderer *stateRenderer, long bool) error {
+	for _, s := range states {
+		if err := s.EnsureValid(); err != nil {
 			return errors.Wrap(err, "invalid session state detected in response")
 		}
 	}
 
-	for _, state := range response.SessionStates {
+	if !renderer.human() {
+		return renderer.renderList(states)
+	}
+
+	for _, state := range states {
 		fmt.Println(delimiterLine)
-		printSession(state, listConfiguration.long)
-		printEndpointStatus("Alpha", state.Session.Alpha, state.AlphaConnected, state.AlphaProblems)
-		printEndpointStatus("Beta", state.Session.Beta, state.BetaConnected, state.BetaProblems)
+		printSession(state, long)
+		printEndpointStatus("Alpha", state.Session.Alpha, state.AlphaConnected, state.AlphaProblems, long)
+		printEndpointStatus("Beta", state.Session.Beta, state.BetaConnected, state.BetaProblems, long)
 		printSessionStatus(state)
 		if len(state.Conflicts) > 0 {
 			printConflicts(state.Conflicts)
 		}
 	}
 
-	if len(response.SessionStates) > 0 {
+	if len(states) > 0 {
 		fmt.Println(delimiterLine)
 	}
 
@@ -157,8 +175,11 @@ var listCommand = &cobra.Command{
 }
 
 var listConfiguration struct {
-	help bool
-	long bool
+	help          bool
+	long          bool
+	output        string
+	template      string
+	labelSelector string
 }
 
 func init() {
@@ -166,4 +187,7 @@ func init() {
 	flags := listCommand.Flags()
 	flags.BoolVarP(&listConfiguration.help, "help", "h", false, "Show help information")
 	flags.BoolVarP(&listConfiguration.long, "long", "l", false, "Show detailed session information")
+	flags.StringVar(&listConfiguration.labelSelector, "label-selector", "", "List sessions matching the specified label selector")
+	flags.StringVarP(&listConfiguration.output, "output", "o", "", "Specify machine-readable output format (json|jsonl)")
+	flags.StringVar(&listConfiguration.template, "template", "", "Format each session using the specified Go template")
 }
@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 
@@ -33,6 +36,18 @@ func printEndpoint(name string, url *urlpkg.URL, configuration *sessionpkg.Confi
 		fmt.Println("\tWatch polling interval:", watchPollingIntervalDescription)
 	}
 
+	deletionModeDescription := configuration.DeletionMode.Description()
+	if configuration.DeletionMode.IsDefault() {
+		deletionModeDescription += fmt.Sprintf(" (%s)", version.DefaultDeletionMode().Description())
+	}
+	fmt.Println("\tDeletion mode:", deletionModeDescription)
+
+	versioningModeDescription := configuration.VersioningMode.Description()
+	if configuration.VersioningMode.IsDefault() {
+		versioningModeDescription += fmt.Sprintf(" (%s)", version.DefaultVersioningMode().Description())
+	}
+	fmt.Println("\tVersioning mode:", versioningModeDescription)
+
 	var defaultFileModeDescription string
 	if configuration.DefaultFileMode == 0 {
 		defaultFileModeDescription = fmt.Sprintf("Default (%#o)", version.DefaultFileMode())
@@ -66,6 +81,22 @@ func printSession(state *sessionpkg.State, long bool) {
 
 	fmt.Println("Session:", state.Session.Identifier)
 
+	if state.Session.Name != "" {
+		fmt.Println("Name:", state.Session.Name)
+	}
+
+	if len(state.Session.Labels) > 0 {
+		keys := make([]string, 0, len(state.Session.Labels))
+		for key := range state.Session.Labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fmt.Println("Labels:")
+		for _, key := range keys {
+			fmt.Printf("\t%s: %s\n", key, state.Session.Labels[key])
+		}
+	}
+
 	if long {
 
 		fmt.Println("Configuration:")
@@ -95,6 +126,27 @@ func printSession(state *sessionpkg.State, long bool) {
 			)
 		}
 
+		if configuration.MassDeletionThresholdCount != 0 {
+			fmt.Println("\tMass deletion threshold:", configuration.MassDeletionThresholdCount)
+		} else if configuration.MassDeletionThresholdPercentage != 0 {
+			fmt.Printf("\tMass deletion threshold: %d%%\n", configuration.MassDeletionThresholdPercentage)
+		} else {
+			fmt.Println("\tMass deletion threshold: Disabled")
+		}
+
+		modificationTimesModeDescription := configuration.ModificationTimesMode.Description()
+		if configuration.ModificationTimesMode == sync.ModificationTimesMode_ModificationTimesDefault {
+			defaultModificationTimesMode := state.Session.Version.DefaultModificationTimesMode()
+			modificationTimesModeDescription += fmt.Sprintf(" (%s)", defaultModificationTimesMode.Description())
+		}
+		fmt.Println("\tModification times:", modificationTimesModeDescription)
+
+		if len(configuration.ExtendedAttributeNamespaces) > 0 {
+			fmt.Println("\tExtended attributes:", strings.Join(configuration.ExtendedAttributeNamespaces, ", "))
+		} else {
+			fmt.Println("\tExtended attributes: Not synchronized")
+		}
+
 		symlinkModeDescription := configuration.SymlinkMode.Description()
 		if configuration.SymlinkMode == sync.SymlinkMode_SymlinkDefault {
 			defaultSymlinkMode := state.Session.Version.DefaultSymlinkMode()
@@ -102,6 +154,13 @@ func printSession(state *sessionpkg.State, long bool) {
 		}
 		fmt.Println("\tSymbolic link mode:", symlinkModeDescription)
 
+		permissionsModeDescription := configuration.PermissionsMode.Description()
+		if configuration.PermissionsMode == sync.PermissionsMode_PermissionsDefault {
+			defaultPermissionsMode := state.Session.Version.DefaultPermissionsMode()
+			permissionsModeDescription += fmt.Sprintf(" (%s)", defaultPermissionsMode.Description())
+		}
+		fmt.Println("\tPermissions mode:", permissionsModeDescription)
+
 		ignoreVCSModeDescription := configuration.IgnoreVCSMode.Description()
 		if configuration.IgnoreVCSMode == sync.IgnoreVCSMode_IgnoreVCSDefault {
 			defaultIgnoreVCSMode := state.Session.Version.DefaultIgnoreVCSMode()
@@ -109,6 +168,28 @@ func printSession(state *sessionpkg.State, long bool) {
 		}
 		fmt.Println("\tIgnore VCS mode:", ignoreVCSModeDescription)
 
+		ignoreFilesModeDescription := configuration.IgnoreFilesMode.Description()
+		if configuration.IgnoreFilesMode == sync.IgnoreFilesMode_IgnoreFilesDefault {
+			defaultIgnoreFilesMode := state.Session.Version.DefaultIgnoreFilesMode()
+			ignoreFilesModeDescription += fmt.Sprintf(" (%s)", defaultIgnoreFilesMode.Description())
+		}
+		fmt.Println("\tIgnore files:", ignoreFilesModeDescription)
+
+		if configuration.IgnoreMaximumFileSize != 0 {
+			fmt.Printf(
+				"\tIgnore files larger than: %d (%s)\n",
+				configuration.IgnoreMaximumFileSize,
+				humanize.Bytes(configuration.IgnoreMaximumFileSize),
+			)
+		}
+
+		if configuration.IgnoreMaximumFileAge != 0 {
+			fmt.Println(
+				"\tIgnore files older than:",
+				time.Duration(configuration.IgnoreMaximumFileAge)*time.Second,
+			)
+		}
+
 		if len(configuration.DefaultIgnores) > 0 {
 			fmt.Println("\tDefault ignores:")
 			for _, p := range configuration.DefaultIgnores {
@@ -125,6 +206,15 @@ func printSession(state *sessionpkg.State, long bool) {
 			fmt.Println("\tIgnores: None")
 		}
 
+		if len(configuration.Includes) > 0 {
+			fmt.Println("\tIncludes:")
+			for _, p := range configuration.Includes {
+				fmt.Printf("\t\t%s\n", p)
+			}
+		} else {
+			fmt.Println("\tIncludes: All")
+		}
+
 		alphaConfigurationMerged := sessionpkg.MergeConfigurations(
 			state.Session.Configuration,
 			state.Session.ConfigurationAlpha,
@@ -46,6 +46,14 @@ func init() {
 		pauseCommand,
 		resumeCommand,
 		terminateCommand,
+		resolveCommand,
+		acknowledgeCommand,
+		planCommand,
+		historyCommand,
+		updateCommand,
+		versionsCommand,
+		ignoreCommand,
+		projectCommand,
 		daemonCommand,
 		versionCommand,
 		legalCommand,
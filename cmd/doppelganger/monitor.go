@@ -61,6 +61,11 @@ func monitorMain(command *cobra.Command, arguments []string) error {
 		return errors.New("multiple session specification not allowed")
 	}
 
+	renderer, err := newStateRenderer(monitorConfiguration.output, monitorConfiguration.template)
+	if err != nil {
+		return err
+	}
+
 	daemonConnection, err := createDaemonClientConnection()
 	if err != nil {
 		return errors.Wrap(err, "unable to connect to daemon")
@@ -112,6 +117,13 @@ func monitorMain(command *cobra.Command, arguments []string) error {
 			return err
 		}
 
+		if !renderer.human() {
+			if err := renderer.render(state); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if !sessionInformationPrinted {
 			printSession(state, monitorConfiguration.long)
 			if !monitorConfiguration.long {
@@ -135,12 +147,16 @@ var monitorCommand = &cobra.Command{
 }
 
 var monitorConfiguration struct {
-	help bool
-	long bool
+	help     bool
+	long     bool
+	output   string
+	template string
 }
 
 func init() {
 	flags := monitorCommand.Flags()
 	flags.BoolVarP(&monitorConfiguration.help, "help", "h", false, "Show help information")
 	flags.BoolVarP(&monitorConfiguration.long, "long", "l", false, "Show detailed session information")
+	flags.StringVarP(&monitorConfiguration.output, "output", "o", "", "Specify machine-readable output format (json|jsonl)")
+	flags.StringVar(&monitorConfiguration.template, "template", "", "Format each status update using the specified Go template")
 }
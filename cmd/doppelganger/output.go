@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
+)
+
+type outputFormat uint8
+
+const (
+	outputFormatHuman outputFormat = iota
+	outputFormatJSON
+	outputFormatJSONL
+	outputFormatTemplate
+)
+
+type stateRenderer struct {
+	format    outputFormat
+	marshaler *jsonpb.Marshaler
+	template  *template.Template
+}
+
+func newStateRenderer(output, templateText string) (*stateRenderer, error) {
+	if templateText != "" {
+		if output != "" {
+			return nil, errors.New("output format and template cannot both be specified")
+		}
+		parsed, err := template.New("output").Parse(templateText)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse output template")
+		}
+		return &stateRenderer{format: outputFormatTemplate, template: parsed}, nil
+	}
+
+	switch output {
+	case "":
+		return &stateRenderer{format: outputFormatHuman}, nil
+	case "json":
+		return &stateRenderer{
+			format:    outputFormatJSON,
+			marshaler: &jsonpb.Marshaler{EmitDefaults: true, OrigName: true, Indent: "  "},
+		}, nil
+	case "jsonl":
+		return &stateRenderer{
+			format:    outputFormatJSONL,
+			marshaler: &jsonpb.Marshaler{EmitDefaults: true, OrigName: true},
+		}, nil
+	default:
+		return nil, errors.Errorf("unknown output format: %s", output)
+	}
+}
+
+func (r *stateRenderer) human() bool {
+	return r.format == outputFormatHuman
+}
+
+func (r *stateRenderer) render(state *sessionpkg.State) error {
+	switch r.format {
+	case outputFormatJSON, outputFormatJSONL:
+		if err := r.marshaler.Marshal(os.Stdout, state); err != nil {
+			return errors.Wrap(err, "unable to encode session state")
+		}
+	case outputFormatTemplate:
+		if err := r.template.Execute(os.Stdout, state); err != nil {
+			return errors.Wrap(err, "unable to execute output template")
+		}
+	default:
+		panic("unsupported output format")
+	}
+
+	fmt.Println()
+
+	return nil
+}
+
+func (r *stateRenderer) renderList(states []*sessionpkg.State) error {
+	if r.format != outputFormatJSON {
+		for _, state := range states {
+			if err := r.render(state); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	fmt.Print("[")
+	for s, state := range states {
+		if s > 0 {
+			fmt.Print(",")
+		}
+		fmt.Println()
+		if err := r.marshaler.Marshal(os.Stdout, state); err != nil {
+			return errors.Wrap(err, "unable to encode session state")
+		}
+	}
+	if len(states) > 0 {
+		fmt.Println()
+	}
+	fmt.Println("]")
+
+	return nil
+}
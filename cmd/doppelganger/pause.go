@@ -17,9 +17,13 @@ func pauseMain(command *cobra.Command, arguments []string) error {
 	if len(arguments) > 0 {
 		if pauseConfiguration.all {
 			return errors.New("-a/--all specified with specific sessions")
+		} else if pauseConfiguration.labelSelector != "" {
+			return errors.New("--label-selector specified with specific sessions")
 		}
 		specifications = arguments
-	} else if !pauseConfiguration.all {
+	} else if pauseConfiguration.all && pauseConfiguration.labelSelector != "" {
+		return errors.New("-a/--all specified with --label-selector")
+	} else if !pauseConfiguration.all && pauseConfiguration.labelSelector == "" {
 		return errors.New("no sessions specified")
 	}
 
@@ -40,6 +44,7 @@ func pauseMain(command *cobra.Command, arguments []string) error {
 
 	request := &sessionsvcpkg.PauseRequest{
 		Specifications: specifications,
+		LabelSelector:  pauseConfiguration.labelSelector,
 	}
 	if err := stream.Send(request); err != nil {
 		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send pause request")
@@ -73,8 +78,9 @@ var pauseCommand = &cobra.Command{
 }
 
 var pauseConfiguration struct {
-	help bool
-	all  bool
+	help          bool
+	all           bool
+	labelSelector string
 }
 
 func init() {
@@ -82,4 +88,5 @@ func init() {
 	flags := pauseCommand.Flags()
 	flags.BoolVarP(&pauseConfiguration.help, "help", "h", false, "Show help information")
 	flags.BoolVarP(&pauseConfiguration.all, "all", "a", false, "Pause all sessions")
+	flags.StringVar(&pauseConfiguration.labelSelector, "label-selector", "", "Pause sessions matching the specified label selector")
 }
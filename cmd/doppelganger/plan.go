@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	promptpkg "github.com/RokyErickson/doppelganger/pkg/prompt"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+func printTransitions(name string, transitions []*sync.Change) {
+	fmt.Printf("%s changes:\n", name)
+	if len(transitions) == 0 {
+		fmt.Println("\tNone")
+		return
+	}
+	for _, t := range transitions {
+		fmt.Printf(
+			"\t%s (%s -> %s)\n",
+			formatPath(t.Path),
+			formatEntryKind(t.Old),
+			formatEntryKind(t.New),
+		)
+	}
+}
+
+func printPlan(plan *sessionpkg.Plan) {
+	printTransitions("Alpha", plan.AlphaTransitions)
+	printTransitions("Beta", plan.BetaTransitions)
+	if len(plan.Conflicts) > 0 {
+		printConflicts(plan.Conflicts)
+	}
+}
+
+func planMain(command *cobra.Command, arguments []string) error {
+
+	if len(arguments) != 1 {
+		return errors.New("a single session must be specified")
+	}
+	session := arguments[0]
+
+	var synchronizationMode sync.SynchronizationMode
+	if planConfiguration.synchronizationMode != "" {
+		if err := synchronizationMode.UnmarshalText([]byte(planConfiguration.synchronizationMode)); err != nil {
+			return errors.Wrap(err, "unable to parse synchronization mode")
+		}
+	}
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	planContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := sessionService.Plan(planContext)
+	if err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to invoke plan")
+	}
+
+	request := &sessionsvcpkg.PlanRequest{
+		Session:             session,
+		SynchronizationMode: synchronizationMode,
+	}
+	if err := stream.Send(request); err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send plan request")
+	}
+
+	statusLinePrinter := &cmd.StatusLinePrinter{}
+
+	for {
+		if response, err := stream.Recv(); err != nil {
+			statusLinePrinter.BreakIfNonEmpty()
+			return errors.Wrap(peelAwayRPCErrorLayer(err), "plan failed")
+		} else if err = response.EnsureValid(); err != nil {
+			statusLinePrinter.BreakIfNonEmpty()
+			return errors.Wrap(err, "invalid plan response received")
+		} else if response.Plan != nil {
+			statusLinePrinter.Clear()
+			printPlan(response.Plan)
+			return nil
+		} else if response.Message != "" {
+			statusLinePrinter.Print(response.Message)
+			if err := stream.Send(&sessionsvcpkg.PlanRequest{}); err != nil {
+				statusLinePrinter.BreakIfNonEmpty()
+				return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send message response")
+			}
+		} else if response.Prompt != "" {
+			statusLinePrinter.BreakIfNonEmpty()
+			if response, err := promptpkg.PromptCommandLine(response.Prompt); err != nil {
+				return errors.Wrap(err, "unable to perform prompting")
+			} else if err = stream.Send(&sessionsvcpkg.PlanRequest{Response: response}); err != nil {
+				return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send prompt response")
+			}
+		} else {
+			statusLinePrinter.BreakIfNonEmpty()
+			return errors.New("plan response missing plan")
+		}
+	}
+}
+
+var planCommand = &cobra.Command{
+	Use:   "plan <session>",
+	Short: "Shows the changes that resuming a paused session would apply",
+	Run:   cmd.Mainify(planMain),
+}
+
+var planConfiguration struct {
+	help                bool
+	synchronizationMode string
+}
+
+func init() {
+
+	flags := planCommand.Flags()
+	flags.BoolVarP(&planConfiguration.help, "help", "h", false, "Show help information")
+	flags.StringVarP(&planConfiguration.synchronizationMode, "sync-mode", "m", "", "Override the session's synchronization mode (two-way-safe|two-way-resolved|two-way-preserve|one-way-safe|one-way-replica)")
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
+	"github.com/RokyErickson/doppelganger/pkg/project"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
+	"github.com/RokyErickson/doppelganger/pkg/url"
+)
+
+func loadProject() (*project.Project, string, string, error) {
+	path := projectConfiguration.file
+	if path == "" {
+		path = project.DefaultFileName
+	}
+
+	loaded, err := project.Load(path)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	identifier, err := project.Identifier(path)
+	if err != nil {
+		return nil, "", "", errors.Wrap(err, "unable to compute project identifier")
+	}
+
+	directory, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, "", "", errors.Wrap(err, "unable to compute project directory")
+	}
+
+	return loaded, identifier, directory, nil
+}
+
+func parseProjectURL(raw string, alpha bool, directory string) (*url.URL, error) {
+	result, err := url.Parse(raw, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Protocol == url.Protocol_Local {
+		path := result.Path
+		if !filepath.IsAbs(path) && !strings.HasPrefix(path, "~") {
+			path = filepath.Join(directory, path)
+		}
+		if result.Path, err = fs.Normalize(path); err != nil {
+			return nil, errors.Wrap(err, "unable to normalize path")
+		}
+	}
+
+	return result, nil
+}
+
+func listProjectSessions(identifier string) ([]*sessionpkg.State, error) {
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	request := &sessionsvcpkg.ListRequest{
+		LabelSelector: fmt.Sprintf("%s=%s", project.ProjectLabelKey, identifier),
+	}
+	response, err := sessionService.List(context.Background(), request)
+	if err != nil {
+		return nil, errors.Wrap(peelAwayRPCErrorLayer(err), "list failed")
+	} else if err = response.EnsureValid(); err != nil {
+		return nil, errors.Wrap(err, "invalid list response received")
+	}
+
+	return response.SessionStates, nil
+}
+
+func projectSessionIdentifiers() ([]string, error) {
+	_, identifier, _, err := loadProject()
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := listProjectSessions(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := make([]string, len(states))
+	for s, state := range states {
+		identifiers[s] = state.Session.Identifier
+	}
+
+	return identifiers, nil
+}
+
+func projectMain(command *cobra.Command, arguments []string) error {
+
+	command.Help()
+	return nil
+}
+
+var projectCommand = &cobra.Command{
+	Use:   "project",
+	Short: "Manages the synchronization sessions declared in a project file",
+	Run:   cmd.Mainify(projectMain),
+}
+
+var projectConfiguration struct {
+	help bool
+	file string
+}
+
+func init() {
+
+	flags := projectCommand.Flags()
+	flags.BoolVarP(&projectConfiguration.help, "help", "h", false, "Show help information")
+
+	persistentFlags := projectCommand.PersistentFlags()
+	persistentFlags.StringVarP(&projectConfiguration.file, "project-file", "f", "", "Specify project file (defaults to "+project.DefaultFileName+")")
+
+	projectCommand.AddCommand(
+		projectStartCommand,
+		projectStopCommand,
+		projectPauseCommand,
+		projectResumeCommand,
+		projectListCommand,
+	)
+}
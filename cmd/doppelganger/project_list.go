@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+)
+
+func projectListMain(command *cobra.Command, arguments []string) error {
+	if len(arguments) != 0 {
+		return errors.New("unexpected arguments provided")
+	}
+
+	renderer, err := newStateRenderer(projectListConfiguration.output, projectListConfiguration.template)
+	if err != nil {
+		return err
+	}
+
+	_, identifier, _, err := loadProject()
+	if err != nil {
+		return err
+	}
+
+	states, err := listProjectSessions(identifier)
+	if err != nil {
+		return err
+	}
+
+	if len(states) == 0 && renderer.human() {
+		fmt.Println("No project sessions found")
+		return nil
+	}
+
+	return printSessionStates(states, renderer, projectListConfiguration.long)
+}
+
+var projectListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the sessions created from a project file",
+	Run:   cmd.Mainify(projectListMain),
+}
+
+var projectListConfiguration struct {
+	help     bool
+	long     bool
+	output   string
+	template string
+}
+
+func init() {
+	flags := projectListCommand.Flags()
+	flags.BoolVarP(&projectListConfiguration.help, "help", "h", false, "Show help information")
+	flags.BoolVarP(&projectListConfiguration.long, "long", "l", false, "Show detailed session information")
+	flags.StringVarP(&projectListConfiguration.output, "output", "o", "", "Specify machine-readable output format (json|jsonl)")
+	flags.StringVar(&projectListConfiguration.template, "template", "", "Format each session using the specified Go template")
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+)
+
+func projectPauseMain(command *cobra.Command, arguments []string) error {
+	if len(arguments) != 0 {
+		return errors.New("unexpected arguments provided")
+	}
+
+	identifiers, err := projectSessionIdentifiers()
+	if err != nil {
+		return err
+	}
+
+	if len(identifiers) == 0 {
+		fmt.Println("No project sessions found")
+		return nil
+	}
+
+	return pauseMain(command, identifiers)
+}
+
+var projectPauseCommand = &cobra.Command{
+	Use:   "pause",
+	Short: "Pauses the sessions created from a project file",
+	Run:   cmd.Mainify(projectPauseMain),
+}
+
+var projectPauseConfiguration struct {
+	help bool
+}
+
+func init() {
+	flags := projectPauseCommand.Flags()
+	flags.BoolVarP(&projectPauseConfiguration.help, "help", "h", false, "Show help information")
+}
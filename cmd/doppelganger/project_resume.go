@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+)
+
+func projectResumeMain(command *cobra.Command, arguments []string) error {
+	if len(arguments) != 0 {
+		return errors.New("unexpected arguments provided")
+	}
+
+	identifiers, err := projectSessionIdentifiers()
+	if err != nil {
+		return err
+	}
+
+	if len(identifiers) == 0 {
+		fmt.Println("No project sessions found")
+		return nil
+	}
+
+	return resumeMain(command, identifiers)
+}
+
+var projectResumeCommand = &cobra.Command{
+	Use:   "resume",
+	Short: "Resumes the sessions created from a project file",
+	Run:   cmd.Mainify(projectResumeMain),
+}
+
+var projectResumeConfiguration struct {
+	help bool
+}
+
+func init() {
+	flags := projectResumeCommand.Flags()
+	flags.BoolVarP(&projectResumeConfiguration.help, "help", "h", false, "Show help information")
+}
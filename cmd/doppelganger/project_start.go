@@ -0,0 +1,94 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	"github.com/RokyErickson/doppelganger/pkg/project"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
+)
+
+func projectStartMain(command *cobra.Command, arguments []string) error {
+	if len(arguments) != 0 {
+		return errors.New("unexpected arguments provided")
+	}
+
+	loaded, identifier, directory, err := loadProject()
+	if err != nil {
+		return err
+	}
+
+	states, err := listProjectSessions(identifier)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]string, len(states))
+	for _, state := range states {
+		existing[state.Session.Labels[project.SessionLabelKey]] = state.Session.Identifier
+	}
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	var resumable []string
+	for _, name := range loaded.Names() {
+		if session, ok := existing[name]; ok {
+			resumable = append(resumable, session)
+			continue
+		}
+
+		definition := loaded.Sessions[name]
+		alpha, err := parseProjectURL(definition.Alpha, true, directory)
+		if err != nil {
+			return errors.Wrapf(err, "unable to parse alpha URL for session %s", name)
+		}
+		beta, err := parseProjectURL(definition.Beta, false, directory)
+		if err != nil {
+			return errors.Wrapf(err, "unable to parse beta URL for session %s", name)
+		}
+
+		request := &sessionsvcpkg.CreateRequest{
+			Alpha:              alpha,
+			Beta:               beta,
+			Configuration:      sessionpkg.ConfigurationFromTOML(&definition.Configuration),
+			ConfigurationAlpha: sessionpkg.ConfigurationFromTOML(&definition.ConfigurationAlpha),
+			ConfigurationBeta:  sessionpkg.ConfigurationFromTOML(&definition.ConfigurationBeta),
+			Labels: map[string]string{
+				project.ProjectLabelKey: identifier,
+				project.SessionLabelKey: name,
+			},
+		}
+		if _, err := createSession(sessionService, request); err != nil {
+			return errors.Wrapf(err, "unable to create session %s", name)
+		}
+	}
+
+	if len(resumable) > 0 {
+		return resumeMain(command, resumable)
+	}
+
+	return nil
+}
+
+var projectStartCommand = &cobra.Command{
+	Use:   "start",
+	Short: "Creates or resumes the sessions declared in a project file",
+	Run:   cmd.Mainify(projectStartMain),
+}
+
+var projectStartConfiguration struct {
+	help bool
+}
+
+func init() {
+	flags := projectStartCommand.Flags()
+	flags.BoolVarP(&projectStartConfiguration.help, "help", "h", false, "Show help information")
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+)
+
+func projectStopMain(command *cobra.Command, arguments []string) error {
+	if len(arguments) != 0 {
+		return errors.New("unexpected arguments provided")
+	}
+
+	identifiers, err := projectSessionIdentifiers()
+	if err != nil {
+		return err
+	}
+
+	if len(identifiers) == 0 {
+		fmt.Println("No project sessions found")
+		return nil
+	}
+
+	return terminateMain(command, identifiers)
+}
+
+var projectStopCommand = &cobra.Command{
+	Use:   "stop",
+	Short: "Terminates the sessions created from a project file",
+	Run:   cmd.Mainify(projectStopMain),
+}
+
+var projectStopConfiguration struct {
+	help bool
+}
+
+func init() {
+	flags := projectStopCommand.Flags()
+	flags.BoolVarP(&projectStopConfiguration.help, "help", "h", false, "Show help information")
+}
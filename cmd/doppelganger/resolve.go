@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+func resolveMain(command *cobra.Command, arguments []string) error {
+
+	if len(arguments) != 2 {
+		return errors.New("session and conflict path must be specified")
+	}
+	session := arguments[0]
+	path := arguments[1]
+
+	var winner sync.ConflictWinner
+	if resolveConfiguration.winner == "" {
+		return errors.New("no conflict winner specified")
+	} else if err := winner.UnmarshalText([]byte(resolveConfiguration.winner)); err != nil {
+		return errors.Wrap(err, "unable to parse conflict winner")
+	}
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	resolveContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := sessionService.Resolve(resolveContext)
+	if err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to invoke resolve")
+	}
+
+	request := &sessionsvcpkg.ResolveRequest{
+		Session: session,
+		Path:    path,
+		Winner:  winner,
+	}
+	if err := stream.Send(request); err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send resolve request")
+	}
+
+	statusLinePrinter := &cmd.StatusLinePrinter{}
+
+	for {
+		if response, err := stream.Recv(); err != nil {
+			statusLinePrinter.BreakIfNonEmpty()
+			return errors.Wrap(peelAwayRPCErrorLayer(err), "resolve failed")
+		} else if err = response.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid resolve response received")
+		} else if response.Message == "" {
+			statusLinePrinter.Clear()
+			return nil
+		} else if response.Message != "" {
+			statusLinePrinter.Print(response.Message)
+			if err := stream.Send(&sessionsvcpkg.ResolveRequest{}); err != nil {
+				statusLinePrinter.BreakIfNonEmpty()
+				return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send message response")
+			}
+		}
+	}
+}
+
+var resolveCommand = &cobra.Command{
+	Use:   "resolve <session> <path>",
+	Short: "Resolves a synchronization conflict in favor of one endpoint",
+	Run:   cmd.Mainify(resolveMain),
+}
+
+var resolveConfiguration struct {
+	help   bool
+	winner string
+}
+
+func init() {
+	flags := resolveCommand.Flags()
+	flags.BoolVarP(&resolveConfiguration.help, "help", "h", false, "Show help information")
+	flags.StringVar(&resolveConfiguration.winner, "winner", "", "Specify the endpoint whose contents should win the conflict (alpha|beta)")
+}
@@ -18,9 +18,13 @@ func resumeMain(command *cobra.Command, arguments []string) error {
 	if len(arguments) > 0 {
 		if resumeConfiguration.all {
 			return errors.New("-a/--all specified with specific sessions")
+		} else if resumeConfiguration.labelSelector != "" {
+			return errors.New("--label-selector specified with specific sessions")
 		}
 		specifications = arguments
-	} else if !resumeConfiguration.all {
+	} else if resumeConfiguration.all && resumeConfiguration.labelSelector != "" {
+		return errors.New("-a/--all specified with --label-selector")
+	} else if !resumeConfiguration.all && resumeConfiguration.labelSelector == "" {
 		return errors.New("no sessions specified")
 	}
 
@@ -41,6 +45,7 @@ func resumeMain(command *cobra.Command, arguments []string) error {
 
 	request := &sessionsvcpkg.ResumeRequest{
 		Specifications: specifications,
+		LabelSelector:  resumeConfiguration.labelSelector,
 	}
 	if err := stream.Send(request); err != nil {
 		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send resume request")
@@ -82,8 +87,9 @@ var resumeCommand = &cobra.Command{
 }
 
 var resumeConfiguration struct {
-	help bool
-	all  bool
+	help          bool
+	all           bool
+	labelSelector string
 }
 
 func init() {
@@ -91,4 +97,5 @@ func init() {
 	flags := resumeCommand.Flags()
 	flags.BoolVarP(&resumeConfiguration.help, "help", "h", false, "Show help information")
 	flags.BoolVarP(&resumeConfiguration.all, "all", "a", false, "Resume all sessions")
+	flags.StringVar(&resumeConfiguration.labelSelector, "label-selector", "", "Resume sessions matching the specified label selector")
 }
@@ -17,9 +17,13 @@ func terminateMain(command *cobra.Command, arguments []string) error {
 	if len(arguments) > 0 {
 		if terminateConfiguration.all {
 			return errors.New("-a/--all specified with specific sessions")
+		} else if terminateConfiguration.labelSelector != "" {
+			return errors.New("--label-selector specified with specific sessions")
 		}
 		specifications = arguments
-	} else if !terminateConfiguration.all {
+	} else if terminateConfiguration.all && terminateConfiguration.labelSelector != "" {
+		return errors.New("-a/--all specified with --label-selector")
+	} else if !terminateConfiguration.all && terminateConfiguration.labelSelector == "" {
 		return errors.New("no sessions specified")
 	}
 
@@ -40,6 +44,7 @@ func terminateMain(command *cobra.Command, arguments []string) error {
 
 	request := &sessionsvcpkg.TerminateRequest{
 		Specifications: specifications,
+		LabelSelector:  terminateConfiguration.labelSelector,
 	}
 	if err := stream.Send(request); err != nil {
 		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send terminate request")
@@ -73,12 +78,14 @@ var terminateCommand = &cobra.Command{
 }
 
 var terminateConfiguration struct {
-	help bool
-	all  bool
+	help          bool
+	all           bool
+	labelSelector string
 }
 
 func init() {
 	flags := terminateCommand.Flags()
 	flags.BoolVarP(&terminateConfiguration.help, "help", "h", false, "Show help information")
 	flags.BoolVarP(&terminateConfiguration.all, "all", "a", false, "Terminate all sessions")
+	flags.StringVar(&terminateConfiguration.labelSelector, "label-selector", "", "Terminate sessions matching the specified label selector")
 }
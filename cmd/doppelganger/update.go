@@ -0,0 +1,336 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
+	promptpkg "github.com/RokyErickson/doppelganger/pkg/prompt"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	sessionpkg "github.com/RokyErickson/doppelganger/pkg/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+func updateMain(command *cobra.Command, arguments []string) error {
+
+	if len(arguments) != 1 {
+		return errors.New("a single session must be specified")
+	}
+	session := arguments[0]
+
+	var watchMode, watchModeAlpha, watchModeBeta fs.WatchMode
+	if updateConfiguration.watchMode != "" {
+		if err := watchMode.UnmarshalText([]byte(updateConfiguration.watchMode)); err != nil {
+			return errors.Wrap(err, "unable to parse watch mode")
+		}
+	}
+	if updateConfiguration.watchModeAlpha != "" {
+		if err := watchModeAlpha.UnmarshalText([]byte(updateConfiguration.watchModeAlpha)); err != nil {
+			return errors.Wrap(err, "unable to parse watch mode for alpha")
+		}
+	}
+	if updateConfiguration.watchModeBeta != "" {
+		if err := watchModeBeta.UnmarshalText([]byte(updateConfiguration.watchModeBeta)); err != nil {
+			return errors.Wrap(err, "unable to parse watch mode for beta")
+		}
+	}
+
+	for _, ignore := range updateConfiguration.ignores {
+		if !sync.ValidIgnorePattern(ignore) {
+			return errors.Errorf("invalid ignore pattern: %s", ignore)
+		}
+	}
+
+	var massDeletionThresholdCount uint64
+	var massDeletionThresholdPercentage uint32
+	if updateConfiguration.massDeletionThreshold != "" {
+		if c, p, err := sync.ParseDeletionThreshold(updateConfiguration.massDeletionThreshold); err != nil {
+			return errors.Wrap(err, "unable to parse mass deletion threshold")
+		} else {
+			massDeletionThresholdCount = c
+			massDeletionThresholdPercentage = p
+		}
+	}
+
+	for _, include := range updateConfiguration.includes {
+		if !sync.ValidIncludePattern(include) {
+			return errors.Errorf("invalid include pattern: %s", include)
+		}
+	}
+
+	var ignoreFilesMode sync.IgnoreFilesMode
+	if updateConfiguration.ignoreFiles != "" {
+		if err := ignoreFilesMode.UnmarshalText([]byte(updateConfiguration.ignoreFiles)); err != nil {
+			return errors.Wrap(err, "unable to parse ignore files mode")
+		}
+	}
+
+	var ignoreMaximumFileSize uint64
+	if updateConfiguration.ignoreLargerThan != "" {
+		if s, err := humanize.ParseBytes(updateConfiguration.ignoreLargerThan); err != nil {
+			return errors.Wrap(err, "unable to parse maximum file size")
+		} else {
+			ignoreMaximumFileSize = s
+		}
+	}
+
+	var ignoreMaximumFileAge uint64
+	if updateConfiguration.ignoreOlderThan != "" {
+		if d, err := time.ParseDuration(updateConfiguration.ignoreOlderThan); err != nil {
+			return errors.Wrap(err, "unable to parse maximum file age")
+		} else if d < time.Second {
+			return errors.New("maximum file age must be at least one second")
+		} else {
+			ignoreMaximumFileAge = uint64(d / time.Second)
+		}
+	}
+
+	var ignoreVCSMode sync.IgnoreVCSMode
+	if updateConfiguration.ignoreVCS && updateConfiguration.noIgnoreVCS {
+		return errors.New("conflicting VCS ignore behavior specified")
+	} else if updateConfiguration.ignoreVCS {
+		ignoreVCSMode = sync.IgnoreVCSMode_IgnoreVCS
+	} else if updateConfiguration.noIgnoreVCS {
+		ignoreVCSMode = sync.IgnoreVCSMode_PropagateVCS
+	}
+
+	var defaultFileMode uint32
+	if updateConfiguration.defaultFileMode != "" {
+		if m, err := fs.ParseMode(updateConfiguration.defaultFileMode, fs.ModePermissionsMask); err != nil {
+			return errors.Wrap(err, "unable to parse default file mode")
+		} else if err = sync.EnsureDefaultFileModeValid(m); err != nil {
+			return errors.Wrap(err, "invalid default file mode")
+		} else {
+			defaultFileMode = uint32(m)
+		}
+	}
+
+	var defaultDirectoryMode uint32
+	if updateConfiguration.defaultDirectoryMode != "" {
+		if m, err := fs.ParseMode(updateConfiguration.defaultDirectoryMode, fs.ModePermissionsMask); err != nil {
+			return errors.Wrap(err, "unable to parse default directory mode")
+		} else if err = sync.EnsureDefaultDirectoryModeValid(m); err != nil {
+			return errors.Wrap(err, "invalid default directory mode")
+		} else {
+			defaultDirectoryMode = uint32(m)
+		}
+	}
+
+	var modificationTimesMode sync.ModificationTimesMode
+	if updateConfiguration.preserveModificationTimes && updateConfiguration.noPreserveModificationTimes {
+		return errors.New("conflicting modification time preservation behavior specified")
+	} else if updateConfiguration.preserveModificationTimes {
+		modificationTimesMode = sync.ModificationTimesMode_PreserveModificationTimes
+	} else if updateConfiguration.noPreserveModificationTimes {
+		modificationTimesMode = sync.ModificationTimesMode_DiscardModificationTimes
+	}
+
+	if updateConfiguration.defaultOwner != "" {
+		if kind, _ := fs.ParseOwnershipIdentifier(updateConfiguration.defaultOwner); kind == fs.OwnershipIdentifierKindInvalid {
+			return errors.New("invalid ownership specification")
+		}
+	}
+	if updateConfiguration.defaultGroup != "" {
+		if kind, _ := fs.ParseOwnershipIdentifier(updateConfiguration.defaultGroup); kind == fs.OwnershipIdentifierKindInvalid {
+			return errors.New("invalid group ownership specification")
+		}
+	}
+
+	fields := updatedFields(command, updateFields)
+	fieldsAlpha := updatedFields(command, updateFieldsAlpha)
+	fieldsBeta := updatedFields(command, updateFieldsBeta)
+	if len(fields) == 0 && len(fieldsAlpha) == 0 && len(fieldsBeta) == 0 {
+		return errors.New("no configuration changes specified")
+	}
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	updateContext, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := sessionService.Update(updateContext)
+	if err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to invoke update")
+	}
+
+	request := &sessionsvcpkg.UpdateRequest{
+		Session: session,
+		Configuration: &sessionpkg.Configuration{
+			MassDeletionThresholdCount:      massDeletionThresholdCount,
+			MassDeletionThresholdPercentage: massDeletionThresholdPercentage,
+			WatchMode:                       watchMode,
+			WatchPollingInterval:            updateConfiguration.watchPollingInterval,
+			Ignores:                         updateConfiguration.ignores,
+			Includes:                        updateConfiguration.includes,
+			IgnoreFilesMode:                 ignoreFilesMode,
+			IgnoreMaximumFileSize:           ignoreMaximumFileSize,
+			IgnoreMaximumFileAge:            ignoreMaximumFileAge,
+			IgnoreVCSMode:                   ignoreVCSMode,
+			ModificationTimesMode:           modificationTimesMode,
+			DefaultFileMode:                 defaultFileMode,
+			DefaultDirectoryMode:            defaultDirectoryMode,
+			DefaultOwner:                    updateConfiguration.defaultOwner,
+			DefaultGroup:                    updateConfiguration.defaultGroup,
+		},
+		ConfigurationAlpha: &sessionpkg.Configuration{
+			WatchMode:            watchModeAlpha,
+			WatchPollingInterval: updateConfiguration.watchPollingIntervalAlpha,
+		},
+		ConfigurationBeta: &sessionpkg.Configuration{
+			WatchMode:            watchModeBeta,
+			WatchPollingInterval: updateConfiguration.watchPollingIntervalBeta,
+		},
+		Fields:      fields,
+		FieldsAlpha: fieldsAlpha,
+		FieldsBeta:  fieldsBeta,
+	}
+	if err := stream.Send(request); err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send update request")
+	}
+
+	statusLinePrinter := &cmd.StatusLinePrinter{}
+
+	for {
+		if response, err := stream.Recv(); err != nil {
+			statusLinePrinter.BreakIfNonEmpty()
+			return errors.Wrap(peelAwayRPCErrorLayer(err), "update failed")
+		} else if err = response.EnsureValid(); err != nil {
+			statusLinePrinter.BreakIfNonEmpty()
+			return errors.Wrap(err, "invalid update response received")
+		} else if response.Message == "" && response.Prompt == "" {
+			statusLinePrinter.Clear()
+			return nil
+		} else if response.Message != "" {
+			statusLinePrinter.Print(response.Message)
+			if err := stream.Send(&sessionsvcpkg.UpdateRequest{}); err != nil {
+				statusLinePrinter.BreakIfNonEmpty()
+				return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send message response")
+			}
+		} else if response.Prompt != "" {
+			statusLinePrinter.BreakIfNonEmpty()
+			if response, err := promptpkg.PromptCommandLine(response.Prompt); err != nil {
+				return errors.Wrap(err, "unable to perform prompting")
+			} else if err = stream.Send(&sessionsvcpkg.UpdateRequest{Response: response}); err != nil {
+				return errors.Wrap(peelAwayRPCErrorLayer(err), "unable to send prompt response")
+			}
+		}
+	}
+}
+
+type updateField struct {
+	flag  string
+	field string
+}
+
+var updateFields = []updateField{
+	{"mass-deletion-threshold", "massDeletionThresholdCount"},
+	{"mass-deletion-threshold", "massDeletionThresholdPercentage"},
+	{"watch-mode", "watchMode"},
+	{"watch-polling-interval", "watchPollingInterval"},
+	{"ignore", "ignores"},
+	{"include", "includes"},
+	{"ignore-files", "ignoreFilesMode"},
+	{"ignore-larger-than", "ignoreMaximumFileSize"},
+	{"ignore-older-than", "ignoreMaximumFileAge"},
+	{"ignore-vcs", "ignoreVCSMode"},
+	{"no-ignore-vcs", "ignoreVCSMode"},
+	{"preserve-modification-times", "modificationTimesMode"},
+	{"no-preserve-modification-times", "modificationTimesMode"},
+	{"default-file-mode", "defaultFileMode"},
+	{"default-directory-mode", "defaultDirectoryMode"},
+	{"default-owner", "defaultOwner"},
+	{"default-group", "defaultGroup"},
+}
+
+var updateFieldsAlpha = []updateField{
+	{"watch-mode-alpha", "watchMode"},
+	{"watch-polling-interval-alpha", "watchPollingInterval"},
+}
+
+var updateFieldsBeta = []updateField{
+	{"watch-mode-beta", "watchMode"},
+	{"watch-polling-interval-beta", "watchPollingInterval"},
+}
+
+func updatedFields(command *cobra.Command, mapping []updateField) []string {
+	var fields []string
+	for _, m := range mapping {
+		if command.Flags().Changed(m.flag) {
+			fields = append(fields, m.field)
+		}
+	}
+	return fields
+}
+
+var updateCommand = &cobra.Command{
+	Use:   "update <session>",
+	Short: "Updates the configuration of an existing synchronization session",
+	Run:   cmd.Mainify(updateMain),
+}
+
+var updateConfiguration struct {
+	help                        bool
+	massDeletionThreshold       string
+	watchMode                   string
+	watchModeAlpha              string
+	watchModeBeta               string
+	watchPollingInterval        uint32
+	watchPollingIntervalAlpha   uint32
+	watchPollingIntervalBeta    uint32
+	ignores                     []string
+	includes                    []string
+	ignoreFiles                 string
+	ignoreLargerThan            string
+	ignoreOlderThan             string
+	ignoreVCS                   bool
+	noIgnoreVCS                 bool
+	preserveModificationTimes   bool
+	noPreserveModificationTimes bool
+	defaultFileMode             string
+	defaultDirectoryMode        string
+	defaultOwner                string
+	defaultGroup                string
+}
+
+func init() {
+	flags := updateCommand.Flags()
+
+	flags.BoolVarP(&updateConfiguration.help, "help", "h", false, "Show help information")
+
+	flags.StringVar(&updateConfiguration.massDeletionThreshold, "mass-deletion-threshold", "", "Halt synchronization cycles that would delete more than the specified number (or percentage, e.g. 25%) of entries")
+
+	flags.StringVar(&updateConfiguration.watchMode, "watch-mode", "", "Specify watch mode (portable|force-poll|no-watch)")
+	flags.StringVar(&updateConfiguration.watchModeAlpha, "watch-mode-alpha", "", "Specify watch mode for alpha (portable|force-poll|no-watch)")
+	flags.StringVar(&updateConfiguration.watchModeBeta, "watch-mode-beta", "", "Specify watch mode for beta (portable|force-poll|no-watch)")
+	flags.Uint32Var(&updateConfiguration.watchPollingInterval, "watch-polling-interval", 0, "Specify watch polling interval in seconds")
+	flags.Uint32Var(&updateConfiguration.watchPollingIntervalAlpha, "watch-polling-interval-alpha", 0, "Specify watch polling interval in seconds for alpha")
+	flags.Uint32Var(&updateConfiguration.watchPollingIntervalBeta, "watch-polling-interval-beta", 0, "Specify watch polling interval in seconds for beta")
+
+	flags.StringSliceVarP(&updateConfiguration.ignores, "ignore", "i", nil, "Replace the session's ignore paths (pass an empty value to clear)")
+	flags.StringSliceVar(&updateConfiguration.includes, "include", nil, "Replace the session's include paths (pass an empty value to clear)")
+	flags.StringVar(&updateConfiguration.ignoreFiles, "ignore-files", "", "Specify in-tree ignore files to read (disabled|doppelganger|git)")
+	flags.StringVar(&updateConfiguration.ignoreLargerThan, "ignore-larger-than", "", "Ignore files larger than the specified size")
+	flags.StringVar(&updateConfiguration.ignoreOlderThan, "ignore-older-than", "", "Ignore files not modified within the specified duration (e.g. 720h)")
+	flags.BoolVar(&updateConfiguration.ignoreVCS, "ignore-vcs", false, "Ignore VCS directories")
+	flags.BoolVar(&updateConfiguration.noIgnoreVCS, "no-ignore-vcs", false, "Propagate VCS directories")
+
+	flags.BoolVar(&updateConfiguration.preserveModificationTimes, "preserve-modification-times", false, "Preserve file modification times when propagating content")
+	flags.BoolVar(&updateConfiguration.noPreserveModificationTimes, "no-preserve-modification-times", false, "Don't preserve file modification times when propagating content")
+
+	flags.StringVar(&updateConfiguration.defaultFileMode, "default-file-mode", "", "Specify default file permission mode")
+	flags.StringVar(&updateConfiguration.defaultDirectoryMode, "default-directory-mode", "", "Specify default directory permission mode")
+	flags.StringVar(&updateConfiguration.defaultOwner, "default-owner", "", "Specify default file/directory owner")
+	flags.StringVar(&updateConfiguration.defaultGroup, "default-group", "", "Specify default file/directory group")
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+)
+
+func versionsMain(command *cobra.Command, arguments []string) error {
+
+	command.Help()
+	return nil
+}
+
+var versionsCommand = &cobra.Command{
+	Use:   "versions",
+	Short: "Lists and restores previous versions of synchronized files",
+	Run:   cmd.Mainify(versionsMain),
+}
+
+var versionsConfiguration struct {
+	help bool
+}
+
+func init() {
+
+	flags := versionsCommand.Flags()
+	flags.BoolVarP(&versionsConfiguration.help, "help", "h", false, "Show help information")
+
+	versionsCommand.AddCommand(
+		versionsListCommand,
+		versionsRestoreCommand,
+	)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+func printVersions(name string, versions []*sync.FileVersion) {
+	fmt.Printf("%s:\n", name)
+
+	if len(versions) == 0 {
+		fmt.Println("\tNo versions")
+		return
+	}
+
+	for _, v := range versions {
+		timeDescription := "<unknown>"
+		if t, err := ptypes.Timestamp(v.Time); err == nil {
+			timeDescription = t.Local().Format(time.RFC3339)
+		}
+		fmt.Printf("\t%s %x\n", timeDescription, v.Digest)
+	}
+}
+
+func versionsListMain(command *cobra.Command, arguments []string) error {
+
+	if len(arguments) != 2 {
+		return errors.New("session and path must be specified")
+	}
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	request := &sessionsvcpkg.ListVersionsRequest{
+		Session: arguments[0],
+		Path:    arguments[1],
+	}
+	response, err := sessionService.ListVersions(context.Background(), request)
+	if err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "version listing failed")
+	} else if err = response.EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid version listing response received")
+	}
+
+	printVersions("Alpha", response.AlphaVersions)
+	printVersions("Beta", response.BetaVersions)
+
+	return nil
+}
+
+var versionsListCommand = &cobra.Command{
+	Use:   "list <session> <path>",
+	Short: "Lists the stored versions of a synchronized file",
+	Run:   cmd.Mainify(versionsListMain),
+}
+
+var versionsListConfiguration struct {
+	help bool
+}
+
+func init() {
+
+	flags := versionsListCommand.Flags()
+	flags.BoolVarP(&versionsListConfiguration.help, "help", "h", false, "Show help information")
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RokyErickson/doppelganger/cmd"
+	sessionsvcpkg "github.com/RokyErickson/doppelganger/pkg/service/session"
+)
+
+func versionsRestoreMain(command *cobra.Command, arguments []string) error {
+
+	if len(arguments) != 2 {
+		return errors.New("session and path must be specified")
+	}
+
+	var beta bool
+	switch versionsRestoreConfiguration.endpoint {
+	case "alpha":
+	case "beta":
+		beta = true
+	default:
+		return errors.Errorf("unknown endpoint specification: %s", versionsRestoreConfiguration.endpoint)
+	}
+
+	daemonConnection, err := createDaemonClientConnection()
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to daemon")
+	}
+	defer daemonConnection.Close()
+
+	sessionService := sessionsvcpkg.NewSessionsClient(daemonConnection)
+
+	request := &sessionsvcpkg.RestoreVersionRequest{
+		Session: arguments[0],
+		Path:    arguments[1],
+		Beta:    beta,
+		Digest:  versionsRestoreConfiguration.version,
+	}
+	response, err := sessionService.RestoreVersion(context.Background(), request)
+	if err != nil {
+		return errors.Wrap(peelAwayRPCErrorLayer(err), "version restoration failed")
+	} else if err = response.EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid version restoration response received")
+	}
+
+	fmt.Printf("Restored version %x\n", response.Version.Digest)
+
+	return nil
+}
+
+var versionsRestoreCommand = &cobra.Command{
+	Use:   "restore <session> <path>",
+	Short: "Restores a stored version of a synchronized file",
+	Run:   cmd.Mainify(versionsRestoreMain),
+}
+
+var versionsRestoreConfiguration struct {
+	help     bool
+	endpoint string
+	version  string
+}
+
+func init() {
+
+	flags := versionsRestoreCommand.Flags()
+	flags.BoolVarP(&versionsRestoreConfiguration.help, "help", "h", false, "Show help information")
+	flags.StringVar(&versionsRestoreConfiguration.endpoint, "endpoint", "alpha", "Specify the endpoint on which to restore the file (alpha|beta)")
+	flags.StringVar(&versionsRestoreConfiguration.version, "version", "", "Specify the digest (or digest prefix) of the version to restore (defaults to the most recent version)")
+}
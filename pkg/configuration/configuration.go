@@ -15,12 +15,30 @@ type Configuration struct {
 		MaximumEntryCount uint64 `toml:"maxEntryCount"`
 
 		MaximumStagingFileSize ByteSize `toml:"maxStagingFileSize"`
+
+		DeletionMode sync.DeletionMode `toml:"deletionMode"`
+
+		VersioningMode sync.VersioningMode `toml:"versioning"`
+
+		MassDeletionThreshold DeletionThreshold `toml:"massDeletionThreshold"`
+
+		PreserveModificationTimes sync.ModificationTimesMode `toml:"preserveModificationTimes"`
+
+		ExtendedAttributes []string `toml:"extendedAttributes"`
 	} `toml:"sync"`
 
 	Ignore struct {
 		Default []string `toml:"default"`
 
 		VCS sync.IgnoreVCSMode `toml:"vcs"`
+
+		Includes []string `toml:"includes"`
+
+		Files sync.IgnoreFilesMode `toml:"files"`
+
+		MaximumFileSize ByteSize `toml:"maxFileSize"`
+
+		MaximumFileAge uint64 `toml:"maxFileAge"`
 	} `toml:"ignore"`
 
 	Symlink struct {
@@ -34,6 +52,8 @@ type Configuration struct {
 	} `toml:"watch"`
 
 	Permissions struct {
+		Mode sync.PermissionsMode `toml:"mode"`
+
 		DefaultFileMode filesystem.Mode `toml:"defaultFileMode"`
 
 		DefaultDirectoryMode filesystem.Mode `toml:"defaultDirectoryMode"`
@@ -41,6 +61,10 @@ type Configuration struct {
 
 		DefaultGroup string `toml:"defaultGroup"`
 	} `toml:"permissions"`
+
+	Daemon struct {
+		MetricsAddress string `toml:"metricsAddress"`
+	} `toml:"daemon"`
 }
 
 func loadFromPath(path string) (*Configuration, error) {
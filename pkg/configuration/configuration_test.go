@@ -4,6 +4,8 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+
+	"github.com/RokyErickson/doppelganger/pkg/sync"
 )
 
 const (
@@ -12,6 +14,11 @@ const (
 mode = "two-way-resolved"
 maxEntryCount = 500
 maxStagingFileSize = "1000 GB"
+deletionMode = "trash"
+versioning = "staggered"
+massDeletionThreshold = "50%"
+preserveModificationTimes = true
+extendedAttributes = ["user", "system.posix_acl_access"]
 
 [symlink]
 mode = "portable"
@@ -22,12 +29,20 @@ pollingInterval = 5
 
 [ignore]
 default = ["ignore/this/**", "!ignore/this/that"]
+includes = ["services/api/**"]
+files = "git"
+maxFileSize = "4 GB"
+maxFileAge = 2592000
 
 [permissions]
+mode = "preserve-numeric"
 defaultFileMode = 644
 defaultDirectoryMode = 0755
 defaultOwner = "george"
 defaultGroup = "presidents"
+
+[daemon]
+metricsAddress = "127.0.0.1:9102"
 `
 )
 
@@ -102,6 +117,8 @@ func TestLoadValidConfiguration(t *testing.T) {
 		t.Error("load from valid configuration failed:", err)
 	} else if c == nil {
 		t.Error("load from valid configuration returned nil configuration")
+	} else if c.Synchronization.PreserveModificationTimes != sync.ModificationTimesMode_PreserveModificationTimes {
+		t.Error("modification time preservation not loaded")
 	}
 }
 
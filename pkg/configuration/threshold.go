@@ -0,0 +1,21 @@
+package configuration
+
+import (
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+type DeletionThreshold struct {
+	Count      uint64
+	Percentage uint32
+}
+
+func (t *DeletionThreshold) UnmarshalText(textBytes []byte) error {
+	count, percentage, err := sync.ParseDeletionThreshold(string(textBytes))
+	if err != nil {
+		return err
+	}
+	t.Count = count
+	t.Percentage = percentage
+
+	return nil
+}
@@ -114,6 +114,18 @@ func (d *Directory) SetPermissions(name string, ownership *OwnershipSpecificatio
 	return nil
 }
 
+func (d *Directory) SetModificationTime(name string, modificationTime time.Time) error {
+
+	if err := ensureValidName(name); err != nil {
+		return err
+	}
+
+	timestamp := unix.NsecToTimespec(modificationTime.UnixNano())
+	times := []unix.Timespec{timestamp, timestamp}
+
+	return unix.UtimesNanoAt(d.descriptor, name, times, unix.AT_SYMLINK_NOFOLLOW)
+}
+
 func (d *Directory) open(name string, wantDirectory bool) (*os.File, int, error) {
 
 	if err := ensureValidName(name); err != nil {
@@ -209,6 +221,9 @@ func (d *Directory) ReadContentMetadata(name string) (*Metadata, error) {
 		ModificationTime: time.Unix(modificationTime.Unix()),
 		DeviceID:         uint64(metadata.Dev),
 		FileID:           uint64(metadata.Ino),
+		LinkCount:        uint64(metadata.Nlink),
+		OwnerID:          metadata.Uid,
+		GroupID:          metadata.Gid,
 	}, nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -85,6 +86,15 @@ func (d *Directory) CreateSymbolicLink(name, target string) error {
 	return os.Symlink(target, filepath.Join(d.file.Name(), name))
 }
 
+func (d *Directory) SetModificationTime(name string, modificationTime time.Time) error {
+
+	if err := ensureValidName(name); err != nil {
+		return err
+	}
+
+	return os.Chtimes(filepath.Join(d.file.Name(), name), modificationTime, modificationTime)
+}
+
 func (d *Directory) SetPermissions(name string, ownership *OwnershipSpecification, mode Mode) error {
 
 	if err := ensureValidName(name); err != nil {
@@ -16,4 +16,10 @@ type Metadata struct {
 	DeviceID uint64
 
 	FileID uint64
+
+	LinkCount uint64
+
+	OwnerID uint32
+
+	GroupID uint32
 }
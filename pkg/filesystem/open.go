@@ -1,6 +1,7 @@
 package filesystem
 
 import (
+	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -44,14 +45,54 @@ type Opener struct {
 	openParentNames []string
 
 	openParentDirectories []*Directory
+
+	followSymbolicLinks bool
+
+	followWithinRoot bool
+
+	realRoot string
 }
 
 func NewOpener(root string) *Opener {
 	return &Opener{root: root}
 }
 
+func NewFollowingOpener(root string, withinRoot bool) *Opener {
+	return &Opener{
+		root:                root,
+		followSymbolicLinks: true,
+		followWithinRoot:    withinRoot,
+	}
+}
+
+func (o *Opener) openFollowing(path string) (ReadableFile, error) {
+	target, err := filepath.EvalSymlinks(filepath.Join(o.root, filepath.FromSlash(path)))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to resolve path")
+	}
+
+	if o.followWithinRoot {
+		if o.realRoot == "" {
+			if o.realRoot, err = filepath.EvalSymlinks(o.root); err != nil {
+				return nil, errors.Wrap(err, "unable to resolve root")
+			}
+		}
+		if relative, err := filepath.Rel(o.realRoot, target); err != nil ||
+			relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+			return nil, errors.New("path resolves outside of root")
+		}
+	}
+
+	file, _, err := OpenFile(target, false)
+	return file, err
+}
+
 func (o *Opener) Open(path string) (ReadableFile, error) {
 
+	if o.followSymbolicLinks {
+		return o.openFollowing(path)
+	}
+
 	if path == "" {
 
 		if o.rootDirectory != nil {
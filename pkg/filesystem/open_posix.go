@@ -40,6 +40,9 @@ func Open(path string, allowSymbolicLinkLeaf bool) (io.Closer, *Metadata, error)
 		ModificationTime: fileMetadata.ModTime(),
 		DeviceID:         uint64(rawMetadata.Dev),
 		FileID:           uint64(rawMetadata.Ino),
+		LinkCount:        uint64(rawMetadata.Nlink),
+		OwnerID:          rawMetadata.Uid,
+		GroupID:          rawMetadata.Gid,
 	}
 
 	switch metadata.Mode & ModeTypeMask {
@@ -0,0 +1,13 @@
+package filesystem
+
+import (
+	"github.com/pkg/errors"
+)
+
+var ErrDataRegionsUnsupported = errors.New("data region detection not supported")
+
+type DataRegion struct {
+	Offset uint64
+
+	Length uint64
+}
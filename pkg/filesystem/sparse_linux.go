@@ -0,0 +1,62 @@
+// The pinned golang.org/x/sys/unix doesn't define SEEK_DATA or SEEK_HOLE, so
+// their Linux values from lseek(2) are defined here.
+
+package filesystem
+
+import (
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	seekData = 3
+	seekHole = 4
+)
+
+func seekErrorIs(err error, errno unix.Errno) bool {
+	if pathErr, ok := err.(*os.PathError); ok {
+		err = pathErr.Err
+	}
+	return err == errno
+}
+
+func DataRegions(file io.Seeker) ([]DataRegion, uint64, error) {
+
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "unable to determine file size")
+	}
+
+	var regions []DataRegion
+	for offset := int64(0); offset < size; {
+		start, err := file.Seek(offset, seekData)
+		if seekErrorIs(err, unix.ENXIO) {
+			break
+		} else if seekErrorIs(err, unix.EINVAL) {
+			file.Seek(0, io.SeekStart)
+			return nil, 0, ErrDataRegionsUnsupported
+		} else if err != nil {
+			file.Seek(0, io.SeekStart)
+			return nil, 0, errors.Wrap(err, "unable to seek to data")
+		}
+
+		end, err := file.Seek(start, seekHole)
+		if err != nil {
+			file.Seek(0, io.SeekStart)
+			return nil, 0, errors.Wrap(err, "unable to seek to hole")
+		}
+
+		regions = append(regions, DataRegion{Offset: uint64(start), Length: uint64(end - start)})
+		offset = end
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, errors.Wrap(err, "unable to reset file offset")
+	}
+
+	return regions, uint64(size), nil
+}
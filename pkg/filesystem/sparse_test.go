@@ -0,0 +1,53 @@
+package filesystem
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestDataRegions(t *testing.T) {
+	file, err := ioutil.TempFile("", "doppelganger_sparse")
+	if err != nil {
+		t.Fatal("unable to create temporary file:", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	const size = 1 << 24
+	if _, err := file.WriteAt([]byte("data"), size/2); err != nil {
+		t.Fatal("unable to write data:", err)
+	} else if err = file.Truncate(size); err != nil {
+		t.Fatal("unable to extend file:", err)
+	}
+
+	regions, length, err := DataRegions(file)
+	if err == ErrDataRegionsUnsupported {
+		t.Skip()
+	} else if err != nil {
+		t.Fatal("unable to compute data regions:", err)
+	}
+
+	if length != size {
+		t.Error("file size mismatch:", length, "!=", size)
+	}
+
+	var data uint64
+	for _, r := range regions {
+		if r.Offset > size/2 || r.Offset+r.Length < size/2+4 {
+			t.Error("data region does not contain written data:", r.Offset, r.Length)
+		}
+		data += r.Length
+	}
+	if len(regions) == 0 {
+		t.Error("no data regions found")
+	} else if data > size {
+		t.Error("data regions exceed file size:", data, ">", size)
+	}
+
+	if offset, err := file.Seek(0, os.SEEK_CUR); err != nil {
+		t.Fatal("unable to query file offset:", err)
+	} else if offset != 0 {
+		t.Error("file offset not reset:", offset)
+	}
+}
@@ -0,0 +1,11 @@
+// +build !linux
+
+package filesystem
+
+import (
+	"io"
+)
+
+func DataRegions(file io.Seeker) ([]DataRegion, uint64, error) {
+	return nil, 0, ErrDataRegionsUnsupported
+}
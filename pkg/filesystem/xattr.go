@@ -0,0 +1,29 @@
+package filesystem
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var ErrExtendedAttributesUnsupported = errors.New("extended attributes not supported")
+
+func ExtendedAttributeAllowed(name string, namespaces []string) bool {
+	for _, namespace := range namespaces {
+		if name == namespace || strings.HasPrefix(name, namespace+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func splitExtendedAttributeNames(buffer []byte) []string {
+	var names []string
+	for _, name := range bytes.Split(buffer, []byte{0}) {
+		if len(name) > 0 {
+			names = append(names, string(name))
+		}
+	}
+	return names
+}
@@ -0,0 +1,62 @@
+// +build freebsd netbsd
+
+package filesystem
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+var errExtendedAttributeNotFound error = unix.ENOATTR
+
+var extendedAttributeNamespaces = []struct {
+	identifier int
+	prefix     string
+}{
+	{unix.EXTATTR_NAMESPACE_USER, "user."},
+	{unix.EXTATTR_NAMESPACE_SYSTEM, "system."},
+}
+
+func listExtendedAttributesInNamespace(descriptor, namespace int) ([]byte, error) {
+	for {
+		size, err := unix.ExtattrListFd(descriptor, namespace, 0, 0)
+		if err != nil {
+			return nil, err
+		} else if size == 0 {
+			return nil, nil
+		}
+
+		buffer := make([]byte, size)
+		count, err := unix.ExtattrListFd(descriptor, namespace, uintptr(unsafe.Pointer(&buffer[0])), size)
+		if err != nil {
+			return nil, err
+		} else if count > size {
+			continue
+		}
+
+		return buffer[:count], nil
+	}
+}
+
+func listExtendedAttributes(descriptor int) ([]string, error) {
+	var names []string
+	for _, namespace := range extendedAttributeNamespaces {
+		buffer, err := listExtendedAttributesInNamespace(descriptor, namespace.identifier)
+		if err == unix.EPERM && namespace.identifier != unix.EXTATTR_NAMESPACE_USER {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		for len(buffer) > 0 {
+			length := int(buffer[0])
+			if length+1 > len(buffer) {
+				break
+			}
+			names = append(names, namespace.prefix+string(buffer[1:length+1]))
+			buffer = buffer[length+1:]
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,28 @@
+package filesystem
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+var errExtendedAttributeNotFound error = unix.ENOATTR
+
+func listExtendedAttributes(descriptor int) ([]string, error) {
+	for {
+		size, err := unix.Flistxattr(descriptor, nil)
+		if err != nil {
+			return nil, err
+		} else if size == 0 {
+			return nil, nil
+		}
+
+		buffer := make([]byte, size)
+		size, err = unix.Flistxattr(descriptor, buffer)
+		if err == unix.ERANGE {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return splitExtendedAttributeNames(buffer[:size]), nil
+	}
+}
@@ -0,0 +1,130 @@
+// +build linux darwin freebsd netbsd
+
+package filesystem
+
+import (
+	"os"
+	"runtime"
+
+	"github.com/pkg/errors"
+
+	"golang.org/x/sys/unix"
+)
+
+func isExtendedAttributesUnsupportedError(err error) bool {
+	return err == unix.ENOTSUP || err == unix.EOPNOTSUPP
+}
+
+func getExtendedAttribute(descriptor int, name string) ([]byte, error) {
+	for {
+		size, err := unix.Fgetxattr(descriptor, name, nil)
+		if err != nil {
+			return nil, err
+		} else if size == 0 {
+			return []byte{}, nil
+		}
+
+		buffer := make([]byte, size)
+		size, err = unix.Fgetxattr(descriptor, name, buffer)
+		if err == unix.ERANGE {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return buffer[:size], nil
+	}
+}
+
+func readExtendedAttributes(descriptor int, namespaces []string) (map[string][]byte, error) {
+
+	names, err := listExtendedAttributes(descriptor)
+	if err != nil {
+		if isExtendedAttributesUnsupportedError(err) {
+			return nil, ErrExtendedAttributesUnsupported
+		}
+		return nil, errors.Wrap(err, "unable to list extended attributes")
+	}
+
+	attributes := make(map[string][]byte)
+	for _, name := range names {
+		if !ExtendedAttributeAllowed(name, namespaces) {
+			continue
+		}
+
+		value, err := getExtendedAttribute(descriptor, name)
+		if err != nil {
+			if err == errExtendedAttributeNotFound {
+				continue
+			}
+			return nil, errors.Wrapf(err, "unable to read extended attribute (%s)", name)
+		}
+		attributes[name] = value
+	}
+
+	return attributes, nil
+}
+
+func (d *Directory) openContent(name string) (int, error) {
+
+	if err := ensureValidName(name); err != nil {
+		return -1, err
+	}
+
+	for {
+		if descriptor, err := openat(d.descriptor, name, os.O_RDONLY|unix.O_NOFOLLOW|unix.O_CLOEXEC|unix.O_NONBLOCK, 0); err == nil {
+			return descriptor, nil
+		} else if runtime.GOOS == "darwin" && err == unix.EINTR {
+			continue
+		} else {
+			return -1, err
+		}
+	}
+}
+
+func (d *Directory) ReadExtendedAttributes(namespaces []string) (map[string][]byte, error) {
+	return readExtendedAttributes(d.descriptor, namespaces)
+}
+
+func (d *Directory) ReadContentExtendedAttributes(name string, namespaces []string) (map[string][]byte, error) {
+
+	descriptor, err := d.openContent(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open content")
+	}
+	defer unix.Close(descriptor)
+
+	return readExtendedAttributes(descriptor, namespaces)
+}
+
+func (d *Directory) SetContentExtendedAttribute(name, attribute string, value []byte) error {
+
+	descriptor, err := d.openContent(name)
+	if err != nil {
+		return errors.Wrap(err, "unable to open content")
+	}
+	defer unix.Close(descriptor)
+
+	return unix.Fsetxattr(descriptor, attribute, value, 0)
+}
+
+func (d *Directory) RemoveContentExtendedAttribute(name, attribute string) error {
+
+	descriptor, err := d.openContent(name)
+	if err != nil {
+		return errors.Wrap(err, "unable to open content")
+	}
+	defer unix.Close(descriptor)
+
+	if err := unix.Fremovexattr(descriptor, attribute); err != nil && err != errExtendedAttributeNotFound {
+		return err
+	}
+	return nil
+}
+
+func ReadFileExtendedAttributes(file ReadableFile, namespaces []string) (map[string][]byte, error) {
+	if f, ok := file.(*os.File); ok {
+		return readExtendedAttributes(int(f.Fd()), namespaces)
+	}
+	return nil, errors.New("file does not expose a descriptor")
+}
@@ -0,0 +1,27 @@
+package filesystem
+
+import (
+	"testing"
+)
+
+func TestExtendedAttributeAllowed(t *testing.T) {
+	namespaces := []string{"user", "system.posix_acl_access"}
+
+	testCases := []struct {
+		name     string
+		expected bool
+	}{
+		{"user.comment", true},
+		{"user", true},
+		{"username.comment", false},
+		{"security.selinux", false},
+		{"system.posix_acl_access", true},
+		{"system.posix_acl_default", false},
+	}
+
+	for _, testCase := range testCases {
+		if allowed := ExtendedAttributeAllowed(testCase.name, namespaces); allowed != testCase.expected {
+			t.Error("extended attribute allowance does not match expected:", testCase.name, allowed, "!=", testCase.expected)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// +build !linux,!darwin,!freebsd,!netbsd
+
+package filesystem
+
+func (d *Directory) ReadExtendedAttributes(namespaces []string) (map[string][]byte, error) {
+	return nil, ErrExtendedAttributesUnsupported
+}
+
+func (d *Directory) ReadContentExtendedAttributes(name string, namespaces []string) (map[string][]byte, error) {
+	return nil, ErrExtendedAttributesUnsupported
+}
+
+func (d *Directory) SetContentExtendedAttribute(name, attribute string, value []byte) error {
+	return ErrExtendedAttributesUnsupported
+}
+
+func (d *Directory) RemoveContentExtendedAttribute(name, attribute string) error {
+	return ErrExtendedAttributesUnsupported
+}
+
+func ReadFileExtendedAttributes(file ReadableFile, namespaces []string) (map[string][]byte, error) {
+	return nil, ErrExtendedAttributesUnsupported
+}
@@ -49,6 +49,8 @@ func init() {
 	agent.Housekeep()
 	local.HousekeepCaches()
 	local.HousekeepStaging()
+	local.HousekeepTrash()
+	local.HousekeepVersions()
 }
 
 func waitForSuccessfulSynchronizationCycle(sessionId string, allowConflicts, allowProblems bool) error {
@@ -59,7 +61,7 @@ func waitForSuccessfulSynchronizationCycle(sessionId string, allowConflicts, all
 	var states []*session.State
 	var err error
 	for {
-		previousStateIndex, states, err = sessionManager.List(previousStateIndex, specification)
+		previousStateIndex, states, err = sessionManager.List(previousStateIndex, specification, "")
 		if err != nil {
 			return errors.Wrap(err, "unable to list session states")
 		} else if len(states) != 1 {
@@ -80,6 +82,8 @@ func testSessionLifecycle(prompter string, alpha, beta *url.URL, configuration *
 	sessionId, err := sessionManager.Create(
 		alpha, beta,
 		configuration, &session.Configuration{}, &session.Configuration{},
+		"",
+		nil,
 		prompter,
 	)
 	if err != nil {
@@ -92,11 +96,11 @@ func testSessionLifecycle(prompter string, alpha, beta *url.URL, configuration *
 		return errors.Wrap(err, "unable to wait for successful synchronization")
 	}
 
-	if err := sessionManager.Pause(specification, ""); err != nil {
+	if err := sessionManager.Pause(specification, "", ""); err != nil {
 		return errors.Wrap(err, "unable to pause session")
 	}
 
-	if err := sessionManager.Resume(specification, ""); err != nil {
+	if err := sessionManager.Resume(specification, "", ""); err != nil {
 		return errors.Wrap(err, "unable to resume session")
 	}
 
@@ -104,11 +108,11 @@ func testSessionLifecycle(prompter string, alpha, beta *url.URL, configuration *
 		return errors.Wrap(err, "unable to wait for additional synchronization")
 	}
 
-	if err := sessionManager.Resume(specification, ""); err != nil {
+	if err := sessionManager.Resume(specification, "", ""); err != nil {
 		return errors.Wrap(err, "unable to perform additional resume")
 	}
 
-	if err := sessionManager.Terminate(specification, ""); err != nil {
+	if err := sessionManager.Terminate(specification, "", ""); err != nil {
 		return errors.Wrap(err, "unable to terminate session")
 	}
 
@@ -0,0 +1,3 @@
+// Package project provides loading facilities for Doppelganger project files,
+// which declare groups of synchronization sessions that are managed together.
+package project
@@ -0,0 +1,112 @@
+package project
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/RokyErickson/doppelganger/pkg/configuration"
+	"github.com/RokyErickson/doppelganger/pkg/encoding"
+	"github.com/RokyErickson/doppelganger/pkg/session"
+)
+
+const (
+	DefaultFileName = "doppelganger.toml"
+
+	ProjectLabelKey = "doppelganger-project"
+
+	SessionLabelKey = "doppelganger-project-session"
+)
+
+var sessionNameMatcher = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+type Session struct {
+	Alpha string `toml:"alpha"`
+
+	Beta string `toml:"beta"`
+
+	configuration.Configuration
+
+	ConfigurationAlpha configuration.Configuration `toml:"configurationAlpha"`
+
+	ConfigurationBeta configuration.Configuration `toml:"configurationBeta"`
+}
+
+type Project struct {
+	Sessions map[string]*Session `toml:"sessions"`
+}
+
+func Load(path string) (*Project, error) {
+	result := &Project{}
+
+	if err := encoding.LoadAndUnmarshalTOML(path, result); err != nil {
+		return nil, errors.Wrap(err, "unable to load project file")
+	}
+
+	if err := result.EnsureValid(); err != nil {
+		return nil, errors.Wrap(err, "invalid project file")
+	}
+
+	return result, nil
+}
+
+func (p *Project) EnsureValid() error {
+	if p == nil {
+		return errors.New("nil project")
+	}
+
+	if len(p.Sessions) == 0 {
+		return errors.New("no sessions defined")
+	}
+
+	for name, s := range p.Sessions {
+		if !sessionNameMatcher.MatchString(name) {
+			return errors.Errorf("invalid session name: %s", name)
+		} else if s == nil {
+			return errors.Errorf("empty session definition: %s", name)
+		} else if s.Alpha == "" {
+			return errors.Errorf("no alpha URL specified for session: %s", name)
+		} else if s.Beta == "" {
+			return errors.Errorf("no beta URL specified for session: %s", name)
+		}
+
+		configuration := session.ConfigurationFromTOML(&s.Configuration)
+		if err := configuration.EnsureValid(session.ConfigurationSourceTypeCreate); err != nil {
+			return errors.Wrapf(err, "invalid configuration for session %s", name)
+		}
+
+		alphaConfiguration := session.ConfigurationFromTOML(&s.ConfigurationAlpha)
+		if err := alphaConfiguration.EnsureValid(session.ConfigurationSourceTypeCreateEndpointSpecific); err != nil {
+			return errors.Wrapf(err, "invalid alpha-specific configuration for session %s", name)
+		}
+
+		betaConfiguration := session.ConfigurationFromTOML(&s.ConfigurationBeta)
+		if err := betaConfiguration.EnsureValid(session.ConfigurationSourceTypeCreateEndpointSpecific); err != nil {
+			return errors.Wrapf(err, "invalid beta-specific configuration for session %s", name)
+		}
+	}
+
+	return nil
+}
+
+func (p *Project) Names() []string {
+	names := make([]string, 0, len(p.Sessions))
+	for name := range p.Sessions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func Identifier(path string) (string, error) {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to compute absolute project file path")
+	}
+
+	return fmt.Sprintf("%x", sha1.Sum([]byte(absolutePath))), nil
+}
@@ -0,0 +1,109 @@
+package project
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+const (
+	testProjectValid = `[sessions.code]
+alpha = "."
+beta = "docker://dev/app"
+
+[sessions.code.sync]
+mode = "two-way-resolved"
+
+[sessions.code.ignore]
+default = ["node_modules"]
+
+[sessions.code.configurationBeta.permissions]
+defaultOwner = "node"
+
+[sessions.assets]
+alpha = "assets"
+beta = "docker://dev/assets"
+`
+	testProjectMissingBeta = `[sessions.code]
+alpha = "."
+`
+	testProjectInvalidName = `[sessions."code base"]
+alpha = "."
+beta = "docker://dev/app"
+`
+	testProjectEndpointSynchronizationMode = `[sessions.code]
+alpha = "."
+beta = "docker://dev/app"
+
+[sessions.code.configurationAlpha.sync]
+mode = "one-way-safe"
+`
+)
+
+func writeTestProject(t *testing.T, contents string) (string, func()) {
+	directory, err := ioutil.TempDir("", "doppelganger_project")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	path := filepath.Join(directory, DefaultFileName)
+	if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		os.RemoveAll(directory)
+		t.Fatal("unable to write project file:", err)
+	}
+	return path, func() { os.RemoveAll(directory) }
+}
+
+func TestLoadValidProject(t *testing.T) {
+	path, cleanup := writeTestProject(t, testProjectValid)
+	defer cleanup()
+
+	project, err := Load(path)
+	if err != nil {
+		t.Fatal("unable to load valid project:", err)
+	}
+
+	if names := project.Names(); len(names) != 2 || names[0] != "assets" || names[1] != "code" {
+		t.Error("unexpected session names:", names)
+	}
+
+	code := project.Sessions["code"]
+	if code.Beta != "docker://dev/app" {
+		t.Error("unexpected beta URL:", code.Beta)
+	}
+	if code.Synchronization.Mode != sync.SynchronizationMode_SynchronizationModeTwoWayResolved {
+		t.Error("session configuration not loaded")
+	}
+	if len(code.Ignore.Default) != 1 || code.Ignore.Default[0] != "node_modules" {
+		t.Error("session ignores not loaded")
+	}
+	if code.ConfigurationBeta.Permissions.DefaultOwner != "node" {
+		t.Error("beta-specific configuration not loaded")
+	}
+}
+
+func TestLoadInvalidProjects(t *testing.T) {
+	for _, contents := range []string{"", testProjectMissingBeta, testProjectInvalidName, testProjectEndpointSynchronizationMode} {
+		path, cleanup := writeTestProject(t, contents)
+		if _, err := Load(path); err == nil {
+			t.Error("load succeeded on invalid project:", contents)
+		}
+		cleanup()
+	}
+}
+
+func TestIdentifierStable(t *testing.T) {
+	first, err := Identifier(DefaultFileName)
+	if err != nil {
+		t.Fatal("unable to compute identifier:", err)
+	}
+	second, err := Identifier("./" + DefaultFileName)
+	if err != nil {
+		t.Fatal("unable to compute identifier:", err)
+	}
+	if first != second {
+		t.Error("identifier differs for equivalent paths")
+	}
+}
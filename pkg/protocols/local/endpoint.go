@@ -23,9 +23,17 @@ type endpoint struct {
 	watchEvents                    chan struct{}
 	symlinkMode                    sync.SymlinkMode
 	ignores                        []string
+	includes                       []string
+	ignoreFileNames                []string
+	ignorePredicates               *sync.IgnorePredicates
+	preserveModificationTimes      bool
+	permissionsMode                sync.PermissionsMode
+	extendedAttributes             []string
 	defaultFileMode                filesystem.Mode
 	defaultDirectoryMode           filesystem.Mode
 	defaultOwnership               *filesystem.OwnershipSpecification
+	trashRoot                      string
+	versioner                      sync.Versioner
 	cachePath                      string
 	cacheLock                      syncpkg.Mutex
 	cacheWriteError                error
@@ -61,10 +69,24 @@ func NewEndpoint(
 	if synchronizationMode.IsDefault() {
 		synchronizationMode = version.DefaultSynchronizationMode()
 	}
-	unidirectional := synchronizationMode == sync.SynchronizationMode_SynchronizationModeOneWaySafe ||
-		synchronizationMode == sync.SynchronizationMode_SynchronizationModeOneWayReplica
+	unidirectional := synchronizationMode.Unidirectional()
 	readOnly := alpha && unidirectional
 
+	deletionMode := configuration.DeletionMode
+	if deletionMode.IsDefault() {
+		deletionMode = version.DefaultDeletionMode()
+	}
+
+	modificationTimesMode := configuration.ModificationTimesMode
+	if modificationTimesMode.IsDefault() {
+		modificationTimesMode = version.DefaultModificationTimesMode()
+	}
+
+	versioningMode := configuration.VersioningMode
+	if versioningMode.IsDefault() {
+		versioningMode = version.DefaultVersioningMode()
+	}
+
 	symlinkMode := configuration.SymlinkMode
 	if symlinkMode.IsDefault() {
 		symlinkMode = version.DefaultSymlinkMode()
@@ -80,9 +102,9 @@ func NewEndpoint(
 		watchPollingInterval = version.DefaultWatchPollingInterval()
 	}
 
-	ignoreVCSMode := configuration.IgnoreVCSMode
-	if ignoreVCSMode.IsDefault() {
-		ignoreVCSMode = version.DefaultIgnoreVCSMode()
+	permissionsMode := configuration.PermissionsMode
+	if permissionsMode.IsDefault() {
+		permissionsMode = version.DefaultPermissionsMode()
 	}
 
 	defaultFileMode := filesystem.Mode(configuration.DefaultFileMode)
@@ -113,12 +135,7 @@ func NewEndpoint(
 		return nil, errors.Wrap(err, "unable to create ownership specification")
 	}
 
-	var ignores []string
-	if ignoreVCSMode == sync.IgnoreVCSMode_IgnoreVCS {
-		ignores = append(ignores, sync.DefaultVCSIgnores...)
-	}
-	ignores = append(ignores, configuration.DefaultIgnores...)
-	ignores = append(ignores, configuration.Ignores...)
+	ignoreOptions := session.IgnoreScanOptions(version, configuration)
 
 	watchContext, watchCancel := context.WithCancel(context.Background())
 	watchEvents := make(chan struct{}, 1)
@@ -163,21 +180,52 @@ func NewEndpoint(
 		return nil, errors.Wrap(err, "unable to compute staging root")
 	}
 
+	var trashRoot string
+	if deletionMode == sync.DeletionMode_DeletionModeTrash {
+		if endpointOptions.trashRootCallback != nil {
+			trashRoot, err = endpointOptions.trashRootCallback(sessionIdentifier, alpha)
+		} else {
+			trashRoot, err = pathForTrashRoot(sessionIdentifier, alpha)
+		}
+		if err != nil {
+			watchCancel()
+			return nil, errors.Wrap(err, "unable to compute trash root")
+		}
+	}
+
+	var versioner sync.Versioner
+	if versioningMode == sync.VersioningMode_VersioningModeStaggered {
+		if versionStoreRoot, err := pathForVersionStore(sessionIdentifier, alpha); err != nil {
+			watchCancel()
+			return nil, errors.Wrap(err, "unable to compute version store path")
+		} else {
+			versioner = newVersionStore(versionStoreRoot)
+		}
+	}
+
 	return &endpoint{
-		root:                 root,
-		readOnly:             readOnly,
-		maximumEntryCount:    configuration.MaximumEntryCount,
-		watchCancel:          watchCancel,
-		watchEvents:          watchEvents,
-		symlinkMode:          symlinkMode,
-		ignores:              ignores,
-		defaultFileMode:      defaultFileMode,
-		defaultDirectoryMode: defaultDirectoryMode,
-		defaultOwnership:     defaultOwnership,
-		cachePath:            cachePath,
-		cache:                cache,
-		scanHasher:           version.Hasher(),
-		stager:               newStager(version, stagingRoot, configuration.MaximumStagingFileSize),
+		root:                      root,
+		readOnly:                  readOnly,
+		maximumEntryCount:         configuration.MaximumEntryCount,
+		watchCancel:               watchCancel,
+		watchEvents:               watchEvents,
+		symlinkMode:               symlinkMode,
+		ignores:                   ignoreOptions.Ignores,
+		includes:                  ignoreOptions.Includes,
+		ignoreFileNames:           ignoreOptions.IgnoreFileNames,
+		ignorePredicates:          ignoreOptions.Predicates,
+		preserveModificationTimes: modificationTimesMode == sync.ModificationTimesMode_PreserveModificationTimes,
+		permissionsMode:           permissionsMode,
+		extendedAttributes:        configuration.ExtendedAttributeNamespaces,
+		defaultFileMode:           defaultFileMode,
+		defaultDirectoryMode:      defaultDirectoryMode,
+		defaultOwnership:          defaultOwnership,
+		trashRoot:                 trashRoot,
+		versioner:                 versioner,
+		cachePath:                 cachePath,
+		cache:                     cache,
+		scanHasher:                version.Hasher(),
+		stager:                    newStager(version, stagingRoot, configuration.MaximumStagingFileSize),
 	}, nil
 }
 
@@ -195,36 +243,45 @@ func (e *endpoint) Poll(context context.Context) error {
 	return nil
 }
 
-func (e *endpoint) Scan(_ *sync.Entry) (*sync.Entry, bool, error, bool) {
+func (e *endpoint) Scan(_ *sync.Entry) (*sync.Entry, bool, []string, []*sync.Problem, error, bool) {
 
 	e.cacheLock.Lock()
 
 	if e.cacheWriteError != nil {
 		defer e.cacheLock.Unlock()
-		return nil, false, errors.Wrap(e.cacheWriteError, "unable to save cache to disk"), false
-	}
-
-	result, preservesExecutability, recomposeUnicode, newCache, newIgnoreCache, err := sync.Scan(
-		e.root, e.scanHasher, e.cache, e.ignores, e.ignoreCache, e.symlinkMode,
+		return nil, false, nil, nil, errors.Wrap(e.cacheWriteError, "unable to save cache to disk"), false
+	}
+
+	result, err := sync.Scan(
+		e.root, e.scanHasher, e.cache, e.ignoreCache, sync.ScanOptions{
+			Ignores:                 e.ignores,
+			Includes:                e.includes,
+			IgnoreFileNames:         e.ignoreFileNames,
+			Predicates:              e.ignorePredicates,
+			SymlinkMode:             e.symlinkMode,
+			RecordModificationTimes: e.preserveModificationTimes,
+			PermissionsMode:         e.permissionsMode,
+			ExtendedAttributes:      e.extendedAttributes,
+		},
 	)
 	if err != nil {
 		e.cacheLock.Unlock()
-		return nil, false, err, true
+		return nil, false, nil, nil, err, true
 	}
 
-	e.lastScanCount = result.Count()
+	e.lastScanCount = result.Root.Count()
 
 	e.scannedSinceLastStageCall = true
 	e.scannedSinceLastTransitionCall = true
 
 	if e.maximumEntryCount != 0 && e.lastScanCount > e.maximumEntryCount {
 		e.cacheLock.Unlock()
-		return nil, false, errors.New("exceeded allowed entry count"), true
+		return nil, false, nil, nil, errors.New("exceeded allowed entry count"), true
 	}
 
-	e.cache = newCache
-	e.ignoreCache = newIgnoreCache
-	e.recomposeUnicode = recomposeUnicode
+	e.cache = result.Cache
+	e.ignoreCache = result.IgnoreCache
+	e.recomposeUnicode = result.RecomposeUnicode
 
 	go func() {
 		if err := encoding.MarshalAndSaveProtobuf(e.cachePath, e.cache); err != nil {
@@ -233,7 +290,14 @@ func (e *endpoint) Scan(_ *sync.Entry) (*sync.Entry, bool, error, bool) {
 		e.cacheLock.Unlock()
 	}()
 
-	return result, preservesExecutability, nil, false
+	return result.Root, result.PreservesExecutability, result.Excluded, result.Problems, nil, false
+}
+
+func (e *endpoint) opener() *filesystem.Opener {
+	if e.symlinkMode.Follows() {
+		return filesystem.NewFollowingOpener(e.root, e.symlinkMode == sync.SymlinkMode_SymlinkFollowWithinRoot)
+	}
+	return filesystem.NewOpener(e.root)
 }
 
 func (e *endpoint) stageFromRoot(
@@ -291,7 +355,7 @@ func (e *endpoint) Stage(paths []string, digests [][]byte) ([]string, []*rsync.S
 		return nil, nil, nil, errors.Wrap(err, "unable to generate reverse lookup map")
 	}
 
-	opener := filesystem.NewOpener(e.root)
+	opener := e.opener()
 	defer opener.Close()
 
 	filteredPaths := paths[:0]
@@ -326,7 +390,7 @@ func (e *endpoint) Stage(paths []string, digests [][]byte) ([]string, []*rsync.S
 		}
 	}
 
-	receiver, err := rsync.NewReceiver(e.root, filteredPaths, signatures, e.stager)
+	receiver, err := rsync.NewReceiver(e.opener(), filteredPaths, signatures, e.stager)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "unable to create rsync receiver")
 	}
@@ -335,7 +399,7 @@ func (e *endpoint) Stage(paths []string, digests [][]byte) ([]string, []*rsync.S
 }
 
 func (e *endpoint) Supply(paths []string, signatures []*rsync.Signature, receiver rsync.Receiver) error {
-	return rsync.Transmit(e.root, paths, signatures, receiver)
+	return rsync.Transmit(e.opener(), paths, signatures, receiver)
 }
 
 func (e *endpoint) Transition(transitions []*sync.Change) ([]*sync.Entry, []*sync.Problem, error) {
@@ -378,12 +442,19 @@ func (e *endpoint) Transition(transitions []*sync.Change) ([]*sync.Entry, []*syn
 		e.root,
 		transitions,
 		e.cache,
-		e.symlinkMode,
-		e.defaultFileMode,
-		e.defaultDirectoryMode,
-		e.defaultOwnership,
-		e.recomposeUnicode,
 		e.stager,
+		sync.TransitionOptions{
+			SymlinkMode:                    e.symlinkMode,
+			DefaultFilePermissionMode:      e.defaultFileMode,
+			DefaultDirectoryPermissionMode: e.defaultDirectoryMode,
+			DefaultOwnership:               e.defaultOwnership,
+			RecomposeUnicode:               e.recomposeUnicode,
+			Trash:                          e.trashRoot,
+			Versioner:                      e.versioner,
+			PreserveModificationTimes:      e.preserveModificationTimes,
+			PermissionsMode:                e.permissionsMode,
+			ExtendedAttributes:             e.extendedAttributes,
+		},
 	)
 
 	e.stager.wipe()
@@ -7,6 +7,7 @@ import (
 type endpointOptions struct {
 	cachePathCallback   func(string, bool) (string, error)
 	stagingRootCallback func(string, bool) (string, error)
+	trashRootCallback   func(string, bool) (string, error)
 	watchingMechanism   func(context.Context, string, chan<- struct{})
 }
 
@@ -38,6 +39,12 @@ func WithStagingRootCallback(callback func(string, bool) (string, error)) Endpoi
 	})
 }
 
+func WithTrashRootCallback(callback func(string, bool) (string, error)) EndpointOption {
+	return newFunctionEndpointOption(func(options *endpointOptions) {
+		options.trashRootCallback = callback
+	})
+}
+
 func WithWatchingMechanism(callback func(context.Context, string, chan<- struct{})) EndpointOption {
 	return newFunctionEndpointOption(func(options *endpointOptions) {
 		options.watchingMechanism = callback
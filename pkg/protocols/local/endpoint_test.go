@@ -0,0 +1,86 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/RokyErickson/doppelganger/pkg/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+func TestFollowedSymbolicLinksStagedAndSupplied(t *testing.T) {
+	directory, err := ioutil.TempDir("", "doppelganger_endpoint")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(directory)
+
+	source := filepath.Join(directory, "source")
+	if err := os.MkdirAll(filepath.Join(source, "real"), 0700); err != nil {
+		t.Fatal("unable to create source directory:", err)
+	} else if err := ioutil.WriteFile(filepath.Join(source, "real", "c.json"), []byte("{}"), 0600); err != nil {
+		t.Fatal("unable to create source file:", err)
+	} else if err := ioutil.WriteFile(filepath.Join(source, "target.txt"), []byte("target"), 0600); err != nil {
+		t.Fatal("unable to create source file:", err)
+	} else if err := os.Symlink("real", filepath.Join(source, "link")); err != nil {
+		t.Fatal("unable to create directory symbolic link:", err)
+	} else if err := os.Symlink("target.txt", filepath.Join(source, "flink")); err != nil {
+		t.Fatal("unable to create file symbolic link:", err)
+	}
+
+	destination := filepath.Join(directory, "destination")
+	if err := os.Mkdir(destination, 0700); err != nil {
+		t.Fatal("unable to create destination directory:", err)
+	}
+
+	alpha := &endpoint{
+		root:        source,
+		symlinkMode: sync.SymlinkMode_SymlinkFollowWithinRoot,
+		cachePath:   filepath.Join(directory, "cache"),
+		cache:       &sync.Cache{},
+		scanHasher:  session.Version_Version1.Hasher(),
+	}
+	snapshot, _, _, _, err, _ := alpha.Scan(nil)
+	if err != nil {
+		t.Fatal("unable to scan source:", err)
+	}
+
+	beta := &endpoint{
+		root:                      destination,
+		symlinkMode:               sync.SymlinkMode_SymlinkPortable,
+		cache:                     &sync.Cache{},
+		stager:                    newStager(session.Version_Version1, filepath.Join(directory, "staging"), 0),
+		scannedSinceLastStageCall: true,
+	}
+
+	paths, digests, err := sync.TransitionDependencies([]*sync.Change{{New: snapshot}})
+	if err != nil {
+		t.Fatal("unable to compute transition dependencies:", err)
+	}
+	expected := map[string]bool{"flink": true, "link/c.json": true, "real/c.json": true, "target.txt": true}
+	if len(paths) != len(expected) {
+		t.Fatal("unexpected staging paths:", paths)
+	}
+	pathDigests := make(map[string][]byte, len(paths))
+	for p, path := range paths {
+		if !expected[path] {
+			t.Fatal("unexpected staging path:", path)
+		}
+		pathDigests[path] = digests[p]
+	}
+
+	filteredPaths, signatures, receiver, err := beta.Stage(paths, digests)
+	if err != nil {
+		t.Fatal("unable to begin staging:", err)
+	} else if err = alpha.Supply(filteredPaths, signatures, receiver); err != nil {
+		t.Fatal("unable to supply files:", err)
+	}
+
+	for _, path := range paths {
+		if _, err := beta.stager.Provide(path, pathDigests[path]); err != nil {
+			t.Error("file not staged:", path, err)
+		}
+	}
+}
@@ -1,16 +1,21 @@
 package local
 
 import (
+	"encoding/hex"
 	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/RokyErickson/doppelganger/pkg/encoding"
 	"github.com/RokyErickson/doppelganger/pkg/filesystem"
 )
 
 const (
 	maximumCacheAge       = 30 * 24 * time.Hour
 	maximumStagingRootAge = maximumCacheAge
+	maximumTrashAge       = 7 * 24 * time.Hour
+
+	minimumUnreferencedVersionAge = time.Hour
 )
 
 func HousekeepCaches() {
@@ -62,3 +67,102 @@ func HousekeepStaging() {
 		}
 	}
 }
+
+func HousekeepTrash() {
+	trashDirectoryPath, err := filesystem.Doppelganger(false, trashDirectoryName)
+	if err != nil {
+		return
+	}
+
+	trashDirectoryContents, err := filesystem.DirectoryContentsByPath(trashDirectoryPath)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+
+	for _, c := range trashDirectoryContents {
+
+		trashRootPath := filepath.Join(trashDirectoryPath, c.Name())
+		trashRootContents, err := filesystem.DirectoryContentsByPath(trashRootPath)
+		if err != nil {
+			continue
+		}
+
+		for _, b := range trashRootContents {
+			fullPath := filepath.Join(trashRootPath, b.Name())
+			if stat, err := os.Lstat(fullPath); err != nil {
+				continue
+			} else if now.Sub(stat.ModTime()) > maximumTrashAge {
+				os.RemoveAll(fullPath)
+			}
+		}
+
+		os.Remove(trashRootPath)
+	}
+}
+
+func housekeepVersionStore(root string, now time.Time) {
+	historiesPath := filepath.Join(root, versionHistoriesDirectoryName)
+	historiesContents, err := filesystem.DirectoryContentsByPath(historiesPath)
+	if err != nil && !os.IsNotExist(err) {
+		return
+	}
+
+	referenced := make(map[string]bool)
+	for _, h := range historiesContents {
+		historyPath := filepath.Join(historiesPath, h.Name())
+		history, err := loadVersionHistory(historyPath, "")
+		if err != nil {
+			continue
+		}
+
+		history.Prune(now)
+		if len(history.Versions) == 0 {
+			os.Remove(historyPath)
+			continue
+		} else if err = encoding.MarshalAndSaveProtobuf(historyPath, history); err != nil {
+			continue
+		}
+
+		for _, v := range history.Versions {
+			referenced[hex.EncodeToString(v.Digest)] = true
+		}
+	}
+
+	objectsPath := filepath.Join(root, versionObjectsDirectoryName)
+	objectsContents, err := filesystem.DirectoryContentsByPath(objectsPath)
+	if err != nil {
+		return
+	}
+
+	for _, o := range objectsContents {
+		if referenced[o.Name()] {
+			continue
+		}
+		fullPath := filepath.Join(objectsPath, o.Name())
+		if stat, err := os.Lstat(fullPath); err != nil {
+			continue
+		} else if now.Sub(stat.ModTime()) > minimumUnreferencedVersionAge {
+			os.Remove(fullPath)
+		}
+	}
+}
+
+func HousekeepVersions() {
+	versionsDirectoryPath, err := filesystem.Doppelganger(false, versionsDirectoryName)
+	if err != nil {
+		return
+	}
+
+	versionsDirectoryContents, err := filesystem.DirectoryContentsByPath(versionsDirectoryPath)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+
+	for _, c := range versionsDirectoryContents {
+		housekeepVersionStore(filepath.Join(versionsDirectoryPath, c.Name()), now)
+	}
+}
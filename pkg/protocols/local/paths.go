@@ -15,6 +15,10 @@ const (
 
 	stagingDirectoryName = "staging"
 
+	trashDirectoryName = "trash"
+
+	versionsDirectoryName = "versions"
+
 	alphaName = "alpha"
 
 	betaName = "beta"
@@ -51,6 +55,30 @@ func pathForStagingRoot(session string, alpha bool) (string, error) {
 	return filesystem.Doppelganger(false, stagingDirectoryName, stagingRootName)
 }
 
+func pathForTrashRoot(session string, alpha bool) (string, error) {
+
+	endpointName := alphaName
+	if !alpha {
+		endpointName = betaName
+	}
+
+	trashRootName := fmt.Sprintf("%s_%s", session, endpointName)
+
+	return filesystem.Doppelganger(false, trashDirectoryName, trashRootName)
+}
+
+func pathForVersionStore(session string, alpha bool) (string, error) {
+
+	endpointName := alphaName
+	if !alpha {
+		endpointName = betaName
+	}
+
+	versionStoreName := fmt.Sprintf("%s_%s", session, endpointName)
+
+	return filesystem.Doppelganger(false, versionsDirectoryName, versionStoreName)
+}
+
 func pathForStaging(root, path string, digest []byte) (string, string, error) {
 
 	if len(digest) == 0 {
@@ -14,8 +14,12 @@ import (
 
 const (
 	numberOfByteValues = 1 << 8
+
+	zeroDigestBufferSize = 1 << 15
 )
 
+var zeroDigestBuffer [zeroDigestBufferSize]byte
+
 type stagingSink struct {
 	stager *stager
 
@@ -28,6 +32,8 @@ type stagingSink struct {
 	maximumSize uint64
 
 	currentSize uint64
+
+	sparse bool
 }
 
 func (s *stagingSink) Write(data []byte) (int, error) {
@@ -45,8 +51,41 @@ func (s *stagingSink) Write(data []byte) (int, error) {
 	return n, err
 }
 
+func (s *stagingSink) WriteZeros(count uint64) error {
+
+	if s.maximumSize != 0 && (s.maximumSize-s.currentSize) < count {
+		return errors.New("maximum file size reached")
+	}
+
+	if _, err := s.storage.Seek(int64(count), io.SeekCurrent); err != nil {
+		return errors.Wrap(err, "unable to seek past zeros")
+	}
+
+	for remaining := count; remaining > 0; {
+		size := remaining
+		if size > zeroDigestBufferSize {
+			size = zeroDigestBufferSize
+		}
+		s.digester.Write(zeroDigestBuffer[:size])
+		remaining -= size
+	}
+
+	s.currentSize += count
+	s.sparse = true
+
+	return nil
+}
+
 func (s *stagingSink) Close() error {
 
+	if s.sparse {
+		if err := s.storage.Truncate(int64(s.currentSize)); err != nil {
+			s.storage.Close()
+			os.Remove(s.storage.Name())
+			return errors.Wrap(err, "unable to set sparse file size")
+		}
+	}
+
 	if err := s.storage.Close(); err != nil {
 		return errors.Wrap(err, "unable to close underlying storage")
 	}
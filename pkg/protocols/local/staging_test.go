@@ -0,0 +1,79 @@
+package local
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/RokyErickson/doppelganger/pkg/rsync"
+	"github.com/RokyErickson/doppelganger/pkg/session"
+)
+
+func TestStagingSinkSparseRoundTrip(t *testing.T) {
+	root, err := ioutil.TempDir("", "doppelganger_staging")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(root)
+
+	stager := newStager(session.Version_Version1, root, 0)
+
+	sink, err := stager.Sink("sparse")
+	if err != nil {
+		t.Fatal("unable to create staging sink:", err)
+	}
+	zeroWriter, ok := sink.(rsync.ZeroWriter)
+	if !ok {
+		t.Fatal("staging sink does not support writing zeros")
+	}
+
+	const (
+		leadingHole  = 1 << 16
+		middleHole   = 1 << 20
+		trailingHole = 1<<20 + 123
+	)
+	expected := &bytes.Buffer{}
+	write := func(data []byte) {
+		if _, err := sink.Write(data); err != nil {
+			t.Fatal("unable to write data:", err)
+		}
+		expected.Write(data)
+	}
+	writeZeros := func(count int) {
+		if err := zeroWriter.WriteZeros(uint64(count)); err != nil {
+			t.Fatal("unable to write zeros:", err)
+		}
+		expected.Write(make([]byte, count))
+	}
+	writeZeros(leadingHole)
+	write([]byte("head"))
+	writeZeros(middleHole)
+	write([]byte("tail"))
+	writeZeros(trailingHole)
+
+	if err := sink.Close(); err != nil {
+		t.Fatal("unable to close staging sink:", err)
+	}
+
+	hasher := session.Version_Version1.Hasher()
+	hasher.Write(expected.Bytes())
+	digest := hasher.Sum(nil)
+
+	path, err := stager.Provide("sparse", digest)
+	if err != nil {
+		t.Fatal("staged file not found at digest location:", err)
+	}
+
+	if info, err := os.Stat(path); err != nil {
+		t.Fatal("unable to query staged file metadata:", err)
+	} else if info.Size() != int64(expected.Len()) {
+		t.Error("staged file size mismatch:", info.Size(), "!=", expected.Len())
+	}
+
+	if contents, err := ioutil.ReadFile(path); err != nil {
+		t.Fatal("unable to read staged file:", err)
+	} else if !bytes.Equal(contents, expected.Bytes()) {
+		t.Error("staged file contents did not match expected")
+	}
+}
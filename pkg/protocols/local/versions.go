@@ -0,0 +1,213 @@
+package local
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/RokyErickson/doppelganger/pkg/encoding"
+	"github.com/RokyErickson/doppelganger/pkg/filesystem"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+const (
+	versionObjectsDirectoryName = "objects"
+
+	versionHistoriesDirectoryName = "histories"
+
+	versionStorePermissions os.FileMode = 0700
+
+	versionRestoreFileMode os.FileMode = 0644
+
+	versionTemporaryNamePrefix = filesystem.TemporaryNamePrefix + "version"
+)
+
+type versionStore struct {
+	root string
+}
+
+func newVersionStore(root string) *versionStore {
+	return &versionStore{root: root}
+}
+
+func (s *versionStore) objectPath(digest []byte) string {
+	return filepath.Join(s.root, versionObjectsDirectoryName, fmt.Sprintf("%x", digest))
+}
+
+func (s *versionStore) historyPath(path string) string {
+	return filepath.Join(s.root, versionHistoriesDirectoryName, fmt.Sprintf("%x", sha1.Sum([]byte(path))))
+}
+
+func loadVersionHistory(historyPath, path string) (*sync.FileVersionHistory, error) {
+	history := &sync.FileVersionHistory{}
+	if err := encoding.LoadAndUnmarshalProtobuf(historyPath, history); err != nil {
+		if os.IsNotExist(err) {
+			return &sync.FileVersionHistory{Path: path}, nil
+		}
+		return nil, errors.Wrap(err, "unable to load version history")
+	} else if err = history.EnsureValid(); err != nil {
+		return nil, errors.Wrap(err, "invalid version history")
+	}
+
+	return history, nil
+}
+
+func (s *versionStore) storeObject(objectPath string, open func() (io.ReadCloser, error)) error {
+	objectsPath := filepath.Dir(objectPath)
+	if err := os.MkdirAll(objectsPath, versionStorePermissions); err != nil {
+		return errors.Wrap(err, "unable to create version objects directory")
+	}
+
+	source, err := open()
+	if err != nil {
+		return errors.Wrap(err, "unable to open file")
+	}
+	defer source.Close()
+
+	temporary, err := ioutil.TempFile(objectsPath, versionTemporaryNamePrefix)
+	if err != nil {
+		return errors.Wrap(err, "unable to create temporary version file")
+	}
+
+	_, copyErr := io.Copy(temporary, source)
+	temporary.Close()
+	if copyErr != nil {
+		os.Remove(temporary.Name())
+		return errors.Wrap(copyErr, "unable to copy file contents")
+	}
+
+	if err := os.Rename(temporary.Name(), objectPath); err != nil {
+		os.Remove(temporary.Name())
+		return errors.Wrap(err, "unable to relocate version file")
+	}
+
+	return nil
+}
+
+func (s *versionStore) Record(path string, digest []byte, open func() (io.ReadCloser, error)) error {
+	objectPath := s.objectPath(digest)
+	if _, err := os.Lstat(objectPath); os.IsNotExist(err) {
+		if err := s.storeObject(objectPath, open); err != nil {
+			return errors.Wrap(err, "unable to store version contents")
+		}
+	} else if err != nil {
+		return errors.Wrap(err, "unable to check for existing version contents")
+	}
+
+	historyPath := s.historyPath(path)
+	history, err := loadVersionHistory(historyPath, path)
+	if err != nil {
+		return err
+	}
+
+	history.Versions = append(history.Versions, &sync.FileVersion{
+		Time:   ptypes.TimestampNow(),
+		Digest: digest,
+	})
+	history.Prune(time.Now())
+
+	if err := os.MkdirAll(filepath.Dir(historyPath), versionStorePermissions); err != nil {
+		return errors.Wrap(err, "unable to create version histories directory")
+	} else if err = encoding.MarshalAndSaveProtobuf(historyPath, history); err != nil {
+		return errors.Wrap(err, "unable to save version history")
+	}
+
+	return nil
+}
+
+func ListVersions(session string, alpha bool, path string) ([]*sync.FileVersion, error) {
+	root, err := pathForVersionStore(session, alpha)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to compute version store path")
+	}
+	store := newVersionStore(root)
+
+	history, err := loadVersionHistory(store.historyPath(path), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return history.Versions, nil
+}
+
+func RestoreVersion(session string, alpha bool, root, path, digest string) (*sync.FileVersion, error) {
+	versions, err := ListVersions(session, alpha, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var version *sync.FileVersion
+	for _, v := range versions {
+		if strings.HasPrefix(hex.EncodeToString(v.Digest), digest) {
+			version = v
+			break
+		}
+	}
+	if version == nil {
+		return nil, errors.New("no matching version found")
+	}
+
+	storeRoot, err := pathForVersionStore(session, alpha)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to compute version store path")
+	}
+	store := newVersionStore(storeRoot)
+
+	root, err = filesystem.Normalize(root)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to normalize root path")
+	}
+	target := filepath.Join(root, filepath.FromSlash(path))
+	if relative, err := filepath.Rel(root, target); err != nil || relative == "." ||
+		relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return nil, errors.New("restoration path is outside synchronization root")
+	}
+
+	mode := versionRestoreFileMode
+	if info, err := os.Lstat(target); err == nil {
+		if !info.Mode().IsRegular() {
+			return nil, errors.New("restoration target exists and is not a file")
+		}
+		mode = info.Mode().Perm()
+	} else if !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "unable to query restoration target")
+	}
+
+	source, err := os.Open(store.objectPath(version.Digest))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open version contents")
+	}
+	defer source.Close()
+
+	temporary, err := ioutil.TempFile(filepath.Dir(target), versionTemporaryNamePrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create temporary file")
+	}
+
+	_, copyErr := io.Copy(temporary, source)
+	temporary.Close()
+	if copyErr != nil {
+		os.Remove(temporary.Name())
+		return nil, errors.Wrap(copyErr, "unable to copy version contents")
+	}
+
+	if err := os.Chmod(temporary.Name(), mode); err != nil {
+		os.Remove(temporary.Name())
+		return nil, errors.Wrap(err, "unable to set restored file permissions")
+	} else if err = os.Rename(temporary.Name(), target); err != nil {
+		os.Remove(temporary.Name())
+		return nil, errors.Wrap(err, "unable to relocate restored file")
+	}
+
+	return version, nil
+}
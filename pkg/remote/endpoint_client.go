@@ -148,7 +148,7 @@ func (e *endpointClient) Poll(context contextpkg.Context) error {
 	return nil
 }
 
-func (e *endpointClient) Scan(ancestor *sync.Entry) (*sync.Entry, bool, error, bool) {
+func (e *endpointClient) Scan(ancestor *sync.Entry) (*sync.Entry, bool, []string, []*sync.Problem, error, bool) {
 
 	engine := rsync.NewEngine()
 	var baseBytes []byte
@@ -158,7 +158,7 @@ func (e *endpointClient) Scan(ancestor *sync.Entry) (*sync.Entry, bool, error, b
 		buffer := proto.NewBuffer(nil)
 		buffer.SetDeterministic(true)
 		if err := buffer.Marshal(&sync.Archive{Root: ancestor}); err != nil {
-			return nil, false, errors.Wrap(err, "unable to marshal ancestor"), false
+			return nil, false, nil, nil, errors.Wrap(err, "unable to marshal ancestor"), false
 		}
 		baseBytes = buffer.Bytes()
 	}
@@ -171,38 +171,38 @@ func (e *endpointClient) Scan(ancestor *sync.Entry) (*sync.Entry, bool, error, b
 		},
 	}
 	if err := e.encoder.Encode(request); err != nil {
-		return nil, false, errors.Wrap(err, "unable to send scan request"), false
+		return nil, false, nil, nil, errors.Wrap(err, "unable to send scan request"), false
 	}
 
 	response := &ScanResponse{}
 	if err := e.decoder.Decode(response); err != nil {
-		return nil, false, errors.Wrap(err, "unable to receive scan response"), false
+		return nil, false, nil, nil, errors.Wrap(err, "unable to receive scan response"), false
 	} else if err = response.ensureValid(); err != nil {
-		return nil, false, errors.Wrap(err, "invalid scan response"), false
+		return nil, false, nil, nil, errors.Wrap(err, "invalid scan response"), false
 	}
 
 	if response.TryAgain {
-		return nil, false, errors.New(response.Error), true
+		return nil, false, nil, nil, errors.New(response.Error), true
 	}
 
 	snapshotBytes, err := engine.PatchBytes(baseBytes, baseSignature, response.SnapshotDelta)
 	if err != nil {
-		return nil, false, errors.Wrap(err, "unable to patch base snapshot"), false
+		return nil, false, nil, nil, errors.Wrap(err, "unable to patch base snapshot"), false
 	}
 
 	archive := &sync.Archive{}
 	if err := proto.Unmarshal(snapshotBytes, archive); err != nil {
-		return nil, false, errors.Wrap(err, "unable to unmarshal snapshot"), false
+		return nil, false, nil, nil, errors.Wrap(err, "unable to unmarshal snapshot"), false
 	}
 	snapshot := archive.Root
 
 	if err = snapshot.EnsureValid(); err != nil {
-		return nil, false, errors.Wrap(err, "invalid snapshot received"), false
+		return nil, false, nil, nil, errors.Wrap(err, "invalid snapshot received"), false
 	}
 
 	e.lastSnapshotBytes = snapshotBytes
 
-	return snapshot, response.PreservesExecutability, nil, false
+	return snapshot, response.PreservesExecutability, response.Excluded, response.Problems, nil, false
 }
 
 func (e *endpointClient) Stage(paths []string, digests [][]byte) ([]string, []*rsync.Signature, rsync.Receiver, error) {
@@ -92,11 +92,19 @@ func (r *ScanResponse) ensureValid() error {
 		}
 	}
 
+	for _, problem := range r.Problems {
+		if err := problem.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid problem returned")
+		}
+	}
+
 	if r.Error != "" {
 		if len(r.SnapshotDelta) > 0 {
 			return errors.New("non-empty snapshot delta present on error")
 		} else if r.PreservesExecutability {
 			return errors.New("executability preservation information present on error")
+		} else if len(r.Excluded) > 0 || len(r.Problems) > 0 {
+			return errors.New("scan details present on error")
 		}
 	}
 
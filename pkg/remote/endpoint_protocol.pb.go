@@ -31,7 +31,7 @@ func (m *InitializeRequest) Reset()         { *m = InitializeRequest{} }
 func (m *InitializeRequest) String() string { return proto.CompactTextString(m) }
 func (*InitializeRequest) ProtoMessage()    {}
 func (*InitializeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{0}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{0}
 }
 func (m *InitializeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitializeRequest.Unmarshal(m, b)
@@ -97,7 +97,7 @@ func (m *InitializeResponse) Reset()         { *m = InitializeResponse{} }
 func (m *InitializeResponse) String() string { return proto.CompactTextString(m) }
 func (*InitializeResponse) ProtoMessage()    {}
 func (*InitializeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{1}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{1}
 }
 func (m *InitializeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitializeResponse.Unmarshal(m, b)
@@ -134,7 +134,7 @@ func (m *PollRequest) Reset()         { *m = PollRequest{} }
 func (m *PollRequest) String() string { return proto.CompactTextString(m) }
 func (*PollRequest) ProtoMessage()    {}
 func (*PollRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{2}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{2}
 }
 func (m *PollRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PollRequest.Unmarshal(m, b)
@@ -164,7 +164,7 @@ func (m *PollCompletionRequest) Reset()         { *m = PollCompletionRequest{} }
 func (m *PollCompletionRequest) String() string { return proto.CompactTextString(m) }
 func (*PollCompletionRequest) ProtoMessage()    {}
 func (*PollCompletionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{3}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{3}
 }
 func (m *PollCompletionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PollCompletionRequest.Unmarshal(m, b)
@@ -195,7 +195,7 @@ func (m *PollResponse) Reset()         { *m = PollResponse{} }
 func (m *PollResponse) String() string { return proto.CompactTextString(m) }
 func (*PollResponse) ProtoMessage()    {}
 func (*PollResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{4}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{4}
 }
 func (m *PollResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PollResponse.Unmarshal(m, b)
@@ -233,7 +233,7 @@ func (m *ScanRequest) Reset()         { *m = ScanRequest{} }
 func (m *ScanRequest) String() string { return proto.CompactTextString(m) }
 func (*ScanRequest) ProtoMessage()    {}
 func (*ScanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{5}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{5}
 }
 func (m *ScanRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScanRequest.Unmarshal(m, b)
@@ -265,6 +265,8 @@ type ScanResponse struct {
 	PreservesExecutability bool               `protobuf:"varint,2,opt,name=preservesExecutability,proto3" json:"preservesExecutability,omitempty"`
 	Error                  string             `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	TryAgain               bool               `protobuf:"varint,4,opt,name=tryAgain,proto3" json:"tryAgain,omitempty"`
+	Excluded               []string           `protobuf:"bytes,5,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	Problems               []*sync.Problem    `protobuf:"bytes,6,rep,name=problems,proto3" json:"problems,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
 	XXX_unrecognized       []byte             `json:"-"`
 	XXX_sizecache          int32              `json:"-"`
@@ -274,7 +276,7 @@ func (m *ScanResponse) Reset()         { *m = ScanResponse{} }
 func (m *ScanResponse) String() string { return proto.CompactTextString(m) }
 func (*ScanResponse) ProtoMessage()    {}
 func (*ScanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{6}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{6}
 }
 func (m *ScanResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScanResponse.Unmarshal(m, b)
@@ -322,6 +324,20 @@ func (m *ScanResponse) GetTryAgain() bool {
 	return false
 }
 
+func (m *ScanResponse) GetExcluded() []string {
+	if m != nil {
+		return m.Excluded
+	}
+	return nil
+}
+
+func (m *ScanResponse) GetProblems() []*sync.Problem {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
+}
+
 type StageRequest struct {
 	Paths                []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
 	Digests              [][]byte `protobuf:"bytes,2,rep,name=digests,proto3" json:"digests,omitempty"`
@@ -334,7 +350,7 @@ func (m *StageRequest) Reset()         { *m = StageRequest{} }
 func (m *StageRequest) String() string { return proto.CompactTextString(m) }
 func (*StageRequest) ProtoMessage()    {}
 func (*StageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{7}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{7}
 }
 func (m *StageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StageRequest.Unmarshal(m, b)
@@ -381,7 +397,7 @@ func (m *StageResponse) Reset()         { *m = StageResponse{} }
 func (m *StageResponse) String() string { return proto.CompactTextString(m) }
 func (*StageResponse) ProtoMessage()    {}
 func (*StageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{8}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{8}
 }
 func (m *StageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StageResponse.Unmarshal(m, b)
@@ -434,7 +450,7 @@ func (m *SupplyRequest) Reset()         { *m = SupplyRequest{} }
 func (m *SupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*SupplyRequest) ProtoMessage()    {}
 func (*SupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{9}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{9}
 }
 func (m *SupplyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SupplyRequest.Unmarshal(m, b)
@@ -479,7 +495,7 @@ func (m *TransitionRequest) Reset()         { *m = TransitionRequest{} }
 func (m *TransitionRequest) String() string { return proto.CompactTextString(m) }
 func (*TransitionRequest) ProtoMessage()    {}
 func (*TransitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{10}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{10}
 }
 func (m *TransitionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransitionRequest.Unmarshal(m, b)
@@ -519,7 +535,7 @@ func (m *TransitionResponse) Reset()         { *m = TransitionResponse{} }
 func (m *TransitionResponse) String() string { return proto.CompactTextString(m) }
 func (*TransitionResponse) ProtoMessage()    {}
 func (*TransitionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{11}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{11}
 }
 func (m *TransitionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransitionResponse.Unmarshal(m, b)
@@ -575,7 +591,7 @@ func (m *EndpointRequest) Reset()         { *m = EndpointRequest{} }
 func (m *EndpointRequest) String() string { return proto.CompactTextString(m) }
 func (*EndpointRequest) ProtoMessage()    {}
 func (*EndpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_endpoint_protocol_319325c6a9243c1e, []int{12}
+	return fileDescriptor_endpoint_protocol_1de406b47eb9bae9, []int{12}
 }
 func (m *EndpointRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndpointRequest.Unmarshal(m, b)
@@ -647,51 +663,52 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("remote/endpoint_protocol.proto", fileDescriptor_endpoint_protocol_319325c6a9243c1e)
-}
-
-var fileDescriptor_endpoint_protocol_319325c6a9243c1e = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x6b, 0x13, 0x41,
-	0x10, 0xc7, 0xb9, 0xa6, 0x69, 0xd3, 0x49, 0xa2, 0xed, 0xda, 0xd4, 0x58, 0x41, 0xc2, 0x21, 0x36,
-	0x16, 0x7a, 0x91, 0x08, 0x05, 0x41, 0x84, 0x1a, 0x2b, 0xf8, 0x64, 0xd9, 0xf8, 0x03, 0x7c, 0x91,
-	0xcd, 0x75, 0xbd, 0x2c, 0x5e, 0x76, 0xcf, 0xdd, 0xbd, 0x60, 0x7c, 0xf0, 0x6f, 0xf2, 0x5f, 0xf0,
-	0xcf, 0xf2, 0x4d, 0xb2, 0x3f, 0xae, 0x1b, 0x4c, 0x0b, 0x3e, 0xdd, 0xcd, 0xcc, 0x67, 0xbf, 0xbb,
-	0x33, 0xb3, 0x3b, 0xf0, 0x40, 0xd2, 0x99, 0xd0, 0x74, 0x40, 0xf9, 0x65, 0x21, 0x18, 0xd7, 0x9f,
-	0x0b, 0x29, 0xb4, 0x48, 0x45, 0x9e, 0x98, 0x1f, 0xb4, 0x65, 0xe3, 0x87, 0x48, 0xaa, 0x05, 0x4f,
-	0x07, 0x94, 0x67, 0x8c, 0x53, 0x1b, 0x3b, 0xbc, 0xaf, 0xa8, 0x52, 0x4c, 0xf0, 0x41, 0x2a, 0xf8,
-	0x17, 0x96, 0x95, 0x92, 0x68, 0x26, 0xb8, 0x0b, 0x76, 0x7c, 0xd0, 0x7d, 0x9d, 0x1b, 0x19, 0x19,
-	0x22, 0xd3, 0x29, 0x9b, 0x7b, 0x9d, 0x3d, 0xe3, 0x4b, 0xa7, 0x84, 0x67, 0x74, 0x05, 0x2b, 0xa4,
-	0x98, 0xe4, 0x74, 0x66, 0x7d, 0xf1, 0xef, 0x08, 0xf6, 0xde, 0x70, 0xa6, 0x19, 0xc9, 0xd9, 0x0f,
-	0x8a, 0xe9, 0xb7, 0x92, 0x2a, 0x8d, 0x10, 0x6c, 0x4a, 0x21, 0x74, 0x37, 0xea, 0x45, 0xfd, 0x1d,
-	0x6c, 0xfe, 0x51, 0x17, 0xb6, 0xdd, 0xae, 0xdd, 0x0d, 0xe3, 0xf6, 0x26, 0x3a, 0x86, 0xed, 0x39,
-	0x95, 0x26, 0x52, 0xeb, 0x45, 0xfd, 0x5b, 0xc3, 0xdd, 0xc4, 0x9f, 0xef, 0x83, 0xf5, 0x63, 0x0f,
-	0xa0, 0xe7, 0xd0, 0x5e, 0x49, 0xac, 0xbb, 0xd9, 0x8b, 0xfa, 0xcd, 0xe1, 0x41, 0xb5, 0x62, 0x14,
-	0x46, 0xf1, 0x2a, 0x8c, 0xf6, 0xa1, 0x4e, 0xf2, 0x62, 0x4a, 0xba, 0xf5, 0x5e, 0xd4, 0x6f, 0x60,
-	0x6b, 0xc4, 0xc7, 0x80, 0xc2, 0x14, 0x54, 0x21, 0xb8, 0xa2, 0x4b, 0x96, 0x4a, 0x29, 0xa4, 0x4b,
-	0xc2, 0x1a, 0x71, 0x1b, 0x9a, 0x17, 0x22, 0xcf, 0x5d, 0xa2, 0xf1, 0x5d, 0xe8, 0x2c, 0xcd, 0x91,
-	0x98, 0x15, 0x39, 0x35, 0x3b, 0xba, 0xc0, 0x43, 0x68, 0x59, 0xee, 0x46, 0xb5, 0xf7, 0xd0, 0x1c,
-	0xa7, 0xc4, 0x2f, 0x42, 0xaf, 0xa1, 0x33, 0x21, 0x8a, 0x8e, 0x39, 0x29, 0xd4, 0x54, 0xe8, 0x31,
-	0xcb, 0x38, 0xd1, 0xa5, 0xa4, 0x66, 0x51, 0x73, 0xb8, 0x9b, 0x98, 0x7e, 0x27, 0x95, 0x1f, 0xaf,
-	0xc7, 0xe3, 0x5f, 0x11, 0xb4, 0xac, 0xae, 0xdb, 0xfd, 0x14, 0xda, 0xca, 0x51, 0xaf, 0x68, 0xae,
-	0x49, 0x37, 0xea, 0xd5, 0x02, 0xc1, 0xb7, 0x05, 0xf5, 0xf5, 0x5a, 0xc1, 0xd0, 0x29, 0x1c, 0x14,
-	0x92, 0x2a, 0x2a, 0xe7, 0x54, 0x9d, 0x7f, 0xa7, 0x69, 0xa9, 0xc9, 0x84, 0xe5, 0x4c, 0x2f, 0x4c,
-	0x0b, 0x1b, 0xf8, 0x9a, 0xe8, 0x55, 0xb6, 0xb5, 0x20, 0x5b, 0x74, 0x08, 0x0d, 0x2d, 0x17, 0x67,
-	0x19, 0x61, 0xb6, 0x6d, 0x0d, 0x5c, 0xd9, 0xf1, 0x0b, 0x68, 0x8d, 0x35, 0xc9, 0xaa, 0x1b, 0xb4,
-	0x0f, 0xf5, 0x82, 0xe8, 0xa9, 0x32, 0x27, 0xdd, 0xc1, 0xd6, 0x58, 0xde, 0xa1, 0x4b, 0x96, 0x51,
-	0xa5, 0x55, 0x77, 0xa3, 0x57, 0xeb, 0xb7, 0xb0, 0x37, 0xe3, 0x19, 0xb4, 0xdd, 0xfa, 0xab, 0x82,
-	0xaf, 0x11, 0x78, 0x02, 0xa0, 0x7c, 0x99, 0xac, 0xc6, 0xba, 0xb2, 0x06, 0xcc, 0xfa, 0x54, 0xe2,
-	0x8f, 0xd0, 0x1e, 0x97, 0x45, 0x91, 0x2f, 0x6e, 0x3e, 0xef, 0x7f, 0x6f, 0x17, 0x8f, 0x60, 0xef,
-	0x9d, 0x24, 0x5c, 0xb1, 0xe0, 0x32, 0xa1, 0x04, 0x9a, 0xba, 0x72, 0x2a, 0xd7, 0xbc, 0x56, 0x62,
-	0x64, 0x46, 0xe6, 0x85, 0xe2, 0x10, 0x88, 0x7f, 0x02, 0x0a, 0x45, 0x5c, 0x45, 0x8e, 0x60, 0x5b,
-	0x52, 0x55, 0xe6, 0xda, 0x2b, 0xb4, 0xad, 0xc2, 0x99, 0x7d, 0xf7, 0xd8, 0x47, 0xd1, 0x63, 0x68,
-	0xb8, 0x47, 0xee, 0xcf, 0xec, 0xc8, 0x0b, 0xeb, 0xc5, 0x55, 0xf8, 0x9a, 0xea, 0xfc, 0x89, 0xe0,
-	0xf6, 0xb9, 0x9b, 0x5d, 0x3e, 0x87, 0x23, 0xd8, 0x2c, 0x44, 0x9e, 0xbb, 0xab, 0x7c, 0x27, 0xb1,
-	0x23, 0x2c, 0x09, 0x1e, 0x13, 0x36, 0xc0, 0x12, 0x54, 0x29, 0xb1, 0x43, 0x22, 0x00, 0x83, 0x77,
-	0x82, 0x0d, 0x80, 0x8e, 0xa1, 0xae, 0x96, 0x2d, 0x37, 0x7b, 0x37, 0x87, 0xfb, 0x15, 0x19, 0xdc,
-	0x23, 0x6c, 0x11, 0x74, 0x02, 0x5b, 0xca, 0xf4, 0xcb, 0xcd, 0x8b, 0x4e, 0x05, 0x87, 0x5d, 0xc4,
-	0x0e, 0x42, 0xcf, 0x00, 0xae, 0xea, 0x69, 0x86, 0x45, 0x73, 0x78, 0xcf, 0x2f, 0xf9, 0xa7, 0x3f,
-	0x38, 0x80, 0x5f, 0xf6, 0x3f, 0x3d, 0xca, 0x98, 0x9e, 0x96, 0x93, 0x24, 0x15, 0xb3, 0xc1, 0x94,
-	0xcc, 0x45, 0x7a, 0xc2, 0xc4, 0x60, 0x56, 0x2e, 0xcf, 0xc1, 0x07, 0xc5, 0xd7, 0x6c, 0x60, 0x75,
-	0x26, 0x5b, 0x66, 0x82, 0x3e, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x87, 0xeb, 0x80, 0x67, 0xee,
-	0x05, 0x00, 0x00,
+	proto.RegisterFile("remote/endpoint_protocol.proto", fileDescriptor_endpoint_protocol_1de406b47eb9bae9)
+}
+
+var fileDescriptor_endpoint_protocol_1de406b47eb9bae9 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdf, 0x6b, 0x1b, 0x39,
+	0x10, 0x66, 0xe3, 0xd8, 0x71, 0xc6, 0xf6, 0x5d, 0xa2, 0x8b, 0x73, 0x7b, 0x39, 0x28, 0x66, 0x29,
+	0xc4, 0x0d, 0x74, 0xb7, 0x75, 0x21, 0x50, 0x28, 0x85, 0xd4, 0x4d, 0xa1, 0x4f, 0x0d, 0x72, 0x7f,
+	0x40, 0x5f, 0x8a, 0xbc, 0x56, 0xd7, 0x22, 0x6b, 0x49, 0x95, 0xb4, 0x21, 0xee, 0x43, 0xff, 0xb7,
+	0xfe, 0x55, 0xa5, 0x6f, 0xc5, 0x92, 0x76, 0xb3, 0xa6, 0x4e, 0xa0, 0x4f, 0xbb, 0x33, 0xdf, 0xa7,
+	0x19, 0xcd, 0x7c, 0xa3, 0x81, 0x7b, 0x8a, 0x2e, 0x84, 0xa1, 0x09, 0xe5, 0x33, 0x29, 0x18, 0x37,
+	0x9f, 0xa4, 0x12, 0x46, 0xa4, 0x22, 0x8f, 0xed, 0x0f, 0x6a, 0x39, 0xfc, 0x08, 0x29, 0xbd, 0xe4,
+	0x69, 0x42, 0x79, 0xc6, 0x38, 0x75, 0xd8, 0xd1, 0xff, 0x9a, 0x6a, 0xcd, 0x04, 0x4f, 0x52, 0xc1,
+	0x3f, 0xb3, 0xac, 0x50, 0xc4, 0x30, 0xc1, 0x3d, 0xd8, 0x2f, 0x41, 0xff, 0xf5, 0x6e, 0x64, 0xc3,
+	0x10, 0x95, 0xce, 0xd9, 0x55, 0x19, 0x67, 0xdf, 0xfa, 0xd2, 0x39, 0xe1, 0x19, 0x5d, 0xa3, 0x49,
+	0x25, 0xa6, 0x39, 0x5d, 0x38, 0x5f, 0xf4, 0x3d, 0x80, 0xfd, 0xd7, 0x9c, 0x19, 0x46, 0x72, 0xf6,
+	0x95, 0x62, 0xfa, 0xa5, 0xa0, 0xda, 0x20, 0x04, 0xdb, 0x4a, 0x08, 0x13, 0x06, 0x83, 0x60, 0xb8,
+	0x8b, 0xed, 0x3f, 0x0a, 0x61, 0xc7, 0x67, 0x0d, 0xb7, 0xac, 0xbb, 0x34, 0xd1, 0x09, 0xec, 0x5c,
+	0x51, 0x65, 0x91, 0xc6, 0x20, 0x18, 0xfe, 0x35, 0xda, 0x8b, 0xcb, 0xfb, 0xbd, 0x77, 0x7e, 0x5c,
+	0x12, 0xd0, 0x33, 0xe8, 0xad, 0x15, 0x16, 0x6e, 0x0f, 0x82, 0x61, 0x67, 0x74, 0x58, 0x9d, 0x18,
+	0xd7, 0x51, 0xbc, 0x4e, 0x46, 0x07, 0xd0, 0x24, 0xb9, 0x9c, 0x93, 0xb0, 0x39, 0x08, 0x86, 0x6d,
+	0xec, 0x8c, 0xe8, 0x04, 0x50, 0xbd, 0x04, 0x2d, 0x05, 0xd7, 0x74, 0xc5, 0xa5, 0x4a, 0x09, 0xe5,
+	0x8b, 0x70, 0x46, 0xd4, 0x83, 0xce, 0x85, 0xc8, 0x73, 0x5f, 0x68, 0xf4, 0x2f, 0xf4, 0x57, 0xe6,
+	0x58, 0x2c, 0x64, 0x4e, 0x6d, 0x46, 0x0f, 0xdc, 0x87, 0xae, 0xe3, 0xdd, 0x19, 0xed, 0x1d, 0x74,
+	0x26, 0x29, 0x29, 0x0f, 0xa1, 0x57, 0xd0, 0x9f, 0x12, 0x4d, 0x27, 0x9c, 0x48, 0x3d, 0x17, 0x66,
+	0xc2, 0x32, 0x4e, 0x4c, 0xa1, 0xa8, 0x3d, 0xd4, 0x19, 0xed, 0xc5, 0x56, 0xef, 0xb8, 0xf2, 0xe3,
+	0xcd, 0xf4, 0xe8, 0x47, 0x00, 0x5d, 0x17, 0xd7, 0x67, 0x3f, 0x85, 0x9e, 0xf6, 0xac, 0x97, 0x34,
+	0x37, 0x24, 0x0c, 0x06, 0x8d, 0x5a, 0xc0, 0x37, 0x92, 0x96, 0xfd, 0x5a, 0xa3, 0xa1, 0x53, 0x38,
+	0x94, 0x8a, 0x6a, 0xaa, 0xae, 0xa8, 0x3e, 0xbf, 0xa6, 0x69, 0x61, 0xc8, 0x94, 0xe5, 0xcc, 0x2c,
+	0xad, 0x84, 0x6d, 0x7c, 0x0b, 0x7a, 0x53, 0x6d, 0xa3, 0x56, 0x2d, 0x3a, 0x82, 0xb6, 0x51, 0xcb,
+	0xb3, 0x8c, 0x30, 0x27, 0x5b, 0x1b, 0x57, 0xf6, 0x0a, 0xa3, 0xd7, 0x69, 0x5e, 0xcc, 0xe8, 0x2c,
+	0x6c, 0x0e, 0x1a, 0xc3, 0x5d, 0x5c, 0xd9, 0xe8, 0x01, 0xb4, 0xfd, 0xd0, 0xe9, 0xb0, 0x65, 0x2f,
+	0xde, 0x8b, 0xed, 0xbd, 0x2f, 0x9c, 0x17, 0x57, 0x70, 0xf4, 0x1c, 0xba, 0x13, 0x43, 0xb2, 0x6a,
+	0x10, 0x0f, 0xa0, 0x29, 0x89, 0x99, 0x6b, 0x5b, 0xf0, 0x2e, 0x76, 0xc6, 0x6a, 0x14, 0x67, 0x2c,
+	0xa3, 0xda, 0xe8, 0x70, 0x6b, 0xd0, 0x18, 0x76, 0x71, 0x69, 0x46, 0x0b, 0xe8, 0xf9, 0xf3, 0x37,
+	0xba, 0x6d, 0x08, 0xf0, 0x08, 0x40, 0x97, 0xdd, 0x76, 0x31, 0x36, 0xa9, 0x53, 0xe3, 0x6c, 0xee,
+	0x48, 0xf4, 0x01, 0x7a, 0x93, 0x42, 0xca, 0x7c, 0x79, 0xf7, 0x7d, 0xff, 0x38, 0x5d, 0x34, 0x86,
+	0xfd, 0xb7, 0x8a, 0x70, 0xcd, 0x6a, 0x33, 0x89, 0x62, 0xe8, 0x98, 0xca, 0xa9, 0xfd, 0x0c, 0x74,
+	0x5d, 0x2b, 0xc7, 0xf6, 0xa1, 0xe3, 0x3a, 0x21, 0xfa, 0x06, 0xa8, 0x1e, 0xc4, 0x77, 0xe4, 0x18,
+	0x76, 0x14, 0xd5, 0x45, 0x6e, 0xca, 0x08, 0x5e, 0x8c, 0x33, 0xb7, 0x3e, 0x70, 0x89, 0xae, 0xc9,
+	0xb6, 0x75, 0xa7, 0x6c, 0xb7, 0x74, 0xe7, 0x67, 0x00, 0x7f, 0x9f, 0xfb, 0x15, 0x58, 0xd6, 0x70,
+	0x0c, 0xdb, 0x52, 0xe4, 0xb9, 0x7f, 0x11, 0xff, 0xc4, 0x6e, 0x13, 0xc6, 0xb5, 0x37, 0x89, 0x2d,
+	0x61, 0x45, 0xd4, 0x29, 0x71, 0xbb, 0xa6, 0x46, 0xac, 0x3d, 0x37, 0x6c, 0x09, 0xe8, 0x04, 0x9a,
+	0x7a, 0x25, 0xb9, 0xcd, 0xdd, 0x19, 0x1d, 0x54, 0xcc, 0xda, 0x1c, 0x61, 0x47, 0x41, 0x0f, 0xa1,
+	0xa5, 0xad, 0x5e, 0x7e, 0xed, 0xf4, 0x2b, 0x72, 0x5d, 0x45, 0xec, 0x49, 0xe8, 0x29, 0xc0, 0x4d,
+	0x3f, 0xed, 0xce, 0xe9, 0x8c, 0xfe, 0x2b, 0x8f, 0xfc, 0xa6, 0x0f, 0xae, 0x91, 0x5f, 0x3c, 0xfe,
+	0x98, 0x64, 0xcc, 0xcc, 0x8b, 0x69, 0x9c, 0x8a, 0x45, 0x82, 0xc5, 0xe5, 0xf2, 0x5c, 0xb1, 0xf4,
+	0x52, 0x0b, 0x9e, 0xcc, 0x84, 0x94, 0x34, 0xcf, 0x56, 0x7a, 0xa9, 0x44, 0x5e, 0x66, 0x89, 0x0b,
+	0x38, 0x6d, 0xd9, 0x8d, 0xfc, 0xe4, 0xd7, 0x00, 0x3b, 0x98, 0xf7, 0x19, 0x3e, 0x06, 0x00, 0x00,
 }
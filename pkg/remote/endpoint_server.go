@@ -230,7 +230,7 @@ func (s *endpointServer) serveScan(request *ScanRequest) error {
 		return errors.Wrap(err, "invalid scan request")
 	}
 
-	snapshot, preservesExecutability, err, tryAgain := s.endpoint.Scan(nil)
+	snapshot, preservesExecutability, excluded, problems, err, tryAgain := s.endpoint.Scan(nil)
 	if tryAgain {
 		response := &ScanResponse{
 			Error:    err.Error(),
@@ -259,6 +259,8 @@ func (s *endpointServer) serveScan(request *ScanRequest) error {
 	response := &ScanResponse{
 		SnapshotDelta:          delta,
 		PreservesExecutability: preservesExecutability,
+		Excluded:               excluded,
+		Problems:               problems,
 	}
 	if err := s.encoder.Encode(response); err != nil {
 		return errors.Wrap(err, "unable to send scan response")
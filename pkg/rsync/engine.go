@@ -74,6 +74,14 @@ func (o *Operation) EnsureValid() error {
 			return errors.New("data operation with non-0 block start index")
 		} else if o.Count != 0 {
 			return errors.New("data operation with non-0 block count")
+		} else if o.Zeros != 0 {
+			return errors.New("data operation with non-0 zero count")
+		}
+	} else if o.Zeros > 0 {
+		if o.Start != 0 {
+			return errors.New("zero operation with non-0 block start index")
+		} else if o.Count != 0 {
+			return errors.New("zero operation with non-0 block count")
 		}
 	} else if o.Count == 0 {
 		return errors.New("block operation with 0 block count")
@@ -94,6 +102,7 @@ func (o *Operation) Copy() *Operation {
 		Data:  data,
 		Start: o.Start,
 		Count: o.Count,
+		Zeros: o.Zeros,
 	}
 }
 
@@ -103,10 +112,11 @@ func (o *Operation) resetToZeroMaintainingCapacity() {
 
 	o.Start = 0
 	o.Count = 0
+	o.Zeros = 0
 }
 
 func (o *Operation) isZeroValue() bool {
-	return len(o.Data) == 0 && o.Start == 0 && o.Count == 0
+	return len(o.Data) == 0 && o.Start == 0 && o.Count == 0 && o.Zeros == 0
 }
 
 const (
@@ -114,6 +124,7 @@ const (
 	maximumOptimalBlockSize         = 1 << 16
 	DefaultBlockSize                = 1 << 13
 	DefaultMaximumDataOperationSize = 1 << 14
+	minimumZeroRunLength            = 1 << 12
 )
 
 func OptimalBlockSizeForBaseLength(baseLength uint64) uint64 {
@@ -146,12 +157,19 @@ func OptimalBlockSizeForBase(base io.Seeker) (uint64, error) {
 
 type OperationTransmitter func(*Operation) error
 
+type ZeroWriter interface {
+	WriteZeros(count uint64) error
+}
+
 type Engine struct {
 	buffer           []byte
 	strongHasher     hash.Hash
 	strongHashBuffer []byte
 	targetReader     *bufio.Reader
 	operation        *Operation
+	detectZeros      bool
+	zeros            uint64
+	zeroBuffer       []byte
 }
 
 func NewEngine() *Engine {
@@ -288,16 +306,78 @@ func min(a, b uint64) uint64 {
 	return b
 }
 
+func zeroRunLength(data []byte) int {
+	for i, b := range data {
+		if b != 0 {
+			return i
+		}
+	}
+	return len(data)
+}
+
+func nextZeroRun(data []byte) int {
+	run := 0
+	for i, b := range data {
+		if b != 0 {
+			run = 0
+		} else if run++; run == minimumZeroRunLength {
+			return i + 1 - run
+		}
+	}
+	return len(data)
+}
+
 func (e *Engine) transmitData(data []byte, transmit OperationTransmitter) error {
+	if !e.detectZeros {
+		*e.operation = Operation{
+			Data: data,
+		}
+		return transmit(e.operation)
+	}
+
+	for len(data) > 0 {
+		if zeros := zeroRunLength(data); zeros >= minimumZeroRunLength || (zeros == len(data) && e.zeros > 0) {
+			e.zeros += uint64(zeros)
+			data = data[zeros:]
+			continue
+		}
+
+		if err := e.flushZeros(transmit); err != nil {
+			return err
+		}
+
+		end := nextZeroRun(data)
+		*e.operation = Operation{
+			Data: data[:end],
+		}
+		if err := transmit(e.operation); err != nil {
+			return err
+		}
+		data = data[end:]
+	}
+
+	return nil
+}
+
+func (e *Engine) flushZeros(transmit OperationTransmitter) error {
+	if e.zeros == 0 {
+		return nil
+	}
+
 	*e.operation = Operation{
-		Data: data,
+		Zeros: e.zeros,
 	}
+	e.zeros = 0
 
 	return transmit(e.operation)
 }
 
 func (e *Engine) transmitBlock(start, count uint64, transmit OperationTransmitter) error {
 
+	if err := e.flushZeros(transmit); err != nil {
+		return err
+	}
+
 	*e.operation = Operation{
 		Start: start,
 		Count: count,
@@ -307,6 +387,15 @@ func (e *Engine) transmitBlock(start, count uint64, transmit OperationTransmitte
 }
 
 func (e *Engine) chunkAndTransmitAll(target io.Reader, maxDataOpSize uint64, transmit OperationTransmitter) error {
+	if err := e.chunkAndTransmit(target, maxDataOpSize, transmit); err != nil {
+		return err
+	} else if err = e.flushZeros(transmit); err != nil {
+		return errors.Wrap(err, "unable to transmit zero operation")
+	}
+	return nil
+}
+
+func (e *Engine) chunkAndTransmit(target io.Reader, maxDataOpSize uint64, transmit OperationTransmitter) error {
 
 	if maxDataOpSize == 0 {
 		maxDataOpSize = DefaultMaximumDataOperationSize
@@ -481,6 +570,10 @@ func (e *Engine) Deltafy(target io.Reader, base *Signature, maxDataOpSize uint64
 		}
 	}
 
+	if err := e.flushZeros(transmit); err != nil {
+		return errors.Wrap(err, "unable to send final zero operation")
+	}
+
 	return nil
 }
 
@@ -502,6 +595,26 @@ func (e *Engine) DeltafyBytes(target []byte, base *Signature, maxDataOpSize uint
 	return delta
 }
 
+func (e *Engine) writeZeros(destination io.Writer, count uint64) error {
+	if zeroWriter, ok := destination.(ZeroWriter); ok {
+		return zeroWriter.WriteZeros(count)
+	}
+
+	if e.zeroBuffer == nil {
+		e.zeroBuffer = make([]byte, DefaultMaximumDataOperationSize)
+	}
+
+	for count > 0 {
+		size := min(count, uint64(len(e.zeroBuffer)))
+		if _, err := destination.Write(e.zeroBuffer[:size]); err != nil {
+			return err
+		}
+		count -= size
+	}
+
+	return nil
+}
+
 func (e *Engine) Patch(destination io.Writer, base io.ReadSeeker, signature *Signature, operation *Operation) error {
 
 	if len(operation.Data) > 0 {
@@ -509,6 +622,11 @@ func (e *Engine) Patch(destination io.Writer, base io.ReadSeeker, signature *Sig
 		if _, err := destination.Write(operation.Data); err != nil {
 			return errors.Wrap(err, "unable to write data")
 		}
+	} else if operation.Zeros > 0 {
+
+		if err := e.writeZeros(destination, operation.Zeros); err != nil {
+			return errors.Wrap(err, "unable to write zeros")
+		}
 	} else {
 
 		if _, err := base.Seek(int64(operation.Start)*int64(signature.BlockSize), io.SeekStart); err != nil {
@@ -25,7 +25,7 @@ func (m *BlockHash) Reset()         { *m = BlockHash{} }
 func (m *BlockHash) String() string { return proto.CompactTextString(m) }
 func (*BlockHash) ProtoMessage()    {}
 func (*BlockHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_engine_8893205e0be692e7, []int{0}
+	return fileDescriptor_engine_59034cee5805fcaa, []int{0}
 }
 func (m *BlockHash) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockHash.Unmarshal(m, b)
@@ -72,7 +72,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_engine_8893205e0be692e7, []int{1}
+	return fileDescriptor_engine_59034cee5805fcaa, []int{1}
 }
 func (m *Signature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Signature.Unmarshal(m, b)
@@ -117,6 +117,7 @@ type Operation struct {
 	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Start                uint64   `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
 	Count                uint64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Zeros                uint64   `protobuf:"varint,4,opt,name=zeros,proto3" json:"zeros,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -126,7 +127,7 @@ func (m *Operation) Reset()         { *m = Operation{} }
 func (m *Operation) String() string { return proto.CompactTextString(m) }
 func (*Operation) ProtoMessage()    {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_engine_8893205e0be692e7, []int{2}
+	return fileDescriptor_engine_59034cee5805fcaa, []int{2}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Operation.Unmarshal(m, b)
@@ -167,29 +168,37 @@ func (m *Operation) GetCount() uint64 {
 	return 0
 }
 
+func (m *Operation) GetZeros() uint64 {
+	if m != nil {
+		return m.Zeros
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BlockHash)(nil), "rsync.BlockHash")
 	proto.RegisterType((*Signature)(nil), "rsync.Signature")
 	proto.RegisterType((*Operation)(nil), "rsync.Operation")
 }
 
-func init() { proto.RegisterFile("rsync/engine.proto", fileDescriptor_engine_8893205e0be692e7) }
-
-var fileDescriptor_engine_8893205e0be692e7 = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x41, 0x4b, 0xf4, 0x30,
-	0x10, 0x86, 0xe9, 0x76, 0xb7, 0xd0, 0xf9, 0x76, 0xe1, 0x23, 0x88, 0xf4, 0xe0, 0xa1, 0x14, 0xc5,
-	0x5e, 0x6c, 0x41, 0x0f, 0xde, 0x7b, 0x12, 0x3c, 0x08, 0xdd, 0x9b, 0xb7, 0x69, 0x0d, 0x49, 0xe8,
-	0x6e, 0x52, 0x92, 0xa9, 0xb2, 0xfe, 0x7a, 0xe9, 0x6c, 0x59, 0xf1, 0x36, 0xef, 0x93, 0x49, 0xde,
-	0x87, 0x80, 0xf0, 0xe1, 0x64, 0xfb, 0x5a, 0x5a, 0x65, 0xac, 0xac, 0x46, 0xef, 0xc8, 0x89, 0x0d,
-	0xb3, 0xe2, 0x19, 0xd2, 0xe6, 0xe0, 0xfa, 0xe1, 0x05, 0x83, 0x16, 0x02, 0xd6, 0x5f, 0x12, 0x87,
-	0x2c, 0xca, 0xa3, 0x72, 0xd7, 0xf2, 0x2c, 0xae, 0x21, 0x09, 0xe4, 0x9d, 0x55, 0xd9, 0x2a, 0x8f,
-	0xca, 0x6d, 0xbb, 0xa4, 0xe2, 0x04, 0xe9, 0xde, 0x28, 0x8b, 0x34, 0x79, 0x29, 0x6e, 0x20, 0xed,
-	0xe6, 0x57, 0xf6, 0xe6, 0x5b, 0xf2, 0xed, 0x75, 0xfb, 0x0b, 0xc4, 0x2d, 0xec, 0x0e, 0x18, 0xa8,
-	0xb9, 0x6c, 0xac, 0x78, 0xe3, 0x2f, 0x14, 0x25, 0x24, 0x1a, 0x83, 0x96, 0x21, 0x8b, 0xf3, 0xb8,
-	0xfc, 0xf7, 0xf8, 0xbf, 0x62, 0xc3, 0xea, 0xa2, 0xd7, 0x2e, 0xe7, 0xc5, 0x2b, 0xa4, 0x6f, 0xa3,
-	0xf4, 0x48, 0xc6, 0xd9, 0xd9, 0xf9, 0x03, 0x09, 0xb9, 0x75, 0xdb, 0xf2, 0x2c, 0xae, 0x60, 0x13,
-	0x08, 0x3d, 0x2d, 0x45, 0xe7, 0x30, 0xd3, 0xde, 0x4d, 0x96, 0xb2, 0xf8, 0x4c, 0x39, 0x34, 0xf7,
-	0xef, 0x77, 0xca, 0x90, 0x9e, 0xba, 0xaa, 0x77, 0xc7, 0x5a, 0xe3, 0xa7, 0xeb, 0x1f, 0x8c, 0xab,
-	0x8f, 0x13, 0xa1, 0x92, 0xb6, 0x1e, 0x07, 0x55, 0xb3, 0x47, 0x97, 0xf0, 0xbf, 0x3d, 0xfd, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x54, 0xbc, 0x4e, 0x93, 0x4d, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("rsync/engine.proto", fileDescriptor_engine_59034cee5805fcaa) }
+
+var fileDescriptor_engine_59034cee5805fcaa = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x6b, 0x84, 0x30,
+	0x14, 0xc6, 0xf1, 0xf4, 0x04, 0xd3, 0x3b, 0x28, 0xa1, 0x14, 0x87, 0x0e, 0x22, 0x1d, 0x9c, 0xb4,
+	0xb4, 0x43, 0x77, 0xa1, 0xd0, 0xad, 0x90, 0xdb, 0xba, 0x3d, 0xbd, 0x10, 0x83, 0x36, 0x4f, 0x92,
+	0x48, 0xf1, 0xfe, 0xfa, 0xe2, 0x53, 0xae, 0xdc, 0xf6, 0xbe, 0x5f, 0x5e, 0xf2, 0xfd, 0x08, 0xe3,
+	0xd6, 0xcd, 0xa6, 0xad, 0xa4, 0x51, 0xda, 0xc8, 0x72, 0xb4, 0xe8, 0x91, 0xef, 0x89, 0xe5, 0xef,
+	0x2c, 0xa9, 0x07, 0x6c, 0xfb, 0x4f, 0x70, 0x1d, 0xe7, 0x2c, 0xfa, 0x95, 0xd0, 0xa7, 0x41, 0x16,
+	0x14, 0x47, 0x41, 0x33, 0x7f, 0x64, 0xb1, 0xf3, 0x16, 0x8d, 0x4a, 0x77, 0x59, 0x50, 0x1c, 0xc4,
+	0x96, 0xf2, 0x99, 0x25, 0x27, 0xad, 0x0c, 0xf8, 0xc9, 0x4a, 0xfe, 0xc4, 0x92, 0x66, 0x79, 0xe5,
+	0xa4, 0x2f, 0x92, 0x6e, 0x47, 0xe2, 0x1f, 0xf0, 0x67, 0x76, 0x1c, 0xc0, 0xf9, 0xfa, 0xba, 0xb1,
+	0xa3, 0x8d, 0x5b, 0xc8, 0x0b, 0x16, 0x77, 0xe0, 0x3a, 0xe9, 0xd2, 0x30, 0x0b, 0x8b, 0xbb, 0xd7,
+	0xfb, 0x92, 0x0c, 0xcb, 0xab, 0x9e, 0xd8, 0xce, 0x73, 0x60, 0xc9, 0xd7, 0x28, 0x2d, 0x78, 0x8d,
+	0x66, 0x71, 0x3e, 0x83, 0x07, 0x6a, 0x3d, 0x08, 0x9a, 0xf9, 0x03, 0xdb, 0x3b, 0x0f, 0xd6, 0x6f,
+	0x45, 0x6b, 0x58, 0x68, 0x8b, 0x93, 0xf1, 0x69, 0xb8, 0x52, 0x0a, 0x0b, 0xbd, 0x48, 0x8b, 0x2e,
+	0x8d, 0x56, 0x4a, 0xa1, 0x7e, 0xf9, 0x2e, 0x95, 0xf6, 0xdd, 0xd4, 0x94, 0x2d, 0xfe, 0x54, 0x02,
+	0xfb, 0xf9, 0xc3, 0xea, 0xb6, 0x77, 0x68, 0xaa, 0x33, 0x8e, 0xa3, 0x1c, 0x14, 0x18, 0x25, 0x6d,
+	0x35, 0xf6, 0xaa, 0x22, 0xcd, 0x26, 0xa6, 0x6f, 0x7d, 0xfb, 0x1b, 0x00, 0x55, 0x1c, 0xd6, 0x12,
+	0x6c, 0x01, 0x00, 0x00,
 }
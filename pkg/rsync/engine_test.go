@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"math/rand"
 	"testing"
+
+	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
 )
 
 func TestBlockHashNilInvalid(t *testing.T) {
@@ -133,6 +135,34 @@ func TestOperationBlocksValid(t *testing.T) {
 	}
 }
 
+func TestOperationZerosAndStartInvalid(t *testing.T) {
+	operation := &Operation{Zeros: 4096, Start: 4}
+	if operation.EnsureValid() == nil {
+		t.Error("operation with zeros and start considered valid")
+	}
+}
+
+func TestOperationZerosAndCountInvalid(t *testing.T) {
+	operation := &Operation{Zeros: 4096, Count: 4}
+	if operation.EnsureValid() == nil {
+		t.Error("operation with zeros and count considered valid")
+	}
+}
+
+func TestOperationDataAndZerosInvalid(t *testing.T) {
+	operation := &Operation{Data: []byte{0}, Zeros: 4096}
+	if operation.EnsureValid() == nil {
+		t.Error("operation with data and zeros considered valid")
+	}
+}
+
+func TestOperationZerosValid(t *testing.T) {
+	operation := &Operation{Zeros: 4096}
+	if err := operation.EnsureValid(); err != nil {
+		t.Error("valid zero operation considered invalid")
+	}
+}
+
 func TestMinimumBlockSize(t *testing.T) {
 	if s := OptimalBlockSizeForBaseLength(1); s != minimumOptimalBlockSize {
 		t.Error("incorrect minimum block size:", s, "!=", minimumOptimalBlockSize)
@@ -418,3 +448,59 @@ func TestDifferentDataDifferentLength(t *testing.T) {
 	}
 	test.run(t)
 }
+
+func TestSparseZeroRunsTransmittedCompactly(t *testing.T) {
+	target := make([]byte, 1<<20)
+	copy(target, []byte("head"))
+	copy(target[len(target)-4:], []byte("tail"))
+	regions := []fs.DataRegion{
+		{Offset: 0, Length: 1 << 16},
+		{Offset: uint64(len(target)) - 1<<16, Length: 1 << 16},
+	}
+
+	engine := NewEngine()
+	var delta []*Operation
+	transmit := func(o *Operation) error {
+		delta = append(delta, o.Copy())
+		return nil
+	}
+	if err := engine.transmitSparse(bytes.NewReader(target), regions, uint64(len(target)), 0, transmit); err != nil {
+		t.Fatal("unable to transmit sparse target:", err)
+	}
+
+	var data, zeros uint64
+	for _, o := range delta {
+		if err := o.EnsureValid(); err != nil {
+			t.Fatal("invalid operation:", err)
+		}
+		data += uint64(len(o.Data))
+		zeros += o.Zeros
+	}
+	if data+zeros != uint64(len(target)) {
+		t.Error("operations did not cover target:", data+zeros, "!=", len(target))
+	}
+	if data > 2*DefaultMaximumDataOperationSize {
+		t.Error("zero run transmitted as data:", data, "bytes")
+	}
+
+	signature := engine.BytesSignature(nil, 0)
+	patched, err := engine.PatchBytes(nil, signature, delta)
+	if err != nil {
+		t.Fatal("unable to patch bytes:", err)
+	}
+	if !bytes.Equal(patched, target) {
+		t.Error("patched data did not match expected")
+	}
+}
+
+func TestDeltafyDoesNotDetectZeroRuns(t *testing.T) {
+	target := make([]byte, 1<<16)
+
+	engine := NewEngine()
+	signature := engine.BytesSignature(nil, 0)
+	for _, o := range engine.DeltafyBytes(target, signature, 0) {
+		if o.Zeros != 0 {
+			t.Fatal("zero operation transmitted outside of sparse transmission")
+		}
+	}
+}
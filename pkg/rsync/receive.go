@@ -49,7 +49,6 @@ func (e *emptyReadSeekCloser) Close() error {
 }
 
 type receiver struct {
-	root       string
 	paths      []string
 	signatures []*Signature
 	opener     *fs.Opener
@@ -63,17 +62,16 @@ type receiver struct {
 	target     io.WriteCloser
 }
 
-func NewReceiver(root string, paths []string, signatures []*Signature, sinker Sinker) (Receiver, error) {
+func NewReceiver(opener *fs.Opener, paths []string, signatures []*Signature, sinker Sinker) (Receiver, error) {
 
 	if len(paths) != len(signatures) {
 		return nil, errors.New("number of paths does not match number of signatures")
 	}
 
 	return &receiver{
-		root:       root,
 		paths:      paths,
 		signatures: signatures,
-		opener:     fs.NewOpener(root),
+		opener:     opener,
 		sinker:     sinker,
 		engine:     NewEngine(),
 		total:      uint64(len(paths)),
@@ -346,3 +344,31 @@ func DecodeToReceiver(decoder Decoder, count uint64, receiver Receiver) error {
 
 	return nil
 }
+
+type countingReceiver struct {
+	receiver Receiver
+	count    *uint64
+}
+
+func NewCountingReceiver(receiver Receiver, count *uint64) Receiver {
+	return &countingReceiver{
+		receiver: receiver,
+		count:    count,
+	}
+}
+
+func (r *countingReceiver) Receive(transmission *Transmission) error {
+	if err := r.receiver.Receive(transmission); err != nil {
+		return err
+	}
+
+	if transmission.Operation != nil {
+		*r.count += uint64(len(transmission.Operation.Data))
+	}
+
+	return nil
+}
+
+func (r *countingReceiver) finalize() error {
+	return r.receiver.finalize()
+}
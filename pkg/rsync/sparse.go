@@ -0,0 +1,62 @@
+package rsync
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
+)
+
+func sparse(regions []fs.DataRegion, size uint64) bool {
+	var data uint64
+	for _, r := range regions {
+		data += r.Length
+	}
+	return data < size
+}
+
+func (e *Engine) transmitSparse(target io.ReadSeeker, regions []fs.DataRegion, size, maxDataOpSize uint64, transmit OperationTransmitter) error {
+
+	e.detectZeros = true
+	defer func() {
+		e.detectZeros = false
+	}()
+
+	var offset uint64
+	for _, r := range regions {
+		if r.Offset > offset {
+			e.zeros += r.Offset - offset
+		}
+
+		if _, err := target.Seek(int64(r.Offset), io.SeekStart); err != nil {
+			return errors.Wrap(err, "unable to seek to data region")
+		}
+
+		if err := e.chunkAndTransmit(io.LimitReader(target, int64(r.Length)), maxDataOpSize, transmit); err != nil {
+			return err
+		}
+
+		offset = r.Offset + r.Length
+	}
+
+	if size > offset {
+		e.zeros += size - offset
+	}
+
+	if err := e.flushZeros(transmit); err != nil {
+		return errors.Wrap(err, "unable to transmit zero operation")
+	}
+
+	return nil
+}
+
+func (e *Engine) deltafyFile(target fs.ReadableFile, base *Signature, maxDataOpSize uint64, transmit OperationTransmitter) error {
+	if len(base.Hashes) == 0 {
+		if regions, size, err := fs.DataRegions(target); err == nil && sparse(regions, size) {
+			return e.transmitSparse(target, regions, size, maxDataOpSize, transmit)
+		}
+	}
+
+	return e.Deltafy(target, base, maxDataOpSize, transmit)
+}
@@ -6,13 +6,12 @@ import (
 	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
 )
 
-func Transmit(root string, paths []string, signatures []*Signature, receiver Receiver) error {
+func Transmit(opener *fs.Opener, paths []string, signatures []*Signature, receiver Receiver) error {
 
 	if len(paths) != len(signatures) {
 		receiver.finalize()
 		return errors.New("number of paths does not match number of signatures")
 	}
-	opener := fs.NewOpener(root)
 	defer opener.Close()
 	engine := NewEngine()
 	transmission := &Transmission{}
@@ -35,7 +34,7 @@ func Transmit(root string, paths []string, signatures []*Signature, receiver Rec
 			transmitError = receiver.Receive(transmission)
 			return transmitError
 		}
-		err = engine.Deltafy(file, signatures[i], 0, transmit)
+		err = engine.deltafyFile(file, signatures[i], 0, transmit)
 
 		file.Close()
 
@@ -0,0 +1,3 @@
+// Package selection provides validation facilities for session labels and
+// parsing and matching facilities for label selectors.
+package selection
@@ -0,0 +1,47 @@
+package selection
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	maximumLabelLength = 63
+)
+
+var labelMatcher = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+
+func EnsureLabelKeyValid(key string) error {
+	if key == "" {
+		return errors.New("empty label key")
+	} else if len(key) > maximumLabelLength {
+		return errors.New("label key too long")
+	} else if !labelMatcher.MatchString(key) {
+		return errors.Errorf("invalid label key: %s", key)
+	}
+	return nil
+}
+
+func EnsureLabelValueValid(value string) error {
+	if value == "" {
+		return nil
+	} else if len(value) > maximumLabelLength {
+		return errors.New("label value too long")
+	} else if !labelMatcher.MatchString(value) {
+		return errors.Errorf("invalid label value: %s", value)
+	}
+	return nil
+}
+
+func EnsureLabelsValid(labels map[string]string) error {
+	for key, value := range labels {
+		if err := EnsureLabelKeyValid(key); err != nil {
+			return err
+		}
+		if err := EnsureLabelValueValid(value); err != nil {
+			return errors.Wrapf(err, "invalid value for label %s", key)
+		}
+	}
+	return nil
+}
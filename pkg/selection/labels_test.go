@@ -0,0 +1,36 @@
+package selection
+
+import (
+	"testing"
+)
+
+func TestEnsureLabelKeyValid(t *testing.T) {
+	testCases := []struct {
+		key   string
+		valid bool
+	}{
+		{"", false},
+		{"project", true},
+		{"doppelganger-project", true},
+		{"app.kubernetes.io", true},
+		{"-leading", false},
+		{"trailing_", false},
+		{"has space", false},
+		{"this-key-is-far-too-long-to-be-accepted-as-a-label-key-by-doppelganger", false},
+	}
+
+	for _, testCase := range testCases {
+		if err := EnsureLabelKeyValid(testCase.key); (err == nil) != testCase.valid {
+			t.Errorf("label key validity incorrect for \"%s\": %v", testCase.key, err)
+		}
+	}
+}
+
+func TestEnsureLabelsValid(t *testing.T) {
+	if err := EnsureLabelsValid(map[string]string{"project": "api", "empty": ""}); err != nil {
+		t.Error("valid labels rejected:", err)
+	}
+	if err := EnsureLabelsValid(map[string]string{"project": "a/b"}); err == nil {
+		t.Error("invalid label value accepted")
+	}
+}
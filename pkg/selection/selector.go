@@ -0,0 +1,193 @@
+package selection
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type operator uint8
+
+const (
+	operatorExists operator = iota
+	operatorDoesNotExist
+	operatorEquals
+	operatorNotEquals
+	operatorIn
+	operatorNotIn
+)
+
+type requirement struct {
+	key      string
+	operator operator
+	values   []string
+}
+
+func (r *requirement) matches(labels map[string]string) bool {
+	value, ok := labels[r.key]
+	switch r.operator {
+	case operatorExists:
+		return ok
+	case operatorDoesNotExist:
+		return !ok
+	case operatorEquals:
+		return ok && value == r.values[0]
+	case operatorNotEquals:
+		return !ok || value != r.values[0]
+	case operatorIn:
+		if !ok {
+			return false
+		}
+		for _, v := range r.values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	case operatorNotIn:
+		if !ok {
+			return true
+		}
+		for _, v := range r.values {
+			if v == value {
+				return false
+			}
+		}
+		return true
+	default:
+		panic("unhandled label selector operator")
+	}
+}
+
+type LabelSelector struct {
+	requirements []*requirement
+}
+
+func splitRequirements(selector string) ([]string, error) {
+	var result []string
+	depth := 0
+	start := 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, errors.New("nested parentheses")
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				result = append(result, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	return append(result, selector[start:]), nil
+}
+
+func parseValueSet(set string) ([]string, error) {
+	set = strings.TrimSpace(set)
+	if !strings.HasPrefix(set, "(") || !strings.HasSuffix(set, ")") {
+		return nil, errors.New("value set must be parenthesized")
+	}
+
+	var values []string
+	for _, v := range strings.Split(set[1:len(set)-1], ",") {
+		v = strings.TrimSpace(v)
+		if err := EnsureLabelValueValid(v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+func parseRequirement(text string) (*requirement, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.New("empty requirement")
+	}
+
+	result := &requirement{}
+	if strings.HasPrefix(text, "!") {
+		result.key = strings.TrimSpace(text[1:])
+		result.operator = operatorDoesNotExist
+	} else if index := strings.Index(text, "!="); index >= 0 {
+		result.key = strings.TrimSpace(text[:index])
+		result.operator = operatorNotEquals
+		result.values = []string{strings.TrimSpace(text[index+2:])}
+	} else if index := strings.Index(text, "=="); index >= 0 {
+		result.key = strings.TrimSpace(text[:index])
+		result.operator = operatorEquals
+		result.values = []string{strings.TrimSpace(text[index+2:])}
+	} else if index := strings.Index(text, "="); index >= 0 {
+		result.key = strings.TrimSpace(text[:index])
+		result.operator = operatorEquals
+		result.values = []string{strings.TrimSpace(text[index+1:])}
+	} else if fields := strings.Fields(text); len(fields) == 1 {
+		result.key = fields[0]
+		result.operator = operatorExists
+	} else if len(fields) >= 2 && (fields[1] == "in" || fields[1] == "notin") {
+		result.key = fields[0]
+		if fields[1] == "in" {
+			result.operator = operatorIn
+		} else {
+			result.operator = operatorNotIn
+		}
+		set := strings.TrimSpace(text[len(fields[0]):])
+		set = strings.TrimSpace(strings.TrimPrefix(set, fields[1]))
+		values, err := parseValueSet(set)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid value set")
+		}
+		result.values = values
+	} else {
+		return nil, errors.Errorf("unable to parse requirement: %s", text)
+	}
+
+	if err := EnsureLabelKeyValid(result.key); err != nil {
+		return nil, err
+	}
+	if result.operator == operatorEquals || result.operator == operatorNotEquals {
+		if err := EnsureLabelValueValid(result.values[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func ParseLabelSelector(selector string) (*LabelSelector, error) {
+	texts, err := splitRequirements(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid label selector")
+	}
+
+	result := &LabelSelector{}
+	for _, text := range texts {
+		r, err := parseRequirement(text)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid label selector")
+		}
+		result.requirements = append(result.requirements, r)
+	}
+
+	return result, nil
+}
+
+func (s *LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s.requirements {
+		if !r.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+package selection
+
+import (
+	"testing"
+)
+
+func TestParseLabelSelectorInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		",",
+		"project in api",
+		"project in (api",
+		"project in ((api))",
+		"project==a/b",
+		"project ~ api",
+	}
+
+	for _, testCase := range testCases {
+		if _, err := ParseLabelSelector(testCase); err == nil {
+			t.Errorf("invalid label selector accepted: \"%s\"", testCase)
+		}
+	}
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	labels := map[string]string{
+		"project": "api",
+		"tier":    "backend",
+	}
+
+	testCases := []struct {
+		selector string
+		expected bool
+	}{
+		{"project", true},
+		{"!project", false},
+		{"owner", false},
+		{"!owner", true},
+		{"project=api", true},
+		{"project==api", true},
+		{"project=web", false},
+		{"project!=web", true},
+		{"owner!=george", true},
+		{"project in (api, web)", true},
+		{"project in (web)", false},
+		{"domain in (api)", false},
+		{"project notin (web)", true},
+		{"owner notin (george)", true},
+		{"project=api,tier=backend", true},
+		{"project in (api,web), tier!=backend", false},
+	}
+
+	for _, testCase := range testCases {
+		selector, err := ParseLabelSelector(testCase.selector)
+		if err != nil {
+			t.Errorf("unable to parse label selector \"%s\": %v", testCase.selector, err)
+			continue
+		}
+		if selector.Matches(labels) != testCase.expected {
+			t.Errorf("label selector \"%s\" match result incorrect", testCase.selector)
+		}
+	}
+}
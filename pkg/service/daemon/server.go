@@ -17,6 +17,8 @@ func housekeep() {
 	agent.Housekeep()
 	local.HousekeepCaches()
 	local.HousekeepStaging()
+	local.HousekeepTrash()
+	local.HousekeepVersions()
 }
 
 type Server struct {
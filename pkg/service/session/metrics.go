@@ -0,0 +1,36 @@
+package session
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+func (s *Server) ServeMetrics(listener net.Listener) error {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/metrics", s.serveMetrics)
+
+	return http.Serve(listener, handler)
+}
+
+func (s *Server) serveMetrics(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	metrics := &bytes.Buffer{}
+	if err := s.manager.WriteMetrics(metrics); err != nil {
+		http.Error(writer, errors.Wrap(err, "unable to write metrics").Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", metricsContentType)
+	writer.Write(metrics.Bytes())
+}
@@ -146,3 +146,119 @@ func (p *terminateStreamPrompter) Message(message string) error {
 func (p *terminateStreamPrompter) Prompt(_ string) (string, error) {
 	return "", errors.New("prompting not supported on terminate message streams")
 }
+
+type resolveStreamPrompter struct {
+	stream Sessions_ResolveServer
+}
+
+func (p *resolveStreamPrompter) sendReceive(request *ResolveResponse) (*ResolveRequest, error) {
+	if err := p.stream.Send(request); err != nil {
+		return nil, errors.Wrap(err, "unable to send request")
+	}
+
+	if response, err := p.stream.Recv(); err != nil {
+		return nil, errors.Wrap(err, "unable to receive response")
+	} else if err = response.ensureValid(false); err != nil {
+		return nil, errors.Wrap(err, "invalid response received")
+	} else {
+		return response, nil
+	}
+}
+
+func (p *resolveStreamPrompter) Message(message string) error {
+	_, err := p.sendReceive(&ResolveResponse{Message: message})
+	return err
+}
+
+func (p *resolveStreamPrompter) Prompt(_ string) (string, error) {
+	return "", errors.New("prompting not supported on resolve message streams")
+}
+
+type planStreamPrompter struct {
+	stream Sessions_PlanServer
+}
+
+func (p *planStreamPrompter) sendReceive(request *PlanResponse) (*PlanRequest, error) {
+	if err := p.stream.Send(request); err != nil {
+		return nil, errors.Wrap(err, "unable to send request")
+	}
+
+	if response, err := p.stream.Recv(); err != nil {
+		return nil, errors.Wrap(err, "unable to receive response")
+	} else if err = response.ensureValid(false); err != nil {
+		return nil, errors.Wrap(err, "invalid response received")
+	} else {
+		return response, nil
+	}
+}
+
+func (p *planStreamPrompter) Message(message string) error {
+	_, err := p.sendReceive(&PlanResponse{Message: message})
+	return err
+}
+
+func (p *planStreamPrompter) Prompt(prompt string) (string, error) {
+	if response, err := p.sendReceive(&PlanResponse{Prompt: prompt}); err != nil {
+		return "", err
+	} else {
+		return response.Response, nil
+	}
+}
+
+type updateStreamPrompter struct {
+	stream Sessions_UpdateServer
+}
+
+func (p *updateStreamPrompter) sendReceive(request *UpdateResponse) (*UpdateRequest, error) {
+	if err := p.stream.Send(request); err != nil {
+		return nil, errors.Wrap(err, "unable to send request")
+	}
+
+	if response, err := p.stream.Recv(); err != nil {
+		return nil, errors.Wrap(err, "unable to receive response")
+	} else if err = response.ensureValid(false); err != nil {
+		return nil, errors.Wrap(err, "invalid response received")
+	} else {
+		return response, nil
+	}
+}
+
+func (p *updateStreamPrompter) Message(message string) error {
+	_, err := p.sendReceive(&UpdateResponse{Message: message})
+	return err
+}
+
+func (p *updateStreamPrompter) Prompt(prompt string) (string, error) {
+	if response, err := p.sendReceive(&UpdateResponse{Prompt: prompt}); err != nil {
+		return "", err
+	} else {
+		return response.Response, nil
+	}
+}
+
+type acknowledgeStreamPrompter struct {
+	stream Sessions_AcknowledgeServer
+}
+
+func (p *acknowledgeStreamPrompter) sendReceive(request *AcknowledgeResponse) (*AcknowledgeRequest, error) {
+	if err := p.stream.Send(request); err != nil {
+		return nil, errors.Wrap(err, "unable to send request")
+	}
+
+	if response, err := p.stream.Recv(); err != nil {
+		return nil, errors.Wrap(err, "unable to receive response")
+	} else if err = response.ensureValid(false); err != nil {
+		return nil, errors.Wrap(err, "invalid response received")
+	} else {
+		return response, nil
+	}
+}
+
+func (p *acknowledgeStreamPrompter) Message(message string) error {
+	_, err := p.sendReceive(&AcknowledgeResponse{Message: message})
+	return err
+}
+
+func (p *acknowledgeStreamPrompter) Prompt(_ string) (string, error) {
+	return "", errors.New("prompting not supported on acknowledge message streams")
+}
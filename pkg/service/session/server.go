@@ -6,7 +6,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/RokyErickson/doppelganger/pkg/prompt"
+	"github.com/RokyErickson/doppelganger/pkg/protocols/local"
 	"github.com/RokyErickson/doppelganger/pkg/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+	"github.com/RokyErickson/doppelganger/pkg/url"
 )
 
 type Server struct {
@@ -24,6 +27,10 @@ func New() (*Server, error) {
 	}, nil
 }
 
+func (s *Server) LoadErrors() []error {
+	return s.manager.LoadErrors()
+}
+
 func (s *Server) Shutdown() {
 	s.manager.Shutdown()
 }
@@ -47,6 +54,8 @@ func (s *Server) Create(stream Sessions_CreateServer) error {
 		request.Configuration,
 		request.ConfigurationAlpha,
 		request.ConfigurationBeta,
+		request.Name,
+		request.Labels,
 		prompter,
 	)
 
@@ -68,7 +77,7 @@ func (s *Server) List(_ context.Context, request *ListRequest) (*ListResponse, e
 		return nil, errors.Wrap(err, "received invalid list request")
 	}
 
-	stateIndex, states, err := s.manager.List(request.PreviousStateIndex, request.Specifications)
+	stateIndex, states, err := s.manager.List(request.PreviousStateIndex, request.Specifications, request.LabelSelector)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +101,7 @@ func (s *Server) Flush(stream Sessions_FlushServer) error {
 		return errors.Wrap(err, "unable to register prompter")
 	}
 
-	err = s.manager.Flush(request.Specifications, prompter, request.SkipWait, stream.Context())
+	err = s.manager.Flush(request.Specifications, request.LabelSelector, prompter, request.SkipWait, stream.Context())
 
 	prompt.UnregisterPrompter(prompter)
 
@@ -120,7 +129,7 @@ func (s *Server) Pause(stream Sessions_PauseServer) error {
 		return errors.Wrap(err, "unable to register prompter")
 	}
 
-	err = s.manager.Pause(request.Specifications, prompter)
+	err = s.manager.Pause(request.Specifications, request.LabelSelector, prompter)
 
 	prompt.UnregisterPrompter(prompter)
 
@@ -148,7 +157,7 @@ func (s *Server) Resume(stream Sessions_ResumeServer) error {
 		return errors.Wrap(err, "unable to register prompter")
 	}
 
-	err = s.manager.Resume(request.Specifications, prompter)
+	err = s.manager.Resume(request.Specifications, request.LabelSelector, prompter)
 
 	prompt.UnregisterPrompter(prompter)
 
@@ -177,7 +186,7 @@ func (s *Server) Terminate(stream Sessions_TerminateServer) error {
 		return errors.Wrap(err, "unable to register prompter")
 	}
 
-	err = s.manager.Terminate(request.Specifications, prompter)
+	err = s.manager.Terminate(request.Specifications, request.LabelSelector, prompter)
 
 	prompt.UnregisterPrompter(prompter)
 
@@ -191,3 +200,224 @@ func (s *Server) Terminate(stream Sessions_TerminateServer) error {
 
 	return nil
 }
+
+func (s *Server) Resolve(stream Sessions_ResolveServer) error {
+
+	request, err := stream.Recv()
+	if err != nil {
+		return errors.Wrap(err, "unable to receive request")
+	} else if err = request.ensureValid(true); err != nil {
+		return errors.Wrap(err, "received invalid resolve request")
+	}
+
+	prompter, err := prompt.RegisterPrompter(&resolveStreamPrompter{stream})
+	if err != nil {
+		return errors.Wrap(err, "unable to register prompter")
+	}
+
+	err = s.manager.Resolve(request.Session, request.Path, request.Winner, prompter)
+
+	prompt.UnregisterPrompter(prompter)
+
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&ResolveResponse{}); err != nil {
+		return errors.Wrap(err, "unable to send response")
+	}
+
+	return nil
+}
+
+func (s *Server) Acknowledge(stream Sessions_AcknowledgeServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return errors.Wrap(err, "unable to receive request")
+	} else if err = request.ensureValid(true); err != nil {
+		return errors.Wrap(err, "received invalid acknowledge request")
+	}
+
+	prompter, err := prompt.RegisterPrompter(&acknowledgeStreamPrompter{stream})
+	if err != nil {
+		return errors.Wrap(err, "unable to register prompter")
+	}
+
+	err = s.manager.Acknowledge(request.Session, request.Acknowledgement, prompter)
+
+	prompt.UnregisterPrompter(prompter)
+
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&AcknowledgeResponse{}); err != nil {
+		return errors.Wrap(err, "unable to send response")
+	}
+
+	return nil
+}
+
+func versioningEnabled(s *session.Session, alpha bool) bool {
+	endpointConfiguration := s.ConfigurationBeta
+	if alpha {
+		endpointConfiguration = s.ConfigurationAlpha
+	}
+
+	mode := session.MergeConfigurations(s.Configuration, endpointConfiguration).VersioningMode
+	if mode.IsDefault() {
+		mode = s.Version.DefaultVersioningMode()
+	}
+
+	return mode == sync.VersioningMode_VersioningModeStaggered
+}
+
+func (s *Server) ListVersions(_ context.Context, request *ListVersionsRequest) (*ListVersionsResponse, error) {
+	if err := request.ensureValid(); err != nil {
+		return nil, errors.Wrap(err, "received invalid list versions request")
+	}
+
+	session, err := s.manager.Session(request.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	alphaLocal := session.Alpha.Protocol == url.Protocol_Local && versioningEnabled(session, true)
+	betaLocal := session.Beta.Protocol == url.Protocol_Local && versioningEnabled(session, false)
+	if !alphaLocal && !betaLocal {
+		return nil, errors.New("version history is only available for endpoints local to the daemon with staggered versioning enabled")
+	}
+
+	response := &ListVersionsResponse{}
+	if alphaLocal {
+		if response.AlphaVersions, err = local.ListVersions(session.Identifier, true, request.Path); err != nil {
+			return nil, errors.Wrap(err, "unable to list alpha versions")
+		}
+	}
+	if betaLocal {
+		if response.BetaVersions, err = local.ListVersions(session.Identifier, false, request.Path); err != nil {
+			return nil, errors.Wrap(err, "unable to list beta versions")
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Server) RestoreVersion(_ context.Context, request *RestoreVersionRequest) (*RestoreVersionResponse, error) {
+	if err := request.ensureValid(); err != nil {
+		return nil, errors.Wrap(err, "received invalid restore version request")
+	}
+
+	session, err := s.manager.Session(request.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := session.Alpha
+	if request.Beta {
+		endpoint = session.Beta
+	}
+	if endpoint.Protocol != url.Protocol_Local {
+		return nil, errors.New("version history is only available for endpoints local to the daemon")
+	} else if !versioningEnabled(session, !request.Beta) {
+		return nil, errors.New("staggered versioning is not enabled for endpoint")
+	}
+
+	version, err := local.RestoreVersion(session.Identifier, !request.Beta, endpoint.Path, request.Path, request.Digest)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to restore version")
+	}
+
+	return &RestoreVersionResponse{Version: version}, nil
+}
+
+func (s *Server) Plan(stream Sessions_PlanServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return errors.Wrap(err, "unable to receive request")
+	} else if err = request.ensureValid(true); err != nil {
+		return errors.Wrap(err, "received invalid plan request")
+	}
+
+	prompter, err := prompt.RegisterPrompter(&planStreamPrompter{stream})
+	if err != nil {
+		return errors.Wrap(err, "unable to register prompter")
+	}
+
+	plan, err := s.manager.Plan(request.Session, request.SynchronizationMode, prompter)
+
+	prompt.UnregisterPrompter(prompter)
+
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&PlanResponse{Plan: plan}); err != nil {
+		return errors.Wrap(err, "unable to send response")
+	}
+
+	return nil
+}
+
+func (s *Server) Update(stream Sessions_UpdateServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return errors.Wrap(err, "unable to receive request")
+	} else if err = request.ensureValid(true); err != nil {
+		return errors.Wrap(err, "received invalid update request")
+	}
+
+	prompter, err := prompt.RegisterPrompter(&updateStreamPrompter{stream})
+	if err != nil {
+		return errors.Wrap(err, "unable to register prompter")
+	}
+
+	err = s.manager.Update(
+		request.Session,
+		request.Configuration,
+		request.ConfigurationAlpha,
+		request.ConfigurationBeta,
+		request.Fields,
+		request.FieldsAlpha,
+		request.FieldsBeta,
+		prompter,
+	)
+
+	prompt.UnregisterPrompter(prompter)
+
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&UpdateResponse{}); err != nil {
+		return errors.Wrap(err, "unable to send response")
+	}
+
+	return nil
+}
+
+func (s *Server) History(_ context.Context, request *HistoryRequest) (*HistoryResponse, error) {
+	if err := request.ensureValid(); err != nil {
+		return nil, errors.Wrap(err, "received invalid history request")
+	}
+
+	records, err := s.manager.History(request.Session, request.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoryResponse{Records: records}, nil
+}
+
+func (s *Server) Events(request *EventsRequest, stream Sessions_EventsServer) error {
+	if err := request.ensureValid(); err != nil {
+		return errors.Wrap(err, "received invalid events request")
+	}
+
+	return s.manager.Events(stream.Context(), request.Specifications, func(event *session.Event) error {
+		if err := stream.Send(&EventsResponse{Event: event}); err != nil {
+			return errors.Wrap(err, "unable to send event")
+		}
+		return nil
+	})
+}
@@ -3,9 +3,25 @@ package session
 import (
 	"github.com/pkg/errors"
 
+	"github.com/bmatcuk/doublestar"
+
+	"github.com/RokyErickson/doppelganger/pkg/selection"
 	"github.com/RokyErickson/doppelganger/pkg/session"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
 )
 
+func ensureSelectionValid(specifications []string, labelSelector string) error {
+	if labelSelector == "" {
+		return nil
+	} else if len(specifications) > 0 {
+		return errors.New("specifications and label selector both provided")
+	} else if _, err := selection.ParseLabelSelector(labelSelector); err != nil {
+		return errors.Wrap(err, "invalid label selector")
+	}
+
+	return nil
+}
+
 func (r *CreateRequest) ensureValid(first bool) error {
 	if r == nil {
 		return errors.New("nil create request")
@@ -32,6 +48,16 @@ func (r *CreateRequest) ensureValid(first bool) error {
 			return errors.Wrap(err, "invalid beta-specific configuration")
 		}
 
+		if r.Name != "" {
+			if err := session.EnsureNameValid(r.Name); err != nil {
+				return errors.Wrap(err, "invalid name")
+			}
+		}
+
+		if err := selection.EnsureLabelsValid(r.Labels); err != nil {
+			return errors.Wrap(err, "invalid labels")
+		}
+
 		if r.Response != "" {
 			return errors.New("non-empty response")
 		}
@@ -56,6 +82,14 @@ func (r *CreateRequest) ensureValid(first bool) error {
 			return errors.New("beta-specific configuration present")
 		}
 
+		if r.Name != "" {
+			return errors.New("name present")
+		}
+
+		if len(r.Labels) > 0 {
+			return errors.New("labels present")
+		}
+
 	}
 	return nil
 }
@@ -87,6 +121,10 @@ func (r *ListRequest) ensureValid() error {
 		return errors.New("nil list request")
 	}
 
+	if err := ensureSelectionValid(r.Specifications, r.LabelSelector); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -110,11 +148,17 @@ func (r *FlushRequest) ensureValid(first bool) error {
 		return errors.New("nil flush request")
 	}
 	if first {
-
+		if err := ensureSelectionValid(r.Specifications, r.LabelSelector); err != nil {
+			return err
+		}
 	} else {
 		if r.Specifications != nil {
 			return errors.New("non-empty specifications on message acknowledgement")
 		}
+
+		if r.LabelSelector != "" {
+			return errors.New("non-empty label selector on message acknowledgement")
+		}
 	}
 
 	return nil
@@ -134,11 +178,17 @@ func (r *PauseRequest) ensureValid(first bool) error {
 	}
 
 	if first {
-
+		if err := ensureSelectionValid(r.Specifications, r.LabelSelector); err != nil {
+			return err
+		}
 	} else {
 		if r.Specifications != nil {
 			return errors.New("non-empty specifications on message acknowledgement")
 		}
+
+		if r.LabelSelector != "" {
+			return errors.New("non-empty label selector on message acknowledgement")
+		}
 	}
 
 	return nil
@@ -160,12 +210,18 @@ func (r *ResumeRequest) ensureValid(first bool) error {
 	}
 
 	if first {
-
+		if err := ensureSelectionValid(r.Specifications, r.LabelSelector); err != nil {
+			return err
+		}
 	} else {
 
 		if r.Specifications != nil {
 			return errors.New("non-empty specifications on message acknowledgement")
 		}
+
+		if r.LabelSelector != "" {
+			return errors.New("non-empty label selector on message acknowledgement")
+		}
 	}
 
 	return nil
@@ -197,12 +253,18 @@ func (r *TerminateRequest) ensureValid(first bool) error {
 	}
 
 	if first {
-
+		if err := ensureSelectionValid(r.Specifications, r.LabelSelector); err != nil {
+			return err
+		}
 	} else {
 		if r.Specifications != nil {
 			return errors.New("non-empty specifications on message acknowledgement")
 		}
 
+		if r.LabelSelector != "" {
+			return errors.New("non-empty label selector on message acknowledgement")
+		}
+
 	}
 
 	return nil
@@ -216,3 +278,316 @@ func (r *TerminateResponse) EnsureValid() error {
 
 	return nil
 }
+
+func (r *ResolveRequest) ensureValid(first bool) error {
+	if r == nil {
+		return errors.New("nil resolve request")
+	}
+
+	if first {
+		if r.Session == "" {
+			return errors.New("empty session specification")
+		}
+
+		if !r.Winner.Supported() {
+			return errors.New("unknown or unsupported conflict winner")
+		}
+	} else {
+		if r.Session != "" {
+			return errors.New("non-empty session specification on message acknowledgement")
+		}
+
+		if r.Path != "" {
+			return errors.New("non-empty path on message acknowledgement")
+		}
+
+		if r.Winner != sync.ConflictWinner_ConflictWinnerInvalid {
+			return errors.New("conflict winner specified on message acknowledgement")
+		}
+	}
+
+	return nil
+}
+
+func (r *ResolveResponse) EnsureValid() error {
+
+	if r == nil {
+		return errors.New("nil resolve response")
+	}
+
+	return nil
+}
+
+func (r *AcknowledgeRequest) ensureValid(first bool) error {
+	if r == nil {
+		return errors.New("nil acknowledge request")
+	}
+
+	if first {
+		if r.Session == "" {
+			return errors.New("empty session specification")
+		}
+
+		if !r.Acknowledgement.Supported() {
+			return errors.New("unknown or unsupported halt acknowledgement")
+		}
+	} else {
+		if r.Session != "" {
+			return errors.New("non-empty session specification on message acknowledgement")
+		}
+
+		if r.Acknowledgement != session.HaltAcknowledgement_HaltAcknowledgementInvalid {
+			return errors.New("halt acknowledgement specified on message acknowledgement")
+		}
+	}
+
+	return nil
+}
+
+func (r *AcknowledgeResponse) EnsureValid() error {
+	if r == nil {
+		return errors.New("nil acknowledge response")
+	}
+
+	return nil
+}
+
+func (r *ListVersionsRequest) ensureValid() error {
+	if r == nil {
+		return errors.New("nil list versions request")
+	}
+
+	if r.Session == "" {
+		return errors.New("empty session specification")
+	}
+
+	if r.Path == "" {
+		return errors.New("empty path")
+	}
+
+	return nil
+}
+
+func (r *ListVersionsResponse) EnsureValid() error {
+	if r == nil {
+		return errors.New("nil list versions response")
+	}
+
+	if err := (&sync.FileVersionHistory{Versions: r.AlphaVersions}).EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid alpha versions")
+	}
+
+	if err := (&sync.FileVersionHistory{Versions: r.BetaVersions}).EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid beta versions")
+	}
+
+	return nil
+}
+
+func (r *RestoreVersionRequest) ensureValid() error {
+	if r == nil {
+		return errors.New("nil restore version request")
+	}
+
+	if r.Session == "" {
+		return errors.New("empty session specification")
+	}
+
+	if r.Path == "" {
+		return errors.New("empty path")
+	}
+
+	return nil
+}
+
+func (r *RestoreVersionResponse) EnsureValid() error {
+	if r == nil {
+		return errors.New("nil restore version response")
+	}
+
+	if err := (&sync.FileVersionHistory{Versions: []*sync.FileVersion{r.Version}}).EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid restored version")
+	}
+
+	return nil
+}
+
+func (r *PlanRequest) ensureValid(first bool) error {
+	if r == nil {
+		return errors.New("nil plan request")
+	}
+
+	if first {
+		if r.Session == "" {
+			return errors.New("empty session specification")
+		}
+
+		if !(r.SynchronizationMode.IsDefault() || r.SynchronizationMode.Supported()) {
+			return errors.New("unknown or unsupported synchronization mode")
+		}
+
+		if r.Response != "" {
+			return errors.New("non-empty prompt response")
+		}
+	} else {
+		if r.Session != "" {
+			return errors.New("non-empty session specification on message acknowledgement")
+		}
+
+		if !r.SynchronizationMode.IsDefault() {
+			return errors.New("synchronization mode specified on message acknowledgement")
+		}
+	}
+
+	return nil
+}
+
+func (r *PlanResponse) EnsureValid() error {
+	if r == nil {
+		return errors.New("nil plan response")
+	}
+
+	var fieldsSet int
+	if r.Message != "" {
+		fieldsSet++
+	}
+	if r.Prompt != "" {
+		fieldsSet++
+	}
+	if r.Plan != nil {
+		fieldsSet++
+		if err := r.Plan.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid plan")
+		}
+	}
+	if fieldsSet > 1 {
+		return errors.New("multiple fields set")
+	}
+
+	return nil
+}
+
+func (r *UpdateRequest) ensureValid(first bool) error {
+	if r == nil {
+		return errors.New("nil update request")
+	}
+
+	if first {
+		if r.Session == "" {
+			return errors.New("empty session specification")
+		}
+
+		if err := r.Configuration.EnsureValid(session.ConfigurationSourceTypeCreate); err != nil {
+			return errors.Wrap(err, "invalid session configuration")
+		}
+
+		if err := r.ConfigurationAlpha.EnsureValid(session.ConfigurationSourceTypeCreateEndpointSpecific); err != nil {
+			return errors.Wrap(err, "invalid alpha-specific configuration")
+		}
+
+		if err := r.ConfigurationBeta.EnsureValid(session.ConfigurationSourceTypeCreateEndpointSpecific); err != nil {
+			return errors.Wrap(err, "invalid beta-specific configuration")
+		}
+
+		if len(r.Fields) == 0 && len(r.FieldsAlpha) == 0 && len(r.FieldsBeta) == 0 {
+			return errors.New("no updated fields specified")
+		}
+
+		if r.Response != "" {
+			return errors.New("non-empty prompt response")
+		}
+	} else {
+		if r.Session != "" {
+			return errors.New("non-empty session specification on message acknowledgement")
+		}
+
+		if r.Configuration != nil {
+			return errors.New("configuration present")
+		}
+
+		if r.ConfigurationAlpha != nil {
+			return errors.New("alpha-specific configuration present")
+		}
+
+		if r.ConfigurationBeta != nil {
+			return errors.New("beta-specific configuration present")
+		}
+
+		if len(r.Fields) > 0 || len(r.FieldsAlpha) > 0 || len(r.FieldsBeta) > 0 {
+			return errors.New("updated fields present")
+		}
+	}
+
+	return nil
+}
+
+func (r *UpdateResponse) EnsureValid() error {
+	if r == nil {
+		return errors.New("nil update response")
+	}
+
+	var fieldsSet int
+	if r.Message != "" {
+		fieldsSet++
+	}
+	if r.Prompt != "" {
+		fieldsSet++
+	}
+	if fieldsSet > 1 {
+		return errors.New("multiple fields set")
+	}
+
+	return nil
+}
+
+func (r *HistoryRequest) ensureValid() error {
+	if r == nil {
+		return errors.New("nil history request")
+	}
+
+	if r.Session == "" {
+		return errors.New("empty session specification")
+	}
+
+	if r.Path != "" {
+		if _, err := doublestar.Match(r.Path, "a"); err != nil {
+			return errors.Wrap(err, "invalid path pattern")
+		}
+	}
+
+	return nil
+}
+
+func (r *HistoryResponse) EnsureValid() error {
+	if r == nil {
+		return errors.New("nil history response")
+	}
+
+	for _, record := range r.Records {
+		if err := record.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid history record")
+		}
+	}
+
+	return nil
+}
+
+func (r *EventsRequest) ensureValid() error {
+	if r == nil {
+		return errors.New("nil events request")
+	}
+
+	return nil
+}
+
+func (r *EventsResponse) EnsureValid() error {
+	if r == nil {
+		return errors.New("nil events response")
+	}
+
+	if err := r.Event.EnsureValid(); err != nil {
+		return errors.Wrap(err, "invalid event")
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import session "github.com/RokyErickson/doppelganger/pkg/session"
+import sync "github.com/RokyErickson/doppelganger/pkg/sync"
 import url "github.com/RokyErickson/doppelganger/pkg/url"
 
 import (
@@ -27,6 +28,8 @@ type CreateRequest struct {
 	ConfigurationAlpha   *session.Configuration `protobuf:"bytes,4,opt,name=configurationAlpha,proto3" json:"configurationAlpha,omitempty"`
 	ConfigurationBeta    *session.Configuration `protobuf:"bytes,5,opt,name=configurationBeta,proto3" json:"configurationBeta,omitempty"`
 	Response             string                 `protobuf:"bytes,6,opt,name=response,proto3" json:"response,omitempty"`
+	Labels               map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name                 string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -36,7 +39,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{0}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{0}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
@@ -98,6 +101,20 @@ func (m *CreateRequest) GetResponse() string {
 	return ""
 }
 
+func (m *CreateRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *CreateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type CreateResponse struct {
 	Session              string   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -111,7 +128,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{1}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{1}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateResponse.Unmarshal(m, b)
@@ -155,6 +172,7 @@ func (m *CreateResponse) GetPrompt() string {
 type ListRequest struct {
 	PreviousStateIndex   uint64   `protobuf:"varint,1,opt,name=previousStateIndex,proto3" json:"previousStateIndex,omitempty"`
 	Specifications       []string `protobuf:"bytes,2,rep,name=specifications,proto3" json:"specifications,omitempty"`
+	LabelSelector        string   `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -164,7 +182,7 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{2}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{2}
 }
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
@@ -198,6 +216,13 @@ func (m *ListRequest) GetSpecifications() []string {
 	return nil
 }
 
+func (m *ListRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
 type ListResponse struct {
 	StateIndex           uint64           `protobuf:"varint,1,opt,name=stateIndex,proto3" json:"stateIndex,omitempty"`
 	SessionStates        []*session.State `protobuf:"bytes,2,rep,name=sessionStates,proto3" json:"sessionStates,omitempty"`
@@ -210,7 +235,7 @@ func (m *ListResponse) Reset()         { *m = ListResponse{} }
 func (m *ListResponse) String() string { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()    {}
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{3}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{3}
 }
 func (m *ListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
@@ -247,6 +272,7 @@ func (m *ListResponse) GetSessionStates() []*session.State {
 type FlushRequest struct {
 	Specifications       []string `protobuf:"bytes,1,rep,name=specifications,proto3" json:"specifications,omitempty"`
 	SkipWait             bool     `protobuf:"varint,2,opt,name=skipWait,proto3" json:"skipWait,omitempty"`
+	LabelSelector        string   `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -256,7 +282,7 @@ func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
 func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushRequest) ProtoMessage()    {}
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{4}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{4}
 }
 func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
@@ -290,6 +316,13 @@ func (m *FlushRequest) GetSkipWait() bool {
 	return false
 }
 
+func (m *FlushRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
 type FlushResponse struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -301,7 +334,7 @@ func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
 func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
 func (*FlushResponse) ProtoMessage()    {}
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{5}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{5}
 }
 func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
@@ -330,6 +363,7 @@ func (m *FlushResponse) GetMessage() string {
 
 type PauseRequest struct {
 	Specifications       []string `protobuf:"bytes,1,rep,name=specifications,proto3" json:"specifications,omitempty"`
+	LabelSelector        string   `protobuf:"bytes,2,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -339,7 +373,7 @@ func (m *PauseRequest) Reset()         { *m = PauseRequest{} }
 func (m *PauseRequest) String() string { return proto.CompactTextString(m) }
 func (*PauseRequest) ProtoMessage()    {}
 func (*PauseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{6}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{6}
 }
 func (m *PauseRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PauseRequest.Unmarshal(m, b)
@@ -366,6 +400,13 @@ func (m *PauseRequest) GetSpecifications() []string {
 	return nil
 }
 
+func (m *PauseRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
 type PauseResponse struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -377,7 +418,7 @@ func (m *PauseResponse) Reset()         { *m = PauseResponse{} }
 func (m *PauseResponse) String() string { return proto.CompactTextString(m) }
 func (*PauseResponse) ProtoMessage()    {}
 func (*PauseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{7}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{7}
 }
 func (m *PauseResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PauseResponse.Unmarshal(m, b)
@@ -407,6 +448,7 @@ func (m *PauseResponse) GetMessage() string {
 type ResumeRequest struct {
 	Specifications       []string `protobuf:"bytes,1,rep,name=specifications,proto3" json:"specifications,omitempty"`
 	Response             string   `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	LabelSelector        string   `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -416,7 +458,7 @@ func (m *ResumeRequest) Reset()         { *m = ResumeRequest{} }
 func (m *ResumeRequest) String() string { return proto.CompactTextString(m) }
 func (*ResumeRequest) ProtoMessage()    {}
 func (*ResumeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{8}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{8}
 }
 func (m *ResumeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResumeRequest.Unmarshal(m, b)
@@ -450,6 +492,13 @@ func (m *ResumeRequest) GetResponse() string {
 	return ""
 }
 
+func (m *ResumeRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
 type ResumeResponse struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	Prompt               string   `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
@@ -462,7 +511,7 @@ func (m *ResumeResponse) Reset()         { *m = ResumeResponse{} }
 func (m *ResumeResponse) String() string { return proto.CompactTextString(m) }
 func (*ResumeResponse) ProtoMessage()    {}
 func (*ResumeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{9}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{9}
 }
 func (m *ResumeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResumeResponse.Unmarshal(m, b)
@@ -498,6 +547,7 @@ func (m *ResumeResponse) GetPrompt() string {
 
 type TerminateRequest struct {
 	Specifications       []string `protobuf:"bytes,1,rep,name=specifications,proto3" json:"specifications,omitempty"`
+	LabelSelector        string   `protobuf:"bytes,2,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -507,7 +557,7 @@ func (m *TerminateRequest) Reset()         { *m = TerminateRequest{} }
 func (m *TerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*TerminateRequest) ProtoMessage()    {}
 func (*TerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{10}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{10}
 }
 func (m *TerminateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TerminateRequest.Unmarshal(m, b)
@@ -534,6 +584,13 @@ func (m *TerminateRequest) GetSpecifications() []string {
 	return nil
 }
 
+func (m *TerminateRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
 type TerminateResponse struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -545,7 +602,7 @@ func (m *TerminateResponse) Reset()         { *m = TerminateResponse{} }
 func (m *TerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*TerminateResponse) ProtoMessage()    {}
 func (*TerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_dd805e133a97b5e9, []int{11}
+	return fileDescriptor_session_38563b82b9a0f74a, []int{11}
 }
 func (m *TerminateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TerminateResponse.Unmarshal(m, b)
@@ -572,236 +629,1228 @@ func (m *TerminateResponse) GetMessage() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*CreateRequest)(nil), "session.CreateRequest")
-	proto.RegisterType((*CreateResponse)(nil), "session.CreateResponse")
-	proto.RegisterType((*ListRequest)(nil), "session.ListRequest")
-	proto.RegisterType((*ListResponse)(nil), "session.ListResponse")
-	proto.RegisterType((*FlushRequest)(nil), "session.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "session.FlushResponse")
-	proto.RegisterType((*PauseRequest)(nil), "session.PauseRequest")
-	proto.RegisterType((*PauseResponse)(nil), "session.PauseResponse")
-	proto.RegisterType((*ResumeRequest)(nil), "session.ResumeRequest")
-	proto.RegisterType((*ResumeResponse)(nil), "session.ResumeResponse")
-	proto.RegisterType((*TerminateRequest)(nil), "session.TerminateRequest")
-	proto.RegisterType((*TerminateResponse)(nil), "session.TerminateResponse")
+type ResolveRequest struct {
+	Session              string              `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Path                 string              `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Winner               sync.ConflictWinner `protobuf:"varint,3,opt,name=winner,proto3,enum=sync.ConflictWinner" json:"winner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-var _ context.Context
-var _ grpc.ClientConn
-
-const _ = grpc.SupportPackageIsVersion4
-
-type SessionsClient interface {
-	Create(ctx context.Context, opts ...grpc.CallOption) (Sessions_CreateClient, error)
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
-	Flush(ctx context.Context, opts ...grpc.CallOption) (Sessions_FlushClient, error)
-	Pause(ctx context.Context, opts ...grpc.CallOption) (Sessions_PauseClient, error)
-	Resume(ctx context.Context, opts ...grpc.CallOption) (Sessions_ResumeClient, error)
-	Terminate(ctx context.Context, opts ...grpc.CallOption) (Sessions_TerminateClient, error)
+func (m *ResolveRequest) Reset()         { *m = ResolveRequest{} }
+func (m *ResolveRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveRequest) ProtoMessage()    {}
+func (*ResolveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{12}
 }
-
-type sessionsClient struct {
-	cc *grpc.ClientConn
+func (m *ResolveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveRequest.Unmarshal(m, b)
 }
-
-func NewSessionsClient(cc *grpc.ClientConn) SessionsClient {
-	return &sessionsClient{cc}
+func (m *ResolveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveRequest.Marshal(b, m, deterministic)
 }
-
-func (c *sessionsClient) Create(ctx context.Context, opts ...grpc.CallOption) (Sessions_CreateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[0], "/session.Sessions/Create", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &sessionsCreateClient{stream}
-	return x, nil
+func (dst *ResolveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveRequest.Merge(dst, src)
 }
-
-type Sessions_CreateClient interface {
-	Send(*CreateRequest) error
-	Recv() (*CreateResponse, error)
-	grpc.ClientStream
+func (m *ResolveRequest) XXX_Size() int {
+	return xxx_messageInfo_ResolveRequest.Size(m)
 }
-
-type sessionsCreateClient struct {
-	grpc.ClientStream
+func (m *ResolveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveRequest.DiscardUnknown(m)
 }
 
-func (x *sessionsCreateClient) Send(m *CreateRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
+var xxx_messageInfo_ResolveRequest proto.InternalMessageInfo
 
-func (x *sessionsCreateClient) Recv() (*CreateResponse, error) {
-	m := new(CreateResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ResolveRequest) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	return m, nil
+	return ""
 }
 
-func (c *sessionsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
-	out := new(ListResponse)
-	err := c.cc.Invoke(ctx, "/session.Sessions/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResolveRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return out, nil
+	return ""
 }
 
-func (c *sessionsClient) Flush(ctx context.Context, opts ...grpc.CallOption) (Sessions_FlushClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[1], "/session.Sessions/Flush", opts...)
-	if err != nil {
-		return nil, err
+func (m *ResolveRequest) GetWinner() sync.ConflictWinner {
+	if m != nil {
+		return m.Winner
 	}
-	x := &sessionsFlushClient{stream}
-	return x, nil
+	return sync.ConflictWinner_ConflictWinnerInvalid
 }
 
-type Sessions_FlushClient interface {
-	Send(*FlushRequest) error
-	Recv() (*FlushResponse, error)
-	grpc.ClientStream
+type ResolveResponse struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type sessionsFlushClient struct {
-	grpc.ClientStream
+func (m *ResolveResponse) Reset()         { *m = ResolveResponse{} }
+func (m *ResolveResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveResponse) ProtoMessage()    {}
+func (*ResolveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{13}
 }
-
-func (x *sessionsFlushClient) Send(m *FlushRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ResolveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResolveResponse.Unmarshal(m, b)
 }
-
-func (x *sessionsFlushClient) Recv() (*FlushResponse, error) {
-	m := new(FlushResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *ResolveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResolveResponse.Marshal(b, m, deterministic)
+}
+func (dst *ResolveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveResponse.Merge(dst, src)
+}
+func (m *ResolveResponse) XXX_Size() int {
+	return xxx_messageInfo_ResolveResponse.Size(m)
+}
+func (m *ResolveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveResponse.DiscardUnknown(m)
 }
 
-func (c *sessionsClient) Pause(ctx context.Context, opts ...grpc.CallOption) (Sessions_PauseClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[2], "/session.Sessions/Pause", opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResolveResponse proto.InternalMessageInfo
+
+func (m *ResolveResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	x := &sessionsPauseClient{stream}
-	return x, nil
+	return ""
 }
 
-type Sessions_PauseClient interface {
-	Send(*PauseRequest) error
-	Recv() (*PauseResponse, error)
-	grpc.ClientStream
+type AcknowledgeRequest struct {
+	Session              string                      `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Acknowledgement      session.HaltAcknowledgement `protobuf:"varint,2,opt,name=acknowledgement,proto3,enum=session.HaltAcknowledgement" json:"acknowledgement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-type sessionsPauseClient struct {
-	grpc.ClientStream
+func (m *AcknowledgeRequest) Reset()         { *m = AcknowledgeRequest{} }
+func (m *AcknowledgeRequest) String() string { return proto.CompactTextString(m) }
+func (*AcknowledgeRequest) ProtoMessage()    {}
+func (*AcknowledgeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{14}
 }
-
-func (x *sessionsPauseClient) Send(m *PauseRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *AcknowledgeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcknowledgeRequest.Unmarshal(m, b)
+}
+func (m *AcknowledgeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcknowledgeRequest.Marshal(b, m, deterministic)
+}
+func (dst *AcknowledgeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcknowledgeRequest.Merge(dst, src)
+}
+func (m *AcknowledgeRequest) XXX_Size() int {
+	return xxx_messageInfo_AcknowledgeRequest.Size(m)
+}
+func (m *AcknowledgeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcknowledgeRequest.DiscardUnknown(m)
 }
 
-func (x *sessionsPauseClient) Recv() (*PauseResponse, error) {
-	m := new(PauseResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_AcknowledgeRequest proto.InternalMessageInfo
+
+func (m *AcknowledgeRequest) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	return m, nil
+	return ""
 }
 
-func (c *sessionsClient) Resume(ctx context.Context, opts ...grpc.CallOption) (Sessions_ResumeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[3], "/session.Sessions/Resume", opts...)
-	if err != nil {
-		return nil, err
+func (m *AcknowledgeRequest) GetAcknowledgement() session.HaltAcknowledgement {
+	if m != nil {
+		return m.Acknowledgement
 	}
-	x := &sessionsResumeClient{stream}
-	return x, nil
+	return session.HaltAcknowledgement_HaltAcknowledgementInvalid
 }
 
-type Sessions_ResumeClient interface {
-	Send(*ResumeRequest) error
-	Recv() (*ResumeResponse, error)
-	grpc.ClientStream
+type AcknowledgeResponse struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type sessionsResumeClient struct {
-	grpc.ClientStream
+func (m *AcknowledgeResponse) Reset()         { *m = AcknowledgeResponse{} }
+func (m *AcknowledgeResponse) String() string { return proto.CompactTextString(m) }
+func (*AcknowledgeResponse) ProtoMessage()    {}
+func (*AcknowledgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{15}
 }
-
-func (x *sessionsResumeClient) Send(m *ResumeRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *AcknowledgeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcknowledgeResponse.Unmarshal(m, b)
 }
-
-func (x *sessionsResumeClient) Recv() (*ResumeResponse, error) {
-	m := new(ResumeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *AcknowledgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcknowledgeResponse.Marshal(b, m, deterministic)
+}
+func (dst *AcknowledgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcknowledgeResponse.Merge(dst, src)
+}
+func (m *AcknowledgeResponse) XXX_Size() int {
+	return xxx_messageInfo_AcknowledgeResponse.Size(m)
+}
+func (m *AcknowledgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcknowledgeResponse.DiscardUnknown(m)
 }
 
-func (c *sessionsClient) Terminate(ctx context.Context, opts ...grpc.CallOption) (Sessions_TerminateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[4], "/session.Sessions/Terminate", opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AcknowledgeResponse proto.InternalMessageInfo
+
+func (m *AcknowledgeResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	x := &sessionsTerminateClient{stream}
-	return x, nil
+	return ""
 }
 
-type Sessions_TerminateClient interface {
-	Send(*TerminateRequest) error
-	Recv() (*TerminateResponse, error)
-	grpc.ClientStream
+type ListVersionsRequest struct {
+	Session              string   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type sessionsTerminateClient struct {
-	grpc.ClientStream
+func (m *ListVersionsRequest) Reset()         { *m = ListVersionsRequest{} }
+func (m *ListVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListVersionsRequest) ProtoMessage()    {}
+func (*ListVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{16}
 }
-
-func (x *sessionsTerminateClient) Send(m *TerminateRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ListVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVersionsRequest.Unmarshal(m, b)
+}
+func (m *ListVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVersionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVersionsRequest.Merge(dst, src)
+}
+func (m *ListVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListVersionsRequest.Size(m)
+}
+func (m *ListVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVersionsRequest.DiscardUnknown(m)
 }
 
-func (x *sessionsTerminateClient) Recv() (*TerminateResponse, error) {
-	m := new(TerminateResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_ListVersionsRequest proto.InternalMessageInfo
+
+func (m *ListVersionsRequest) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	return m, nil
+	return ""
 }
 
-type SessionsServer interface {
-	Create(Sessions_CreateServer) error
-	List(context.Context, *ListRequest) (*ListResponse, error)
-	Flush(Sessions_FlushServer) error
-	Pause(Sessions_PauseServer) error
-	Resume(Sessions_ResumeServer) error
-	Terminate(Sessions_TerminateServer) error
+func (m *ListVersionsRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-func RegisterSessionsServer(s *grpc.Server, srv SessionsServer) {
-	s.RegisterService(&_Sessions_serviceDesc, srv)
+type ListVersionsResponse struct {
+	AlphaVersions        []*sync.FileVersion `protobuf:"bytes,1,rep,name=alphaVersions,proto3" json:"alphaVersions,omitempty"`
+	BetaVersions         []*sync.FileVersion `protobuf:"bytes,2,rep,name=betaVersions,proto3" json:"betaVersions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func _Sessions_Create_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SessionsServer).Create(&sessionsCreateServer{stream})
+func (m *ListVersionsResponse) Reset()         { *m = ListVersionsResponse{} }
+func (m *ListVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListVersionsResponse) ProtoMessage()    {}
+func (*ListVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{17}
 }
-
-type Sessions_CreateServer interface {
-	Send(*CreateResponse) error
-	Recv() (*CreateRequest, error)
-	grpc.ServerStream
+func (m *ListVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVersionsResponse.Unmarshal(m, b)
 }
-
-type sessionsCreateServer struct {
-	grpc.ServerStream
+func (m *ListVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVersionsResponse.Marshal(b, m, deterministic)
 }
-
-func (x *sessionsCreateServer) Send(m *CreateResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (dst *ListVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVersionsResponse.Merge(dst, src)
+}
+func (m *ListVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListVersionsResponse.Size(m)
+}
+func (m *ListVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListVersionsResponse proto.InternalMessageInfo
+
+func (m *ListVersionsResponse) GetAlphaVersions() []*sync.FileVersion {
+	if m != nil {
+		return m.AlphaVersions
+	}
+	return nil
+}
+
+func (m *ListVersionsResponse) GetBetaVersions() []*sync.FileVersion {
+	if m != nil {
+		return m.BetaVersions
+	}
+	return nil
+}
+
+type RestoreVersionRequest struct {
+	Session              string   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Beta                 bool     `protobuf:"varint,3,opt,name=beta,proto3" json:"beta,omitempty"`
+	Digest               string   `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreVersionRequest) Reset()         { *m = RestoreVersionRequest{} }
+func (m *RestoreVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreVersionRequest) ProtoMessage()    {}
+func (*RestoreVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{18}
+}
+func (m *RestoreVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreVersionRequest.Unmarshal(m, b)
+}
+func (m *RestoreVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreVersionRequest.Merge(dst, src)
+}
+func (m *RestoreVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreVersionRequest.Size(m)
+}
+func (m *RestoreVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreVersionRequest proto.InternalMessageInfo
+
+func (m *RestoreVersionRequest) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *RestoreVersionRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RestoreVersionRequest) GetBeta() bool {
+	if m != nil {
+		return m.Beta
+	}
+	return false
+}
+
+func (m *RestoreVersionRequest) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+type RestoreVersionResponse struct {
+	Version              *sync.FileVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RestoreVersionResponse) Reset()         { *m = RestoreVersionResponse{} }
+func (m *RestoreVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreVersionResponse) ProtoMessage()    {}
+func (*RestoreVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{19}
+}
+func (m *RestoreVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreVersionResponse.Unmarshal(m, b)
+}
+func (m *RestoreVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreVersionResponse.Merge(dst, src)
+}
+func (m *RestoreVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreVersionResponse.Size(m)
+}
+func (m *RestoreVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreVersionResponse proto.InternalMessageInfo
+
+func (m *RestoreVersionResponse) GetVersion() *sync.FileVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+type PlanRequest struct {
+	Session              string                   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	SynchronizationMode  sync.SynchronizationMode `protobuf:"varint,2,opt,name=synchronizationMode,proto3,enum=sync.SynchronizationMode" json:"synchronizationMode,omitempty"`
+	Response             string                   `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *PlanRequest) Reset()         { *m = PlanRequest{} }
+func (m *PlanRequest) String() string { return proto.CompactTextString(m) }
+func (*PlanRequest) ProtoMessage()    {}
+func (*PlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{20}
+}
+func (m *PlanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanRequest.Unmarshal(m, b)
+}
+func (m *PlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanRequest.Marshal(b, m, deterministic)
+}
+func (dst *PlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanRequest.Merge(dst, src)
+}
+func (m *PlanRequest) XXX_Size() int {
+	return xxx_messageInfo_PlanRequest.Size(m)
+}
+func (m *PlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanRequest proto.InternalMessageInfo
+
+func (m *PlanRequest) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *PlanRequest) GetSynchronizationMode() sync.SynchronizationMode {
+	if m != nil {
+		return m.SynchronizationMode
+	}
+	return sync.SynchronizationMode_SynchronizationModeDefault
+}
+
+func (m *PlanRequest) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+type PlanResponse struct {
+	Message              string        `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Prompt               string        `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Plan                 *session.Plan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PlanResponse) Reset()         { *m = PlanResponse{} }
+func (m *PlanResponse) String() string { return proto.CompactTextString(m) }
+func (*PlanResponse) ProtoMessage()    {}
+func (*PlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{21}
+}
+func (m *PlanResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanResponse.Unmarshal(m, b)
+}
+func (m *PlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanResponse.Marshal(b, m, deterministic)
+}
+func (dst *PlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanResponse.Merge(dst, src)
+}
+func (m *PlanResponse) XXX_Size() int {
+	return xxx_messageInfo_PlanResponse.Size(m)
+}
+func (m *PlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanResponse proto.InternalMessageInfo
+
+func (m *PlanResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PlanResponse) GetPrompt() string {
+	if m != nil {
+		return m.Prompt
+	}
+	return ""
+}
+
+func (m *PlanResponse) GetPlan() *session.Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+type UpdateRequest struct {
+	Session              string                 `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Configuration        *session.Configuration `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	ConfigurationAlpha   *session.Configuration `protobuf:"bytes,3,opt,name=configurationAlpha,proto3" json:"configurationAlpha,omitempty"`
+	ConfigurationBeta    *session.Configuration `protobuf:"bytes,4,opt,name=configurationBeta,proto3" json:"configurationBeta,omitempty"`
+	Response             string                 `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`
+	Fields               []string               `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	FieldsAlpha          []string               `protobuf:"bytes,7,rep,name=fieldsAlpha,proto3" json:"fieldsAlpha,omitempty"`
+	FieldsBeta           []string               `protobuf:"bytes,8,rep,name=fieldsBeta,proto3" json:"fieldsBeta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()    {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{22}
+}
+func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
+}
+func (m *UpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRequest.Merge(dst, src)
+}
+func (m *UpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRequest.Size(m)
+}
+func (m *UpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRequest proto.InternalMessageInfo
+
+func (m *UpdateRequest) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetConfiguration() *session.Configuration {
+	if m != nil {
+		return m.Configuration
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetConfigurationAlpha() *session.Configuration {
+	if m != nil {
+		return m.ConfigurationAlpha
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetConfigurationBeta() *session.Configuration {
+	if m != nil {
+		return m.ConfigurationBeta
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetFieldsAlpha() []string {
+	if m != nil {
+		return m.FieldsAlpha
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetFieldsBeta() []string {
+	if m != nil {
+		return m.FieldsBeta
+	}
+	return nil
+}
+
+type UpdateResponse struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Prompt               string   `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
+func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateResponse) ProtoMessage()    {}
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{23}
+}
+func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateResponse.Unmarshal(m, b)
+}
+func (m *UpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResponse.Merge(dst, src)
+}
+func (m *UpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateResponse.Size(m)
+}
+func (m *UpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateResponse proto.InternalMessageInfo
+
+func (m *UpdateResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *UpdateResponse) GetPrompt() string {
+	if m != nil {
+		return m.Prompt
+	}
+	return ""
+}
+
+type HistoryRequest struct {
+	Session              string   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
+func (m *HistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoryRequest) ProtoMessage()    {}
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{24}
+}
+func (m *HistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryRequest.Unmarshal(m, b)
+}
+func (m *HistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryRequest.Marshal(b, m, deterministic)
+}
+func (dst *HistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRequest.Merge(dst, src)
+}
+func (m *HistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_HistoryRequest.Size(m)
+}
+func (m *HistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryRequest proto.InternalMessageInfo
+
+func (m *HistoryRequest) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *HistoryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type HistoryResponse struct {
+	Records              []*session.HistoryRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *HistoryResponse) Reset()         { *m = HistoryResponse{} }
+func (m *HistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoryResponse) ProtoMessage()    {}
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{25}
+}
+func (m *HistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryResponse.Unmarshal(m, b)
+}
+func (m *HistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryResponse.Marshal(b, m, deterministic)
+}
+func (dst *HistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryResponse.Merge(dst, src)
+}
+func (m *HistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_HistoryResponse.Size(m)
+}
+func (m *HistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryResponse proto.InternalMessageInfo
+
+func (m *HistoryResponse) GetRecords() []*session.HistoryRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type EventsRequest struct {
+	Specifications       []string `protobuf:"bytes,1,rep,name=specifications,proto3" json:"specifications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{26}
+}
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsRequest.Unmarshal(m, b)
+}
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(dst, src)
+}
+func (m *EventsRequest) XXX_Size() int {
+	return xxx_messageInfo_EventsRequest.Size(m)
+}
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+
+func (m *EventsRequest) GetSpecifications() []string {
+	if m != nil {
+		return m.Specifications
+	}
+	return nil
+}
+
+type EventsResponse struct {
+	Event                *session.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
+func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()    {}
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_38563b82b9a0f74a, []int{27}
+}
+func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsResponse.Unmarshal(m, b)
+}
+func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+}
+func (dst *EventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsResponse.Merge(dst, src)
+}
+func (m *EventsResponse) XXX_Size() int {
+	return xxx_messageInfo_EventsResponse.Size(m)
+}
+func (m *EventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+
+func (m *EventsResponse) GetEvent() *session.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreateRequest)(nil), "session.CreateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "session.CreateRequest.LabelsEntry")
+	proto.RegisterType((*CreateResponse)(nil), "session.CreateResponse")
+	proto.RegisterType((*ListRequest)(nil), "session.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "session.ListResponse")
+	proto.RegisterType((*FlushRequest)(nil), "session.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "session.FlushResponse")
+	proto.RegisterType((*PauseRequest)(nil), "session.PauseRequest")
+	proto.RegisterType((*PauseResponse)(nil), "session.PauseResponse")
+	proto.RegisterType((*ResumeRequest)(nil), "session.ResumeRequest")
+	proto.RegisterType((*ResumeResponse)(nil), "session.ResumeResponse")
+	proto.RegisterType((*TerminateRequest)(nil), "session.TerminateRequest")
+	proto.RegisterType((*TerminateResponse)(nil), "session.TerminateResponse")
+	proto.RegisterType((*ResolveRequest)(nil), "session.ResolveRequest")
+	proto.RegisterType((*ResolveResponse)(nil), "session.ResolveResponse")
+	proto.RegisterType((*AcknowledgeRequest)(nil), "session.AcknowledgeRequest")
+	proto.RegisterType((*AcknowledgeResponse)(nil), "session.AcknowledgeResponse")
+	proto.RegisterType((*ListVersionsRequest)(nil), "session.ListVersionsRequest")
+	proto.RegisterType((*ListVersionsResponse)(nil), "session.ListVersionsResponse")
+	proto.RegisterType((*RestoreVersionRequest)(nil), "session.RestoreVersionRequest")
+	proto.RegisterType((*RestoreVersionResponse)(nil), "session.RestoreVersionResponse")
+	proto.RegisterType((*PlanRequest)(nil), "session.PlanRequest")
+	proto.RegisterType((*PlanResponse)(nil), "session.PlanResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "session.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "session.UpdateResponse")
+	proto.RegisterType((*HistoryRequest)(nil), "session.HistoryRequest")
+	proto.RegisterType((*HistoryResponse)(nil), "session.HistoryResponse")
+	proto.RegisterType((*EventsRequest)(nil), "session.EventsRequest")
+	proto.RegisterType((*EventsResponse)(nil), "session.EventsResponse")
+}
+
+var _ context.Context
+var _ grpc.ClientConn
+
+const _ = grpc.SupportPackageIsVersion4
+
+type SessionsClient interface {
+	Create(ctx context.Context, opts ...grpc.CallOption) (Sessions_CreateClient, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	Flush(ctx context.Context, opts ...grpc.CallOption) (Sessions_FlushClient, error)
+	Pause(ctx context.Context, opts ...grpc.CallOption) (Sessions_PauseClient, error)
+	Resume(ctx context.Context, opts ...grpc.CallOption) (Sessions_ResumeClient, error)
+	Terminate(ctx context.Context, opts ...grpc.CallOption) (Sessions_TerminateClient, error)
+	Resolve(ctx context.Context, opts ...grpc.CallOption) (Sessions_ResolveClient, error)
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error)
+	RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionResponse, error)
+	Plan(ctx context.Context, opts ...grpc.CallOption) (Sessions_PlanClient, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Sessions_EventsClient, error)
+	Update(ctx context.Context, opts ...grpc.CallOption) (Sessions_UpdateClient, error)
+	Acknowledge(ctx context.Context, opts ...grpc.CallOption) (Sessions_AcknowledgeClient, error)
+}
+
+type sessionsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSessionsClient(cc *grpc.ClientConn) SessionsClient {
+	return &sessionsClient{cc}
+}
+
+func (c *sessionsClient) Create(ctx context.Context, opts ...grpc.CallOption) (Sessions_CreateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[0], "/session.Sessions/Create", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsCreateClient{stream}
+	return x, nil
+}
+
+type Sessions_CreateClient interface {
+	Send(*CreateRequest) error
+	Recv() (*CreateResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsCreateClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsCreateClient) Send(m *CreateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsCreateClient) Recv() (*CreateResponse, error) {
+	m := new(CreateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/session.Sessions/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) Flush(ctx context.Context, opts ...grpc.CallOption) (Sessions_FlushClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[1], "/session.Sessions/Flush", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsFlushClient{stream}
+	return x, nil
+}
+
+type Sessions_FlushClient interface {
+	Send(*FlushRequest) error
+	Recv() (*FlushResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsFlushClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsFlushClient) Send(m *FlushRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsFlushClient) Recv() (*FlushResponse, error) {
+	m := new(FlushResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) Pause(ctx context.Context, opts ...grpc.CallOption) (Sessions_PauseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[2], "/session.Sessions/Pause", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsPauseClient{stream}
+	return x, nil
+}
+
+type Sessions_PauseClient interface {
+	Send(*PauseRequest) error
+	Recv() (*PauseResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsPauseClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsPauseClient) Send(m *PauseRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsPauseClient) Recv() (*PauseResponse, error) {
+	m := new(PauseResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) Resume(ctx context.Context, opts ...grpc.CallOption) (Sessions_ResumeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[3], "/session.Sessions/Resume", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsResumeClient{stream}
+	return x, nil
+}
+
+type Sessions_ResumeClient interface {
+	Send(*ResumeRequest) error
+	Recv() (*ResumeResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsResumeClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsResumeClient) Send(m *ResumeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsResumeClient) Recv() (*ResumeResponse, error) {
+	m := new(ResumeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) Terminate(ctx context.Context, opts ...grpc.CallOption) (Sessions_TerminateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[4], "/session.Sessions/Terminate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsTerminateClient{stream}
+	return x, nil
+}
+
+type Sessions_TerminateClient interface {
+	Send(*TerminateRequest) error
+	Recv() (*TerminateResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsTerminateClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsTerminateClient) Send(m *TerminateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsTerminateClient) Recv() (*TerminateResponse, error) {
+	m := new(TerminateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) Resolve(ctx context.Context, opts ...grpc.CallOption) (Sessions_ResolveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[5], "/session.Sessions/Resolve", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsResolveClient{stream}
+	return x, nil
+}
+
+type Sessions_ResolveClient interface {
+	Send(*ResolveRequest) error
+	Recv() (*ResolveResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsResolveClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsResolveClient) Send(m *ResolveRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsResolveClient) Recv() (*ResolveResponse, error) {
+	m := new(ResolveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error) {
+	out := new(ListVersionsResponse)
+	err := c.cc.Invoke(ctx, "/session.Sessions/ListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionResponse, error) {
+	out := new(RestoreVersionResponse)
+	err := c.cc.Invoke(ctx, "/session.Sessions/RestoreVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) Plan(ctx context.Context, opts ...grpc.CallOption) (Sessions_PlanClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[6], "/session.Sessions/Plan", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsPlanClient{stream}
+	return x, nil
+}
+
+type Sessions_PlanClient interface {
+	Send(*PlanRequest) error
+	Recv() (*PlanResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsPlanClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsPlanClient) Send(m *PlanRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsPlanClient) Recv() (*PlanResponse, error) {
+	m := new(PlanResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
+	out := new(HistoryResponse)
+	err := c.cc.Invoke(ctx, "/session.Sessions/History", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Sessions_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[7], "/session.Sessions/Events", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sessions_EventsClient interface {
+	Recv() (*EventsResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsEventsClient) Recv() (*EventsResponse, error) {
+	m := new(EventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) Update(ctx context.Context, opts ...grpc.CallOption) (Sessions_UpdateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[8], "/session.Sessions/Update", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsUpdateClient{stream}
+	return x, nil
+}
+
+type Sessions_UpdateClient interface {
+	Send(*UpdateRequest) error
+	Recv() (*UpdateResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsUpdateClient) Send(m *UpdateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsUpdateClient) Recv() (*UpdateResponse, error) {
+	m := new(UpdateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) Acknowledge(ctx context.Context, opts ...grpc.CallOption) (Sessions_AcknowledgeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Sessions_serviceDesc.Streams[9], "/session.Sessions/Acknowledge", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsAcknowledgeClient{stream}
+	return x, nil
+}
+
+type Sessions_AcknowledgeClient interface {
+	Send(*AcknowledgeRequest) error
+	Recv() (*AcknowledgeResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsAcknowledgeClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsAcknowledgeClient) Send(m *AcknowledgeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionsAcknowledgeClient) Recv() (*AcknowledgeResponse, error) {
+	m := new(AcknowledgeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type SessionsServer interface {
+	Create(Sessions_CreateServer) error
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	Flush(Sessions_FlushServer) error
+	Pause(Sessions_PauseServer) error
+	Resume(Sessions_ResumeServer) error
+	Terminate(Sessions_TerminateServer) error
+	Resolve(Sessions_ResolveServer) error
+	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error)
+	RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionResponse, error)
+	Plan(Sessions_PlanServer) error
+	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
+	Events(*EventsRequest, Sessions_EventsServer) error
+	Update(Sessions_UpdateServer) error
+	Acknowledge(Sessions_AcknowledgeServer) error
+}
+
+func RegisterSessionsServer(s *grpc.Server, srv SessionsServer) {
+	s.RegisterService(&_Sessions_serviceDesc, srv)
+}
+
+func _Sessions_Create_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SessionsServer).Create(&sessionsCreateServer{stream})
+}
+
+type Sessions_CreateServer interface {
+	Send(*CreateResponse) error
+	Recv() (*CreateRequest, error)
+	grpc.ServerStream
+}
+
+type sessionsCreateServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsCreateServer) Send(m *CreateResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
 func (x *sessionsCreateServer) Recv() (*CreateRequest, error) {
@@ -934,6 +1983,185 @@ func (x *sessionsTerminateServer) Recv() (*TerminateRequest, error) {
 	return m, nil
 }
 
+func _Sessions_Resolve_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SessionsServer).Resolve(&sessionsResolveServer{stream})
+}
+
+type Sessions_ResolveServer interface {
+	Send(*ResolveResponse) error
+	Recv() (*ResolveRequest, error)
+	grpc.ServerStream
+}
+
+type sessionsResolveServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsResolveServer) Send(m *ResolveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sessionsResolveServer) Recv() (*ResolveRequest, error) {
+	m := new(ResolveRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Sessions_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.Sessions/ListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListVersions(ctx, req.(*ListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RestoreVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RestoreVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.Sessions/RestoreVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RestoreVersion(ctx, req.(*RestoreVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_Plan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SessionsServer).Plan(&sessionsPlanServer{stream})
+}
+
+type Sessions_PlanServer interface {
+	Send(*PlanResponse) error
+	Recv() (*PlanRequest, error)
+	grpc.ServerStream
+}
+
+type sessionsPlanServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsPlanServer) Send(m *PlanResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sessionsPlanServer) Recv() (*PlanRequest, error) {
+	m := new(PlanRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Sessions_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.Sessions/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SessionsServer).Events(m, &sessionsEventsServer{stream})
+}
+
+type Sessions_EventsServer interface {
+	Send(*EventsResponse) error
+	grpc.ServerStream
+}
+
+type sessionsEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsEventsServer) Send(m *EventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Sessions_Update_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SessionsServer).Update(&sessionsUpdateServer{stream})
+}
+
+type Sessions_UpdateServer interface {
+	Send(*UpdateResponse) error
+	Recv() (*UpdateRequest, error)
+	grpc.ServerStream
+}
+
+type sessionsUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsUpdateServer) Send(m *UpdateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sessionsUpdateServer) Recv() (*UpdateRequest, error) {
+	m := new(UpdateRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Sessions_Acknowledge_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SessionsServer).Acknowledge(&sessionsAcknowledgeServer{stream})
+}
+
+type Sessions_AcknowledgeServer interface {
+	Send(*AcknowledgeResponse) error
+	Recv() (*AcknowledgeRequest, error)
+	grpc.ServerStream
+}
+
+type sessionsAcknowledgeServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsAcknowledgeServer) Send(m *AcknowledgeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sessionsAcknowledgeServer) Recv() (*AcknowledgeRequest, error) {
+	m := new(AcknowledgeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Sessions_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "session.Sessions",
 	HandlerType: (*SessionsServer)(nil),
@@ -942,6 +2170,18 @@ var _Sessions_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Sessions_List_Handler,
 		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _Sessions_ListVersions_Handler,
+		},
+		{
+			MethodName: "RestoreVersion",
+			Handler:    _Sessions_RestoreVersion_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Sessions_History_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -974,51 +2214,123 @@ var _Sessions_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Resolve",
+			Handler:       _Sessions_Resolve_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Plan",
+			Handler:       _Sessions_Plan_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Events",
+			Handler:       _Sessions_Events_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Update",
+			Handler:       _Sessions_Update_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Acknowledge",
+			Handler:       _Sessions_Acknowledge_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "service/session/session.proto",
 }
 
 func init() {
-	proto.RegisterFile("service/session/session.proto", fileDescriptor_session_dd805e133a97b5e9)
-}
-
-var fileDescriptor_session_dd805e133a97b5e9 = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x8e, 0xd2, 0x40,
-	0x14, 0xb6, 0x2c, 0x20, 0x3d, 0x50, 0xe2, 0x8e, 0x8a, 0xb5, 0xea, 0x86, 0xf4, 0xc2, 0xe0, 0xc5,
-	0x52, 0xc5, 0x9f, 0x0b, 0x43, 0xb2, 0x91, 0x35, 0x1b, 0x4d, 0xf6, 0xc2, 0x0c, 0x1a, 0x13, 0xe3,
-	0x4d, 0xe9, 0xce, 0xc2, 0x64, 0xe9, 0x8f, 0x9d, 0x29, 0xf1, 0x15, 0x7c, 0x20, 0xdf, 0xcf, 0x74,
-	0xda, 0xe9, 0xce, 0x14, 0xb2, 0xa8, 0x57, 0xe4, 0x7c, 0xe7, 0xff, 0x3b, 0xdf, 0x50, 0x78, 0xc2,
-	0x48, 0xba, 0xa1, 0x01, 0xf1, 0x18, 0x61, 0x8c, 0xc6, 0x91, 0xfc, 0x1d, 0x27, 0x69, 0xcc, 0x63,
-	0x74, 0xbb, 0x34, 0x9d, 0x47, 0xd2, 0x1f, 0xc4, 0xd1, 0x25, 0x5d, 0x66, 0xa9, 0xcf, 0xab, 0x28,
-	0xe7, 0x6e, 0x95, 0xcc, 0x7d, 0x4e, 0x4a, 0xd0, 0xca, 0xd2, 0xb5, 0x97, 0xa5, 0xeb, 0xc2, 0x74,
-	0x7f, 0x37, 0xc0, 0x3a, 0x4d, 0x89, 0xcf, 0x09, 0x26, 0x3f, 0x32, 0xc2, 0x38, 0x3a, 0x82, 0x96,
-	0xbf, 0x4e, 0x56, 0xbe, 0x6d, 0x0c, 0x8d, 0x51, 0x77, 0xd2, 0x19, 0xe7, 0xc1, 0x5f, 0xf0, 0x39,
-	0x2e, 0x60, 0xf4, 0x18, 0x9a, 0x0b, 0xc2, 0x7d, 0xbb, 0x51, 0x73, 0x0b, 0x14, 0x4d, 0xc1, 0xd2,
-	0x46, 0xb1, 0x0f, 0x44, 0xd8, 0x60, 0x2c, 0x17, 0x38, 0x55, 0xbd, 0x58, 0x0f, 0x46, 0x67, 0x80,
-	0x34, 0xe0, 0x9d, 0x18, 0xa4, 0x79, 0x63, 0x89, 0x1d, 0x19, 0xe8, 0x3d, 0x1c, 0x6a, 0xe8, 0x2c,
-	0x1f, 0xb8, 0x75, 0x63, 0x99, 0xed, 0x04, 0xe4, 0x40, 0x27, 0x25, 0x2c, 0x89, 0x23, 0x46, 0xec,
-	0xf6, 0xd0, 0x18, 0x99, 0xb8, 0xb2, 0xdd, 0xef, 0xd0, 0x97, 0xb4, 0x15, 0x08, 0xb2, 0x41, 0x5e,
-	0x45, 0x30, 0x67, 0x62, 0x69, 0xe6, 0x9e, 0x90, 0x30, 0xe6, 0x2f, 0x89, 0x20, 0xcd, 0xc4, 0xd2,
-	0x44, 0x03, 0x68, 0x27, 0x69, 0x1c, 0x26, 0x5c, 0xd0, 0x64, 0xe2, 0xd2, 0x72, 0x09, 0x74, 0xcf,
-	0x29, 0xe3, 0xf2, 0x24, 0x63, 0x40, 0x49, 0x4a, 0x36, 0x34, 0xce, 0xd8, 0x3c, 0x3f, 0xe5, 0xc7,
-	0xe8, 0x82, 0xfc, 0x14, 0x5d, 0x9a, 0x78, 0x87, 0x07, 0x3d, 0x85, 0x3e, 0x4b, 0x48, 0x40, 0x2f,
-	0x69, 0x20, 0xb6, 0x61, 0x76, 0x63, 0x78, 0x30, 0x32, 0x71, 0x0d, 0x75, 0x2f, 0xa0, 0x57, 0xb4,
-	0x29, 0x57, 0x38, 0x02, 0x60, 0xf5, 0xfa, 0x0a, 0x82, 0x5e, 0x81, 0x55, 0xee, 0x24, 0x9a, 0x15,
-	0x65, 0xbb, 0x93, 0x7e, 0x45, 0xa9, 0x80, 0xb1, 0x1e, 0xe4, 0x62, 0xe8, 0x9d, 0xad, 0x33, 0xb6,
-	0x92, 0xdb, 0x6c, 0x4f, 0x67, 0xec, 0x9a, 0x2e, 0xa7, 0x9f, 0x5d, 0xd1, 0xe4, 0xab, 0x4f, 0xb9,
-	0xe0, 0xad, 0x83, 0x2b, 0xdb, 0x7d, 0x06, 0x56, 0x59, 0xf3, 0x9a, 0x7d, 0xc9, 0xb1, 0xa1, 0x71,
-	0xec, 0xbe, 0x81, 0xde, 0x27, 0x3f, 0x63, 0xe4, 0x1f, 0xdb, 0xe7, 0x2d, 0xca, 0xbc, 0xbd, 0x2d,
-	0xe6, 0x60, 0x61, 0xc2, 0xb2, 0x90, 0xfc, 0xc7, 0x8a, 0x95, 0xc2, 0x1a, 0x35, 0x85, 0xcd, 0xa0,
-	0x2f, 0x8b, 0xee, 0x1b, 0x40, 0xd1, 0x51, 0x43, 0xd3, 0xd1, 0x5b, 0xb8, 0xf3, 0x99, 0xa4, 0x21,
-	0x8d, 0x94, 0xf7, 0xfd, 0xb7, 0xfb, 0x1f, 0xc3, 0xa1, 0x92, 0xbb, 0x6f, 0x84, 0xc9, 0xaf, 0x03,
-	0xe8, 0xcc, 0x8b, 0xbb, 0x33, 0x74, 0x02, 0xed, 0xe2, 0x75, 0x20, 0xe5, 0xb9, 0xa9, 0xff, 0x32,
-	0xce, 0x83, 0x2d, 0xbc, 0x5c, 0xfb, 0xd6, 0xc8, 0x78, 0x6e, 0xa0, 0xd7, 0xd0, 0xcc, 0x95, 0x89,
-	0xee, 0x55, 0x61, 0xca, 0x7b, 0x70, 0xee, 0xd7, 0x50, 0x99, 0x8a, 0xa6, 0xd0, 0x12, 0xb2, 0x40,
-	0xd7, 0x11, 0xaa, 0xf4, 0x9c, 0x41, 0x1d, 0xd6, 0x9a, 0x4e, 0xa1, 0x25, 0x2e, 0xae, 0x64, 0xab,
-	0xca, 0x51, 0xb2, 0x35, 0x61, 0x94, 0xd9, 0x27, 0xd0, 0x2e, 0xee, 0xa5, 0xec, 0xac, 0xa9, 0x42,
-	0xd9, 0x59, 0x3f, 0x6c, 0x59, 0xe0, 0x03, 0x98, 0x15, 0xe1, 0xe8, 0x61, 0x15, 0x5b, 0x3f, 0xa0,
-	0xe3, 0xec, 0x72, 0xa9, 0x95, 0x66, 0x2f, 0xbe, 0x79, 0x4b, 0xca, 0x57, 0xd9, 0x62, 0x1c, 0xc4,
-	0xa1, 0xb7, 0xf2, 0x37, 0x71, 0x70, 0x4c, 0x63, 0x2f, 0xcc, 0xb8, 0xbf, 0x24, 0x91, 0x97, 0x5c,
-	0x2d, 0xbd, 0xda, 0xf7, 0x65, 0xd1, 0x16, 0x9f, 0x83, 0x97, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xa2, 0xd4, 0xa9, 0x63, 0x79, 0x06, 0x00, 0x00,
+	proto.RegisterFile("service/session/session.proto", fileDescriptor_session_38563b82b9a0f74a)
+}
+
+var fileDescriptor_session_38563b82b9a0f74a = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0xfe, 0x75, 0x97, 0x8e, 0x2c, 0x39, 0x99, 0xd8, 0xb2, 0xc2, 0x38, 0xf9, 0x5d, 0xa2, 0x28,
+	0x5c, 0xa4, 0x95, 0x02, 0xb5, 0x69, 0x2e, 0x70, 0x13, 0xd8, 0xae, 0x0d, 0x17, 0x75, 0x8a, 0x80,
+	0x6e, 0x1a, 0xa0, 0xc8, 0xa2, 0x34, 0x35, 0x96, 0x08, 0x51, 0x24, 0xc3, 0xa1, 0x94, 0xa8, 0x0f,
+	0xd0, 0x4d, 0x37, 0x7d, 0xa0, 0x2e, 0xfa, 0x3e, 0x7d, 0x89, 0x82, 0x73, 0xd3, 0x0c, 0x29, 0xc5,
+	0xb6, 0xd2, 0x95, 0x39, 0xdf, 0xb9, 0xce, 0x99, 0x99, 0x73, 0x3e, 0x0b, 0xee, 0x12, 0x1c, 0x4d,
+	0x5d, 0x07, 0x77, 0x09, 0x26, 0xc4, 0x0d, 0x7c, 0xf1, 0xb7, 0x13, 0x46, 0x41, 0x1c, 0xa0, 0x0a,
+	0x5f, 0x1a, 0x77, 0x84, 0xdc, 0x09, 0xfc, 0x0b, 0x77, 0x30, 0x89, 0xec, 0x58, 0x6a, 0x19, 0xb7,
+	0x84, 0x10, 0x4f, 0xb1, 0x1f, 0x73, 0x10, 0x09, 0x70, 0x68, 0x7b, 0x02, 0xdb, 0x94, 0x98, 0x4b,
+	0xe2, 0x20, 0x9a, 0xa5, 0x55, 0x43, 0xcf, 0xce, 0xf8, 0x24, 0xb1, 0x1d, 0x63, 0x09, 0xce, 0x7c,
+	0x87, 0xa6, 0xe0, 0xb9, 0x8e, 0x70, 0xba, 0x4e, 0xc1, 0x71, 0xd0, 0xc7, 0x32, 0x4a, 0x02, 0x4c,
+	0x71, 0x94, 0xd8, 0xbb, 0xfe, 0x80, 0xc3, 0x8d, 0x49, 0xe4, 0x75, 0x27, 0x91, 0xc7, 0x96, 0xe6,
+	0xdf, 0x05, 0x68, 0x1c, 0x46, 0xd8, 0x8e, 0xb1, 0x85, 0xdf, 0x4e, 0x30, 0x89, 0xd1, 0x3d, 0x28,
+	0xd9, 0x5e, 0x38, 0xb4, 0xdb, 0xb9, 0x9d, 0xdc, 0x6e, 0xbd, 0x57, 0xed, 0x24, 0xca, 0xaf, 0xac,
+	0x53, 0x8b, 0xc1, 0x68, 0x1b, 0x8a, 0xe7, 0x38, 0xb6, 0xdb, 0xf9, 0x94, 0x98, 0xa2, 0x68, 0x0f,
+	0x1a, 0x5a, 0x6d, 0xda, 0x05, 0xaa, 0xd6, 0xea, 0x88, 0x8a, 0x1e, 0xaa, 0x52, 0x4b, 0x57, 0x46,
+	0xc7, 0x80, 0x34, 0x60, 0x9f, 0x26, 0x52, 0xfc, 0xa0, 0x8b, 0x05, 0x16, 0xe8, 0x3b, 0xb8, 0xa9,
+	0xa1, 0x07, 0x49, 0xc2, 0xa5, 0x0f, 0xba, 0xc9, 0x1a, 0x20, 0x03, 0xaa, 0x11, 0x26, 0x61, 0xe0,
+	0x13, 0xdc, 0x2e, 0xef, 0xe4, 0x76, 0x6b, 0x96, 0x5c, 0xa3, 0xa7, 0x50, 0xf6, 0xec, 0x73, 0xec,
+	0x91, 0x76, 0x65, 0xa7, 0xb0, 0x5b, 0xef, 0x99, 0x73, 0xb7, 0x6a, 0x35, 0x3b, 0xa7, 0x54, 0xe9,
+	0xc8, 0x8f, 0xa3, 0x99, 0xc5, 0x2d, 0x10, 0x82, 0xa2, 0x6f, 0x8f, 0x71, 0xbb, 0x4a, 0x7d, 0xd2,
+	0x6f, 0xe3, 0x09, 0xd4, 0x15, 0x55, 0x74, 0x03, 0x0a, 0x23, 0x3c, 0xa3, 0x47, 0x50, 0xb3, 0x92,
+	0x4f, 0xb4, 0x01, 0xa5, 0xa9, 0xed, 0x4d, 0x30, 0xad, 0x7b, 0xcd, 0x62, 0x8b, 0xa7, 0xf9, 0xc7,
+	0x39, 0xf3, 0x0d, 0x34, 0x45, 0x4c, 0x9e, 0x5c, 0x1b, 0xc4, 0x8d, 0xe5, 0x1e, 0xc4, 0x32, 0x91,
+	0x8c, 0x31, 0x21, 0xf6, 0x40, 0xf8, 0x11, 0x4b, 0xd4, 0x82, 0x72, 0x18, 0x05, 0xe3, 0x30, 0xa6,
+	0x27, 0x56, 0xb3, 0xf8, 0xca, 0xfc, 0x23, 0x07, 0xf5, 0x53, 0x97, 0xc4, 0xe2, 0x7a, 0x74, 0x00,
+	0x85, 0x11, 0x9e, 0xba, 0xc1, 0x84, 0x9c, 0x25, 0x77, 0xf2, 0x7b, 0xbf, 0x8f, 0xdf, 0xd3, 0x30,
+	0x45, 0x6b, 0x81, 0x04, 0x7d, 0x06, 0x4d, 0x12, 0x62, 0xc7, 0xbd, 0x70, 0x1d, 0x5a, 0x59, 0xd2,
+	0xce, 0xef, 0x14, 0x76, 0x6b, 0x56, 0x0a, 0x45, 0x9f, 0x42, 0x83, 0x96, 0xe7, 0x0c, 0x7b, 0xd8,
+	0x89, 0x83, 0x88, 0xa7, 0xa1, 0x83, 0x66, 0x1f, 0xd6, 0x58, 0x32, 0x7c, 0xa7, 0xf7, 0x00, 0x48,
+	0x3a, 0x0b, 0x05, 0x41, 0x5f, 0x43, 0x83, 0x6f, 0x9d, 0xa6, 0xc4, 0x82, 0xd7, 0x7b, 0x4d, 0x79,
+	0x5a, 0x14, 0xb6, 0x74, 0x25, 0xf3, 0x3d, 0xac, 0x1d, 0x7b, 0x13, 0x32, 0x14, 0x7b, 0xce, 0xee,
+	0x21, 0xb7, 0x70, 0x0f, 0x06, 0x54, 0xc9, 0xc8, 0x0d, 0x5f, 0xdb, 0x6e, 0x4c, 0xcb, 0x5b, 0xb5,
+	0xe4, 0xfa, 0x8a, 0xfb, 0xfb, 0x1c, 0x1a, 0x3c, 0xf2, 0xfc, 0x28, 0xc5, 0x81, 0xe5, 0xb4, 0x03,
+	0x33, 0xdf, 0xc0, 0xda, 0x4b, 0x7b, 0x42, 0xf0, 0x75, 0x93, 0xcc, 0x24, 0x92, 0x5f, 0x92, 0x08,
+	0xf7, 0x7e, 0x69, 0x22, 0x33, 0x68, 0x58, 0x98, 0x4c, 0xc6, 0x78, 0x85, 0x72, 0xc9, 0xf7, 0x95,
+	0x4f, 0xbd, 0xaf, 0xab, 0x95, 0xeb, 0x00, 0x9a, 0x22, 0xf4, 0x65, 0x69, 0x2a, 0x17, 0x3c, 0xaf,
+	0x5d, 0xf0, 0x5f, 0xe1, 0xc6, 0x4f, 0x38, 0x1a, 0xbb, 0xbe, 0x1d, 0x5f, 0x7b, 0x07, 0x57, 0xab,
+	0xe5, 0x97, 0x70, 0x53, 0x89, 0x70, 0x69, 0x3d, 0x3d, 0xba, 0xa9, 0xc0, 0x9b, 0xca, 0x74, 0x96,
+	0xbf, 0x67, 0x04, 0xc5, 0xd0, 0x8e, 0x87, 0x3c, 0x2e, 0xfd, 0x46, 0x5f, 0x40, 0xf9, 0x9d, 0xeb,
+	0xfb, 0x98, 0xd5, 0xac, 0xd9, 0xdb, 0xe8, 0x24, 0x93, 0xa0, 0x73, 0xc8, 0xe7, 0xc5, 0x6b, 0x2a,
+	0xb3, 0xb8, 0x8e, 0x79, 0x1f, 0xd6, 0x65, 0xb4, 0x4b, 0x53, 0x9b, 0x02, 0xda, 0x77, 0x46, 0x7e,
+	0xf0, 0xce, 0xc3, 0xfd, 0xc1, 0x15, 0xd2, 0x3b, 0x86, 0x75, 0x7b, 0xae, 0x3f, 0xc6, 0x3e, 0x2b,
+	0x7e, 0xb3, 0xb7, 0x2d, 0x1f, 0xe0, 0x89, 0xed, 0xc5, 0xfb, 0xba, 0x8e, 0x95, 0x36, 0x32, 0xbb,
+	0x70, 0x4b, 0x8b, 0x7b, 0x69, 0xa2, 0x87, 0x70, 0x2b, 0xe9, 0x13, 0x3f, 0xb3, 0xe9, 0x47, 0x56,
+	0x2a, 0xa4, 0xf9, 0x7b, 0x0e, 0x36, 0x74, 0x2f, 0x3c, 0xee, 0x23, 0x68, 0xd0, 0x59, 0x28, 0x04,
+	0xf4, 0x76, 0xd4, 0x7b, 0x37, 0x59, 0xa1, 0x8f, 0x5d, 0x0f, 0x73, 0x89, 0xa5, 0xeb, 0xa1, 0x87,
+	0xb0, 0x96, 0x4c, 0x49, 0x69, 0x97, 0x5f, 0x66, 0xa7, 0xa9, 0x99, 0x6f, 0x61, 0xd3, 0xc2, 0x24,
+	0x0e, 0x22, 0x29, 0x5f, 0xe9, 0x62, 0x20, 0x3e, 0xb9, 0x0b, 0xb4, 0x35, 0xd1, 0xef, 0xe4, 0x55,
+	0xf4, 0xdd, 0x01, 0x26, 0x31, 0x9d, 0xb2, 0x35, 0x8b, 0xaf, 0xcc, 0x23, 0x68, 0xa5, 0x43, 0xf2,
+	0xcd, 0xdf, 0x87, 0x0a, 0x27, 0x15, 0x9c, 0x21, 0x2c, 0x48, 0x5f, 0x68, 0x98, 0x7f, 0xe6, 0xa0,
+	0xfe, 0xd2, 0xb3, 0xaf, 0x90, 0xf0, 0x0f, 0x40, 0x69, 0xcd, 0x30, 0x0a, 0x7c, 0xf7, 0x37, 0xfa,
+	0xbc, 0x5e, 0x04, 0x7d, 0xcc, 0xaf, 0xcb, 0x6d, 0x16, 0xe2, 0x2c, 0xab, 0x60, 0x2d, 0xb2, 0xd2,
+	0x3a, 0x4b, 0x41, 0xef, 0x2c, 0xa6, 0x03, 0x6b, 0x2c, 0xa3, 0x55, 0x3b, 0x06, 0xfa, 0x04, 0x8a,
+	0x09, 0x45, 0xe3, 0xd4, 0xa6, 0x21, 0xaf, 0x32, 0x75, 0x4b, 0x45, 0xe6, 0x3f, 0x79, 0x68, 0xbc,
+	0x0a, 0xfb, 0x4a, 0x4b, 0x59, 0xbe, 0xf3, 0x0c, 0x65, 0xca, 0x7f, 0x3c, 0x65, 0x2a, 0xfc, 0x37,
+	0x94, 0xa9, 0xf8, 0x31, 0x94, 0xa9, 0x94, 0x6a, 0xe9, 0x2d, 0x28, 0x5f, 0xb8, 0xd8, 0xeb, 0x93,
+	0x76, 0x99, 0x36, 0x53, 0xbe, 0x42, 0x3b, 0x50, 0x67, 0x5f, 0x2c, 0xf5, 0x0a, 0x15, 0xaa, 0x50,
+	0x32, 0xe5, 0xd9, 0x92, 0x26, 0x55, 0xa5, 0x0a, 0x0a, 0x92, 0x8c, 0x01, 0x51, 0xec, 0x95, 0xc7,
+	0xc0, 0x33, 0x68, 0x9e, 0x30, 0x3a, 0xbe, 0x5a, 0xb3, 0x38, 0x84, 0x75, 0x69, 0xcf, 0x93, 0x78,
+	0x00, 0x95, 0x08, 0x3b, 0x41, 0xd4, 0x17, 0x0d, 0x62, 0x5e, 0x48, 0xa9, 0x9a, 0x88, 0x2d, 0xa1,
+	0x66, 0x3e, 0x82, 0xc6, 0x51, 0xf2, 0xcf, 0x03, 0xb9, 0xe6, 0x20, 0x32, 0xbf, 0x81, 0xa6, 0x30,
+	0x94, 0x03, 0xb4, 0x44, 0xff, 0x0f, 0xe1, 0x8f, 0x74, 0xce, 0x78, 0xa8, 0x9e, 0xc5, 0x84, 0xbd,
+	0xbf, 0x2a, 0x50, 0x3d, 0x63, 0x02, 0x82, 0x9e, 0x43, 0x99, 0x11, 0x49, 0xd4, 0x5a, 0xcc, 0x66,
+	0x8d, 0xad, 0x0c, 0xce, 0x1f, 0xd5, 0xff, 0x76, 0x73, 0x0f, 0x72, 0xe8, 0x21, 0x14, 0x93, 0x7e,
+	0x89, 0x36, 0xa4, 0x9a, 0xc2, 0x1c, 0x8d, 0xcd, 0x14, 0x2a, 0x4c, 0xd1, 0x1e, 0x94, 0x28, 0xe9,
+	0x41, 0x73, 0x0d, 0x95, 0x7e, 0x19, 0xad, 0x34, 0xac, 0x05, 0xdd, 0x83, 0x12, 0x65, 0x2a, 0x8a,
+	0xb5, 0xca, 0x8b, 0x8c, 0x56, 0x1a, 0xd6, 0xac, 0x9f, 0x43, 0x99, 0x31, 0x08, 0x65, 0xcf, 0x1a,
+	0x9b, 0x31, 0xb6, 0x32, 0xb8, 0xe6, 0xe0, 0x04, 0x6a, 0x72, 0xb8, 0xa3, 0xdb, 0x52, 0x37, 0x4d,
+	0x29, 0x0c, 0x63, 0x91, 0x48, 0xf3, 0x74, 0x00, 0x15, 0x3e, 0x89, 0x91, 0x16, 0x53, 0x61, 0x02,
+	0x46, 0x3b, 0x2b, 0xd0, 0x7c, 0xbc, 0x60, 0xfc, 0x58, 0x0e, 0x9c, 0x6d, 0xad, 0xe6, 0xa9, 0x71,
+	0x68, 0xdc, 0x5d, 0x22, 0x95, 0x27, 0x73, 0x06, 0x4d, 0x7d, 0x0a, 0xa0, 0x7b, 0x6a, 0x02, 0xd9,
+	0x89, 0x64, 0xfc, 0x7f, 0xa9, 0x5c, 0x3a, 0x7d, 0x02, 0xc5, 0xa4, 0x53, 0x2a, 0xb7, 0x44, 0x99,
+	0x10, 0xc6, 0x66, 0x0a, 0xd5, 0xb6, 0xf7, 0x0c, 0x2a, 0xfc, 0xe5, 0x28, 0x25, 0xd2, 0x9f, 0xad,
+	0xd1, 0xce, 0x0a, 0x64, 0xe8, 0x6f, 0xa1, 0xcc, 0x9e, 0x89, 0x72, 0xda, 0xda, 0x83, 0x33, 0xb6,
+	0x32, 0xb8, 0x30, 0x66, 0x97, 0x85, 0xf5, 0x19, 0xc5, 0x5c, 0xeb, 0xf2, 0xc6, 0x56, 0x06, 0xd7,
+	0xf2, 0xff, 0x11, 0xea, 0x0a, 0x8f, 0x41, 0x77, 0xa4, 0x76, 0x96, 0x55, 0x19, 0xdb, 0x8b, 0x85,
+	0xaa, 0xbf, 0x83, 0xa7, 0xbf, 0x3c, 0x1e, 0xb8, 0xf1, 0x70, 0x72, 0xde, 0x71, 0x82, 0x71, 0xd7,
+	0x0a, 0x46, 0xb3, 0xa3, 0xc8, 0x75, 0x46, 0x24, 0xf0, 0xbb, 0xfd, 0x20, 0x0c, 0xb1, 0x37, 0xb0,
+	0xfd, 0x01, 0x8e, 0xba, 0xe1, 0x68, 0xd0, 0x4d, 0xfd, 0xc4, 0x71, 0x5e, 0xa6, 0x3f, 0x00, 0x7c,
+	0xf5, 0xef, 0x00, 0x8d, 0x56, 0x9a, 0xd4, 0xfc, 0x10, 0x00, 0x00,
 }
@@ -1,11 +1,15 @@
 package session
 
 import (
+	"strings"
+	"time"
+
 	"github.com/pkg/errors"
 
 	"github.com/RokyErickson/doppelganger/pkg/configuration"
 	"github.com/RokyErickson/doppelganger/pkg/filesystem"
 	"github.com/RokyErickson/doppelganger/pkg/sync"
+	"github.com/RokyErickson/doppelganger/pkg/url"
 )
 
 type ConfigurationSourceType uint8
@@ -39,6 +43,26 @@ func (c *Configuration) EnsureValid(source ConfigurationSourceType) error {
 		}
 	}
 
+	if !(c.DeletionMode.IsDefault() || c.DeletionMode.Supported()) {
+		return errors.New("unknown or unsupported deletion mode")
+	}
+
+	if !(c.VersioningMode.IsDefault() || c.VersioningMode.Supported()) {
+		return errors.New("unknown or unsupported versioning mode")
+	}
+
+	if endpointSpecific {
+		if c.MassDeletionThresholdCount != 0 || c.MassDeletionThresholdPercentage != 0 {
+			return errors.New("mass deletion threshold cannot be specified on an endpoint-specific basis")
+		}
+	} else {
+		if c.MassDeletionThresholdCount != 0 && c.MassDeletionThresholdPercentage != 0 {
+			return errors.New("mass deletion threshold specified as both count and percentage")
+		} else if c.MassDeletionThresholdPercentage > 100 {
+			return errors.New("mass deletion threshold percentage exceeds 100")
+		}
+	}
+
 	if endpointSpecific {
 		if !c.SymlinkMode.IsDefault() {
 			return errors.New("symbolic link handling mode cannot be specified on an endpoint-specific basis")
@@ -47,6 +71,10 @@ func (c *Configuration) EnsureValid(source ConfigurationSourceType) error {
 		if !(c.SymlinkMode.IsDefault() || c.SymlinkMode.Supported()) {
 			return errors.New("unknown or unsupported symlink mode")
 		}
+		if c.SymlinkMode.Follows() && !c.SynchronizationMode.Unidirectional() &&
+			(source == ConfigurationSourceTypeSession || !c.SynchronizationMode.IsDefault()) {
+			return errors.New("symbolic link following requires one-way synchronization")
+		}
 	}
 
 	if !(c.WatchMode.IsDefault() || c.WatchMode.Supported()) {
@@ -62,6 +90,25 @@ func (c *Configuration) EnsureValid(source ConfigurationSourceType) error {
 		}
 	}
 
+	if endpointSpecific {
+		if !c.ModificationTimesMode.IsDefault() {
+			return errors.New("modification time preservation cannot be specified on an endpoint-specific basis")
+		}
+	} else {
+		if !(c.ModificationTimesMode.IsDefault() || c.ModificationTimesMode.Supported()) {
+			return errors.New("unknown or unsupported modification time preservation mode")
+		}
+	}
+
+	if endpointSpecific && len(c.ExtendedAttributeNamespaces) > 0 {
+		return errors.New("extended attribute namespaces cannot be specified on an endpoint-specific basis")
+	}
+	for _, namespace := range c.ExtendedAttributeNamespaces {
+		if namespace == "" || strings.IndexByte(namespace, 0) != -1 {
+			return errors.Errorf("invalid extended attribute namespace: %q", namespace)
+		}
+	}
+
 	if endpointSpecific && len(c.Ignores) > 0 {
 		return errors.New("ignores cannot be specified on an endpoint-specific basis")
 	}
@@ -71,6 +118,15 @@ func (c *Configuration) EnsureValid(source ConfigurationSourceType) error {
 		}
 	}
 
+	if endpointSpecific && len(c.Includes) > 0 {
+		return errors.New("includes cannot be specified on an endpoint-specific basis")
+	}
+	for _, include := range c.Includes {
+		if !sync.ValidIncludePattern(include) {
+			return errors.Errorf("invalid include pattern: %s", include)
+		}
+	}
+
 	if endpointSpecific {
 		if !c.IgnoreVCSMode.IsDefault() {
 			return errors.New("VCS ignore mode cannot be specified on an endpoint-specific basis")
@@ -81,6 +137,34 @@ func (c *Configuration) EnsureValid(source ConfigurationSourceType) error {
 		}
 	}
 
+	if endpointSpecific {
+		if !c.IgnoreFilesMode.IsDefault() {
+			return errors.New("ignore files mode cannot be specified on an endpoint-specific basis")
+		}
+	} else {
+		if !(c.IgnoreFilesMode.IsDefault() || c.IgnoreFilesMode.Supported()) {
+			return errors.New("unknown or unsupported ignore files mode")
+		}
+	}
+
+	if endpointSpecific && c.IgnoreMaximumFileSize != 0 {
+		return errors.New("maximum file size ignore cannot be specified on an endpoint-specific basis")
+	}
+
+	if endpointSpecific && c.IgnoreMaximumFileAge != 0 {
+		return errors.New("maximum file age ignore cannot be specified on an endpoint-specific basis")
+	}
+
+	if endpointSpecific {
+		if !c.PermissionsMode.IsDefault() {
+			return errors.New("permissions mode cannot be specified on an endpoint-specific basis")
+		}
+	} else {
+		if !(c.PermissionsMode.IsDefault() || c.PermissionsMode.Supported()) {
+			return errors.New("unknown or unsupported permissions mode")
+		}
+	}
+
 	if c.DefaultFileMode != 0 {
 		if err := sync.EnsureDefaultFileModeValid(filesystem.Mode(c.DefaultFileMode)); err != nil {
 			return errors.Wrap(err, "invalid default file permission mode specified")
@@ -108,26 +192,41 @@ func (c *Configuration) EnsureValid(source ConfigurationSourceType) error {
 	return nil
 }
 
+func ConfigurationFromTOML(configuration *configuration.Configuration) *Configuration {
+	return &Configuration{
+		SynchronizationMode:             configuration.Synchronization.Mode,
+		MaximumEntryCount:               configuration.Synchronization.MaximumEntryCount,
+		MaximumStagingFileSize:          uint64(configuration.Synchronization.MaximumStagingFileSize),
+		DeletionMode:                    configuration.Synchronization.DeletionMode,
+		VersioningMode:                  configuration.Synchronization.VersioningMode,
+		MassDeletionThresholdCount:      configuration.Synchronization.MassDeletionThreshold.Count,
+		MassDeletionThresholdPercentage: configuration.Synchronization.MassDeletionThreshold.Percentage,
+		ModificationTimesMode:           configuration.Synchronization.PreserveModificationTimes,
+		ExtendedAttributeNamespaces:     configuration.Synchronization.ExtendedAttributes,
+		SymlinkMode:                     configuration.Symlink.Mode,
+		WatchMode:                       configuration.Watch.Mode,
+		WatchPollingInterval:            configuration.Watch.PollingInterval,
+		Ignores:                         configuration.Ignore.Default,
+		IgnoreVCSMode:                   configuration.Ignore.VCS,
+		Includes:                        configuration.Ignore.Includes,
+		IgnoreFilesMode:                 configuration.Ignore.Files,
+		IgnoreMaximumFileSize:           uint64(configuration.Ignore.MaximumFileSize),
+		IgnoreMaximumFileAge:            configuration.Ignore.MaximumFileAge,
+		PermissionsMode:                 configuration.Permissions.Mode,
+		DefaultFileMode:                 uint32(configuration.Permissions.DefaultFileMode),
+		DefaultDirectoryMode:            uint32(configuration.Permissions.DefaultDirectoryMode),
+		DefaultOwner:                    configuration.Permissions.DefaultOwner,
+		DefaultGroup:                    configuration.Permissions.DefaultGroup,
+	}
+}
+
 func snapshotGlobalConfiguration() (*Configuration, error) {
 	configuration, err := configuration.Load()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to load global configuration")
 	}
 
-	result := &Configuration{
-		SynchronizationMode:    configuration.Synchronization.Mode,
-		MaximumEntryCount:      configuration.Synchronization.MaximumEntryCount,
-		MaximumStagingFileSize: uint64(configuration.Synchronization.MaximumStagingFileSize),
-		SymlinkMode:            configuration.Symlink.Mode,
-		WatchMode:              configuration.Watch.Mode,
-		WatchPollingInterval:   configuration.Watch.PollingInterval,
-		Ignores:                configuration.Ignore.Default,
-		IgnoreVCSMode:          configuration.Ignore.VCS,
-		DefaultFileMode:        uint32(configuration.Permissions.DefaultFileMode),
-		DefaultDirectoryMode:   uint32(configuration.Permissions.DefaultDirectoryMode),
-		DefaultOwner:           configuration.Permissions.DefaultOwner,
-		DefaultGroup:           configuration.Permissions.DefaultGroup,
-	}
+	result := ConfigurationFromTOML(configuration)
 
 	if err := result.EnsureValid(ConfigurationSourceTypeGlobal); err != nil {
 		return nil, errors.Wrap(err, "global configuration invalid")
@@ -157,6 +256,38 @@ func MergeConfigurations(lower, higher *Configuration) *Configuration {
 		result.MaximumStagingFileSize = lower.MaximumStagingFileSize
 	}
 
+	if !higher.DeletionMode.IsDefault() {
+		result.DeletionMode = higher.DeletionMode
+	} else {
+		result.DeletionMode = lower.DeletionMode
+	}
+
+	if !higher.VersioningMode.IsDefault() {
+		result.VersioningMode = higher.VersioningMode
+	} else {
+		result.VersioningMode = lower.VersioningMode
+	}
+
+	if higher.MassDeletionThresholdCount != 0 || higher.MassDeletionThresholdPercentage != 0 {
+		result.MassDeletionThresholdCount = higher.MassDeletionThresholdCount
+		result.MassDeletionThresholdPercentage = higher.MassDeletionThresholdPercentage
+	} else {
+		result.MassDeletionThresholdCount = lower.MassDeletionThresholdCount
+		result.MassDeletionThresholdPercentage = lower.MassDeletionThresholdPercentage
+	}
+
+	if !higher.ModificationTimesMode.IsDefault() {
+		result.ModificationTimesMode = higher.ModificationTimesMode
+	} else {
+		result.ModificationTimesMode = lower.ModificationTimesMode
+	}
+
+	if len(higher.ExtendedAttributeNamespaces) > 0 {
+		result.ExtendedAttributeNamespaces = higher.ExtendedAttributeNamespaces
+	} else {
+		result.ExtendedAttributeNamespaces = lower.ExtendedAttributeNamespaces
+	}
+
 	if !higher.SymlinkMode.IsDefault() {
 		result.SymlinkMode = higher.SymlinkMode
 	} else {
@@ -181,12 +312,39 @@ func MergeConfigurations(lower, higher *Configuration) *Configuration {
 	result.Ignores = append(result.Ignores, lower.Ignores...)
 	result.Ignores = append(result.Ignores, higher.Ignores...)
 
+	result.Includes = append(result.Includes, lower.Includes...)
+	result.Includes = append(result.Includes, higher.Includes...)
+
 	if !higher.IgnoreVCSMode.IsDefault() {
 		result.IgnoreVCSMode = higher.IgnoreVCSMode
 	} else {
 		result.IgnoreVCSMode = lower.IgnoreVCSMode
 	}
 
+	if !higher.IgnoreFilesMode.IsDefault() {
+		result.IgnoreFilesMode = higher.IgnoreFilesMode
+	} else {
+		result.IgnoreFilesMode = lower.IgnoreFilesMode
+	}
+
+	if higher.IgnoreMaximumFileSize != 0 {
+		result.IgnoreMaximumFileSize = higher.IgnoreMaximumFileSize
+	} else {
+		result.IgnoreMaximumFileSize = lower.IgnoreMaximumFileSize
+	}
+
+	if higher.IgnoreMaximumFileAge != 0 {
+		result.IgnoreMaximumFileAge = higher.IgnoreMaximumFileAge
+	} else {
+		result.IgnoreMaximumFileAge = lower.IgnoreMaximumFileAge
+	}
+
+	if !higher.PermissionsMode.IsDefault() {
+		result.PermissionsMode = higher.PermissionsMode
+	} else {
+		result.PermissionsMode = lower.PermissionsMode
+	}
+
 	if higher.DefaultFileMode != 0 {
 		result.DefaultFileMode = higher.DefaultFileMode
 	} else {
@@ -213,3 +371,93 @@ func MergeConfigurations(lower, higher *Configuration) *Configuration {
 
 	return result
 }
+
+func IgnoreScanOptions(version Version, configuration *Configuration) sync.ScanOptions {
+	ignoreVCSMode := configuration.IgnoreVCSMode
+	if ignoreVCSMode.IsDefault() {
+		ignoreVCSMode = version.DefaultIgnoreVCSMode()
+	}
+
+	ignoreFilesMode := configuration.IgnoreFilesMode
+	if ignoreFilesMode.IsDefault() {
+		ignoreFilesMode = version.DefaultIgnoreFilesMode()
+	}
+
+	var ignores []string
+	if ignoreVCSMode == sync.IgnoreVCSMode_IgnoreVCS {
+		ignores = append(ignores, sync.DefaultVCSIgnores...)
+	}
+	ignores = append(ignores, configuration.DefaultIgnores...)
+	ignores = append(ignores, configuration.Ignores...)
+
+	var predicates *sync.IgnorePredicates
+	if configuration.IgnoreMaximumFileSize != 0 || configuration.IgnoreMaximumFileAge != 0 {
+		predicates = &sync.IgnorePredicates{
+			MaximumFileSize: configuration.IgnoreMaximumFileSize,
+			MaximumFileAge:  time.Duration(configuration.IgnoreMaximumFileAge) * time.Second,
+		}
+	}
+
+	return sync.ScanOptions{
+		Ignores:         ignores,
+		Includes:        configuration.Includes,
+		IgnoreFileNames: ignoreFilesMode.FileNames(),
+		Predicates:      predicates,
+	}
+}
+
+func ensureVersioningSupported(endpoint *url.URL, configuration *Configuration, version Version) error {
+	mode := configuration.VersioningMode
+	if mode.IsDefault() {
+		mode = version.DefaultVersioningMode()
+	}
+
+	if mode == sync.VersioningMode_VersioningModeStaggered && endpoint.Protocol != url.Protocol_Local {
+		return errors.New("staggered versioning is only supported for endpoints local to the daemon")
+	}
+
+	return nil
+}
+
+func UpdateConfiguration(current, update *Configuration, fields []string) (*Configuration, error) {
+	result := MergeConfigurations(current, &Configuration{})
+
+	for _, field := range fields {
+		switch field {
+		case "massDeletionThresholdCount":
+			result.MassDeletionThresholdCount = update.MassDeletionThresholdCount
+		case "massDeletionThresholdPercentage":
+			result.MassDeletionThresholdPercentage = update.MassDeletionThresholdPercentage
+		case "modificationTimesMode":
+			result.ModificationTimesMode = update.ModificationTimesMode
+		case "watchMode":
+			result.WatchMode = update.WatchMode
+		case "watchPollingInterval":
+			result.WatchPollingInterval = update.WatchPollingInterval
+		case "ignores":
+			result.Ignores = update.Ignores
+		case "ignoreVCSMode":
+			result.IgnoreVCSMode = update.IgnoreVCSMode
+		case "includes":
+			result.Includes = update.Includes
+		case "ignoreFilesMode":
+			result.IgnoreFilesMode = update.IgnoreFilesMode
+		case "ignoreMaximumFileSize":
+			result.IgnoreMaximumFileSize = update.IgnoreMaximumFileSize
+		case "ignoreMaximumFileAge":
+			result.IgnoreMaximumFileAge = update.IgnoreMaximumFileAge
+		case "defaultFileMode":
+			result.DefaultFileMode = update.DefaultFileMode
+		case "defaultDirectoryMode":
+			result.DefaultDirectoryMode = update.DefaultDirectoryMode
+		case "defaultOwner":
+			result.DefaultOwner = update.DefaultOwner
+		case "defaultGroup":
+			result.DefaultGroup = update.DefaultGroup
+		default:
+			return nil, errors.Errorf("configuration field cannot be updated: %s", field)
+		}
+	}
+
+	return result, nil
+}
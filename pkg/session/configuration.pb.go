@@ -16,29 +16,40 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2
 
 type Configuration struct {
-	SynchronizationMode    sync.SynchronizationMode `protobuf:"varint,11,opt,name=synchronizationMode,proto3,enum=sync.SynchronizationMode" json:"synchronizationMode,omitempty"`
-	MaximumEntryCount      uint64                   `protobuf:"varint,12,opt,name=maximumEntryCount,proto3" json:"maximumEntryCount,omitempty"`
-	MaximumStagingFileSize uint64                   `protobuf:"varint,13,opt,name=maximumStagingFileSize,proto3" json:"maximumStagingFileSize,omitempty"`
-	SymlinkMode            sync.SymlinkMode         `protobuf:"varint,1,opt,name=symlinkMode,proto3,enum=sync.SymlinkMode" json:"symlinkMode,omitempty"`
-	WatchMode              filesystem.WatchMode     `protobuf:"varint,21,opt,name=watchMode,proto3,enum=filesystem.WatchMode" json:"watchMode,omitempty"`
-	WatchPollingInterval   uint32                   `protobuf:"varint,22,opt,name=watchPollingInterval,proto3" json:"watchPollingInterval,omitempty"`
-	DefaultIgnores         []string                 `protobuf:"bytes,31,rep,name=defaultIgnores,proto3" json:"defaultIgnores,omitempty"`
-	Ignores                []string                 `protobuf:"bytes,32,rep,name=ignores,proto3" json:"ignores,omitempty"`
-	IgnoreVCSMode          sync.IgnoreVCSMode       `protobuf:"varint,33,opt,name=ignoreVCSMode,proto3,enum=sync.IgnoreVCSMode" json:"ignoreVCSMode,omitempty"`
-	DefaultFileMode        uint32                   `protobuf:"varint,63,opt,name=defaultFileMode,proto3" json:"defaultFileMode,omitempty"`
-	DefaultDirectoryMode   uint32                   `protobuf:"varint,64,opt,name=defaultDirectoryMode,proto3" json:"defaultDirectoryMode,omitempty"`
-	DefaultOwner           string                   `protobuf:"bytes,65,opt,name=defaultOwner,proto3" json:"defaultOwner,omitempty"`
-	DefaultGroup           string                   `protobuf:"bytes,66,opt,name=defaultGroup,proto3" json:"defaultGroup,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
-	XXX_unrecognized       []byte                   `json:"-"`
-	XXX_sizecache          int32                    `json:"-"`
+	SynchronizationMode             sync.SynchronizationMode   `protobuf:"varint,11,opt,name=synchronizationMode,proto3,enum=sync.SynchronizationMode" json:"synchronizationMode,omitempty"`
+	MaximumEntryCount               uint64                     `protobuf:"varint,12,opt,name=maximumEntryCount,proto3" json:"maximumEntryCount,omitempty"`
+	MaximumStagingFileSize          uint64                     `protobuf:"varint,13,opt,name=maximumStagingFileSize,proto3" json:"maximumStagingFileSize,omitempty"`
+	DeletionMode                    sync.DeletionMode          `protobuf:"varint,14,opt,name=deletionMode,proto3,enum=sync.DeletionMode" json:"deletionMode,omitempty"`
+	VersioningMode                  sync.VersioningMode        `protobuf:"varint,15,opt,name=versioningMode,proto3,enum=sync.VersioningMode" json:"versioningMode,omitempty"`
+	MassDeletionThresholdCount      uint64                     `protobuf:"varint,16,opt,name=massDeletionThresholdCount,proto3" json:"massDeletionThresholdCount,omitempty"`
+	MassDeletionThresholdPercentage uint32                     `protobuf:"varint,17,opt,name=massDeletionThresholdPercentage,proto3" json:"massDeletionThresholdPercentage,omitempty"`
+	ModificationTimesMode           sync.ModificationTimesMode `protobuf:"varint,18,opt,name=modificationTimesMode,proto3,enum=sync.ModificationTimesMode" json:"modificationTimesMode,omitempty"`
+	ExtendedAttributeNamespaces     []string                   `protobuf:"bytes,19,rep,name=extendedAttributeNamespaces,proto3" json:"extendedAttributeNamespaces,omitempty"`
+	SymlinkMode                     sync.SymlinkMode           `protobuf:"varint,1,opt,name=symlinkMode,proto3,enum=sync.SymlinkMode" json:"symlinkMode,omitempty"`
+	WatchMode                       filesystem.WatchMode       `protobuf:"varint,21,opt,name=watchMode,proto3,enum=filesystem.WatchMode" json:"watchMode,omitempty"`
+	WatchPollingInterval            uint32                     `protobuf:"varint,22,opt,name=watchPollingInterval,proto3" json:"watchPollingInterval,omitempty"`
+	DefaultIgnores                  []string                   `protobuf:"bytes,31,rep,name=defaultIgnores,proto3" json:"defaultIgnores,omitempty"`
+	Ignores                         []string                   `protobuf:"bytes,32,rep,name=ignores,proto3" json:"ignores,omitempty"`
+	IgnoreVCSMode                   sync.IgnoreVCSMode         `protobuf:"varint,33,opt,name=ignoreVCSMode,proto3,enum=sync.IgnoreVCSMode" json:"ignoreVCSMode,omitempty"`
+	Includes                        []string                   `protobuf:"bytes,34,rep,name=includes,proto3" json:"includes,omitempty"`
+	IgnoreFilesMode                 sync.IgnoreFilesMode       `protobuf:"varint,35,opt,name=ignoreFilesMode,proto3,enum=sync.IgnoreFilesMode" json:"ignoreFilesMode,omitempty"`
+	IgnoreMaximumFileSize           uint64                     `protobuf:"varint,36,opt,name=ignoreMaximumFileSize,proto3" json:"ignoreMaximumFileSize,omitempty"`
+	IgnoreMaximumFileAge            uint64                     `protobuf:"varint,37,opt,name=ignoreMaximumFileAge,proto3" json:"ignoreMaximumFileAge,omitempty"`
+	PermissionsMode                 sync.PermissionsMode       `protobuf:"varint,62,opt,name=permissionsMode,proto3,enum=sync.PermissionsMode" json:"permissionsMode,omitempty"`
+	DefaultFileMode                 uint32                     `protobuf:"varint,63,opt,name=defaultFileMode,proto3" json:"defaultFileMode,omitempty"`
+	DefaultDirectoryMode            uint32                     `protobuf:"varint,64,opt,name=defaultDirectoryMode,proto3" json:"defaultDirectoryMode,omitempty"`
+	DefaultOwner                    string                     `protobuf:"bytes,65,opt,name=defaultOwner,proto3" json:"defaultOwner,omitempty"`
+	DefaultGroup                    string                     `protobuf:"bytes,66,opt,name=defaultGroup,proto3" json:"defaultGroup,omitempty"`
+	XXX_NoUnkeyedLiteral            struct{}                   `json:"-"`
+	XXX_unrecognized                []byte                     `json:"-"`
+	XXX_sizecache                   int32                      `json:"-"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_e35a36f0e41f48fe, []int{0}
+	return fileDescriptor_configuration_1d1cbf1335b49715, []int{0}
 }
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Configuration.Unmarshal(m, b)
@@ -79,6 +90,48 @@ func (m *Configuration) GetMaximumStagingFileSize() uint64 {
 	return 0
 }
 
+func (m *Configuration) GetDeletionMode() sync.DeletionMode {
+	if m != nil {
+		return m.DeletionMode
+	}
+	return sync.DeletionMode_DeletionModeDefault
+}
+
+func (m *Configuration) GetVersioningMode() sync.VersioningMode {
+	if m != nil {
+		return m.VersioningMode
+	}
+	return sync.VersioningMode_VersioningModeDefault
+}
+
+func (m *Configuration) GetMassDeletionThresholdCount() uint64 {
+	if m != nil {
+		return m.MassDeletionThresholdCount
+	}
+	return 0
+}
+
+func (m *Configuration) GetMassDeletionThresholdPercentage() uint32 {
+	if m != nil {
+		return m.MassDeletionThresholdPercentage
+	}
+	return 0
+}
+
+func (m *Configuration) GetModificationTimesMode() sync.ModificationTimesMode {
+	if m != nil {
+		return m.ModificationTimesMode
+	}
+	return sync.ModificationTimesMode_ModificationTimesDefault
+}
+
+func (m *Configuration) GetExtendedAttributeNamespaces() []string {
+	if m != nil {
+		return m.ExtendedAttributeNamespaces
+	}
+	return nil
+}
+
 func (m *Configuration) GetSymlinkMode() sync.SymlinkMode {
 	if m != nil {
 		return m.SymlinkMode
@@ -121,6 +174,41 @@ func (m *Configuration) GetIgnoreVCSMode() sync.IgnoreVCSMode {
 	return sync.IgnoreVCSMode_IgnoreVCSDefault
 }
 
+func (m *Configuration) GetIncludes() []string {
+	if m != nil {
+		return m.Includes
+	}
+	return nil
+}
+
+func (m *Configuration) GetIgnoreFilesMode() sync.IgnoreFilesMode {
+	if m != nil {
+		return m.IgnoreFilesMode
+	}
+	return sync.IgnoreFilesMode_IgnoreFilesDefault
+}
+
+func (m *Configuration) GetIgnoreMaximumFileSize() uint64 {
+	if m != nil {
+		return m.IgnoreMaximumFileSize
+	}
+	return 0
+}
+
+func (m *Configuration) GetIgnoreMaximumFileAge() uint64 {
+	if m != nil {
+		return m.IgnoreMaximumFileAge
+	}
+	return 0
+}
+
+func (m *Configuration) GetPermissionsMode() sync.PermissionsMode {
+	if m != nil {
+		return m.PermissionsMode
+	}
+	return sync.PermissionsMode_PermissionsDefault
+}
+
 func (m *Configuration) GetDefaultFileMode() uint32 {
 	if m != nil {
 		return m.DefaultFileMode
@@ -154,35 +242,51 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("session/configuration.proto", fileDescriptor_configuration_e35a36f0e41f48fe)
-}
-
-var fileDescriptor_configuration_e35a36f0e41f48fe = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdf, 0x6f, 0xd3, 0x30,
-	0x10, 0xc7, 0x15, 0xf1, 0x63, 0xaa, 0xb7, 0x6e, 0xaa, 0xc7, 0xaa, 0x30, 0x1e, 0x08, 0x7b, 0x80,
-	0x20, 0x41, 0x22, 0xad, 0x12, 0x12, 0x4f, 0xc0, 0xca, 0x0f, 0x55, 0x08, 0x81, 0x52, 0x09, 0x24,
-	0xde, 0xbc, 0xd4, 0x75, 0x4f, 0x8b, 0x7d, 0x95, 0xed, 0x6c, 0x64, 0xaf, 0xfc, 0xe3, 0xa8, 0x17,
-	0x97, 0xb5, 0x6b, 0xf7, 0x16, 0x7f, 0xbe, 0x9f, 0x93, 0xef, 0x2e, 0x09, 0x7b, 0xe2, 0xa4, 0x73,
-	0x80, 0x26, 0x2f, 0xd1, 0x4c, 0x41, 0xd5, 0x56, 0x78, 0x40, 0x93, 0xcd, 0x2d, 0x7a, 0xe4, 0x3b,
-	0x21, 0x3c, 0xee, 0x4f, 0xa1, 0x92, 0xae, 0x71, 0x5e, 0xea, 0xfc, 0x4a, 0xf8, 0x72, 0xd6, 0x0a,
-	0xc7, 0x3d, 0xd7, 0x98, 0x32, 0x07, 0x65, 0xd0, 0xca, 0x80, 0x0e, 0x08, 0x69, 0x9c, 0x2c, 0x01,
-	0x27, 0xe0, 0x1a, 0x5d, 0x81, 0xb9, 0x68, 0xd9, 0xc9, 0xdf, 0x07, 0xac, 0x3b, 0x5c, 0xbd, 0x90,
-	0x7f, 0x65, 0x87, 0x0b, 0x6f, 0x66, 0xd1, 0xc0, 0x35, 0xa1, 0x6f, 0x38, 0x91, 0xf1, 0x6e, 0x12,
-	0xa5, 0xfb, 0xa7, 0x8f, 0xb3, 0x45, 0x96, 0x8d, 0x37, 0x85, 0x62, 0x5b, 0x15, 0x7f, 0xc5, 0x7a,
-	0x5a, 0xfc, 0x01, 0x5d, 0xeb, 0x4f, 0xc6, 0xdb, 0x66, 0x88, 0xb5, 0xf1, 0xf1, 0x5e, 0x12, 0xa5,
-	0xf7, 0x8b, 0xcd, 0x80, 0xbf, 0x61, 0xfd, 0x00, 0xc7, 0x5e, 0x28, 0x30, 0xea, 0x33, 0x54, 0x72,
-	0x0c, 0xd7, 0x32, 0xee, 0x52, 0xc9, 0x1d, 0x29, 0x1f, 0xb0, 0xdd, 0x30, 0x15, 0xb5, 0x1a, 0x51,
-	0xab, 0xbd, 0x65, 0xab, 0xff, 0x83, 0x62, 0xd5, 0xe2, 0x03, 0xd6, 0xa1, 0x05, 0x52, 0xc9, 0x11,
-	0x95, 0x1c, 0x65, 0x37, 0xdb, 0xcd, 0x7e, 0x2d, 0xc3, 0xe2, 0xc6, 0xe3, 0xa7, 0xec, 0x11, 0x1d,
-	0x7e, 0x60, 0x55, 0x81, 0x51, 0x23, 0xe3, 0xa5, 0xbd, 0x14, 0x55, 0xdc, 0x4f, 0xa2, 0xb4, 0x5b,
-	0x6c, 0xcd, 0xf8, 0x73, 0xb6, 0x3f, 0x91, 0x53, 0x51, 0x57, 0x7e, 0x44, 0xaf, 0xc7, 0xc5, 0x4f,
-	0x93, 0x7b, 0x69, 0xa7, 0xb8, 0x45, 0x79, 0xcc, 0x76, 0x20, 0x08, 0x09, 0x09, 0xcb, 0x23, 0x7f,
-	0xcb, 0xba, 0xed, 0xe3, 0xcf, 0xe1, 0x98, 0xda, 0x7d, 0x46, 0xed, 0x1e, 0xb6, 0x13, 0x8e, 0x56,
-	0xa3, 0x62, 0xdd, 0xe4, 0x29, 0x3b, 0x08, 0xd7, 0x2c, 0xb6, 0x45, 0xc5, 0xef, 0xa8, 0xd7, 0xdb,
-	0x78, 0x31, 0x5a, 0x40, 0x1f, 0xc1, 0xca, 0xd2, 0xa3, 0x6d, 0x48, 0x7f, 0xdf, 0x8e, 0xb6, 0x2d,
-	0xe3, 0x27, 0x6c, 0x2f, 0xf0, 0xef, 0x57, 0x46, 0xda, 0xf8, 0x43, 0x12, 0xa5, 0x9d, 0x62, 0x8d,
-	0xad, 0x38, 0x5f, 0x2c, 0xd6, 0xf3, 0xf8, 0x6c, 0xcd, 0x21, 0x76, 0xf6, 0xf2, 0xf7, 0x0b, 0x05,
-	0x7e, 0x56, 0x9f, 0x67, 0x25, 0xea, 0x7c, 0x26, 0x2e, 0xb1, 0x7c, 0x0d, 0x98, 0xeb, 0xda, 0x0b,
-	0x25, 0x4d, 0x3e, 0xbf, 0x50, 0x79, 0xf8, 0x01, 0xce, 0x1f, 0xd2, 0x77, 0x3b, 0xf8, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xe8, 0xfa, 0x3f, 0x82, 0x2f, 0x03, 0x00, 0x00,
+	proto.RegisterFile("session/configuration.proto", fileDescriptor_configuration_1d1cbf1335b49715)
+}
+
+var fileDescriptor_configuration_1d1cbf1335b49715 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0x5d, 0x6f, 0xd3, 0x3e,
+	0x14, 0xc6, 0x55, 0xfd, 0xff, 0x62, 0xcc, 0x5b, 0x5b, 0xea, 0xad, 0x55, 0xe8, 0x90, 0x56, 0xc6,
+	0x8b, 0x7a, 0x81, 0x1a, 0xb4, 0xa1, 0x49, 0x48, 0x68, 0xef, 0x03, 0x26, 0x34, 0x18, 0xee, 0x34,
+	0x24, 0x6e, 0x50, 0x96, 0x9c, 0xa6, 0x56, 0x13, 0x3b, 0xb2, 0x9d, 0x6d, 0xdd, 0xa7, 0xe1, 0xa3,
+	0xa2, 0x9c, 0x24, 0x6d, 0xda, 0x86, 0x71, 0xd7, 0x3c, 0xcf, 0xef, 0x38, 0xcf, 0x39, 0xb6, 0x53,
+	0xb2, 0xa1, 0x41, 0x6b, 0x2e, 0x85, 0xed, 0x4a, 0x31, 0xe0, 0x7e, 0xac, 0x1c, 0xc3, 0xa5, 0xe8,
+	0x45, 0x4a, 0x1a, 0x49, 0x97, 0x32, 0xb3, 0xdd, 0x1a, 0xf0, 0x00, 0xf4, 0x58, 0x1b, 0x08, 0xed,
+	0x5b, 0xc7, 0xb8, 0xc3, 0x14, 0x68, 0xaf, 0xe9, 0xb1, 0x70, 0x6d, 0x0f, 0x02, 0x98, 0x56, 0xb5,
+	0x1b, 0x28, 0x72, 0x5f, 0x48, 0x05, 0x99, 0xf4, 0x0c, 0xa5, 0x50, 0x7a, 0x7c, 0xc0, 0x5d, 0x7c,
+	0xc3, 0x2f, 0xc3, 0xc3, 0xdc, 0xad, 0xe7, 0x6e, 0x2e, 0xb4, 0x50, 0x88, 0x40, 0x85, 0x1c, 0xdf,
+	0xaf, 0x33, 0x9d, 0xa2, 0xae, 0xc7, 0x61, 0xc0, 0xc5, 0x28, 0xd3, 0x9a, 0xa8, 0xdd, 0x80, 0x4a,
+	0x40, 0x2e, 0xfc, 0x54, 0xde, 0xfa, 0x4d, 0x48, 0xf5, 0xb8, 0xd8, 0x12, 0xfd, 0x42, 0x30, 0xed,
+	0x50, 0x49, 0xc1, 0xef, 0x51, 0x3a, 0x97, 0x1e, 0x58, 0x2b, 0x9d, 0x4a, 0xb7, 0xb6, 0xfd, 0xb4,
+	0x97, 0x78, 0xbd, 0xfe, 0x22, 0xc0, 0xca, 0xaa, 0xe8, 0x1b, 0xd2, 0x08, 0x9d, 0x3b, 0x1e, 0xc6,
+	0xe1, 0xa9, 0x30, 0x6a, 0x7c, 0x2c, 0x63, 0x61, 0xac, 0xd5, 0x4e, 0xa5, 0xfb, 0x3f, 0x5b, 0x34,
+	0xe8, 0x2e, 0x69, 0x65, 0x62, 0xdf, 0x38, 0x3e, 0x17, 0xfe, 0x47, 0x1e, 0x40, 0x9f, 0xdf, 0x83,
+	0x55, 0xc5, 0x92, 0xbf, 0xb8, 0x74, 0x97, 0xac, 0xe6, 0xb3, 0xc5, 0xac, 0x35, 0xcc, 0x4a, 0xd3,
+	0xac, 0x27, 0x05, 0x87, 0xcd, 0x70, 0xf4, 0x03, 0xa9, 0x4d, 0x07, 0x82, 0x95, 0x75, 0xac, 0x5c,
+	0x4f, 0x2b, 0xaf, 0x66, 0x3c, 0x36, 0xc7, 0xd2, 0x3d, 0xd2, 0x0e, 0x1d, 0xad, 0xf3, 0xf5, 0x2f,
+	0x87, 0x0a, 0xf4, 0x50, 0x06, 0x5e, 0xda, 0xe4, 0x13, 0x4c, 0xfc, 0x00, 0x41, 0x3f, 0x93, 0xcd,
+	0x52, 0xf7, 0x02, 0x94, 0x0b, 0xc2, 0x38, 0x3e, 0x58, 0x8d, 0x4e, 0xa5, 0x5b, 0x65, 0xff, 0xc2,
+	0xe8, 0x77, 0xd2, 0x2c, 0x9e, 0x99, 0x4b, 0x1e, 0x82, 0xc6, 0x76, 0x28, 0xb6, 0xb3, 0x91, 0xb6,
+	0x73, 0x5e, 0x86, 0xb0, 0xf2, 0x4a, 0x7a, 0x40, 0x36, 0xe0, 0xce, 0x80, 0xf0, 0xc0, 0x3b, 0x34,
+	0x46, 0xf1, 0xeb, 0xd8, 0xc0, 0x57, 0x27, 0x04, 0x1d, 0x39, 0x2e, 0x68, 0x6b, 0xad, 0xf3, 0x5f,
+	0x77, 0x99, 0x3d, 0x84, 0xd0, 0x1d, 0xb2, 0x92, 0x9d, 0x40, 0x8c, 0x52, 0xc1, 0x28, 0x8d, 0xfc,
+	0xfc, 0x4c, 0x0c, 0x56, 0xa4, 0xe8, 0x0e, 0x59, 0xc6, 0x7b, 0x83, 0x25, 0x4d, 0x2c, 0x69, 0xf6,
+	0xa6, 0x97, 0xaa, 0xf7, 0x23, 0x37, 0xd9, 0x94, 0xa3, 0xdb, 0x64, 0x1d, 0x1f, 0x2e, 0x64, 0x10,
+	0x70, 0xe1, 0x9f, 0x09, 0x03, 0xea, 0xc6, 0x09, 0xac, 0x16, 0x4e, 0xaf, 0xd4, 0xa3, 0xaf, 0x49,
+	0xcd, 0x83, 0x81, 0x13, 0x07, 0xe6, 0x0c, 0x2f, 0xa0, 0xb6, 0x36, 0xb1, 0xa5, 0x39, 0x95, 0x5a,
+	0x64, 0x89, 0x67, 0x40, 0x07, 0x81, 0xfc, 0x91, 0xbe, 0x27, 0xd5, 0xf4, 0xe7, 0xd5, 0x71, 0x1f,
+	0xe3, 0x3e, 0xc7, 0xb8, 0x6b, 0x69, 0x87, 0x67, 0x45, 0x8b, 0xcd, 0x92, 0xb4, 0x4d, 0x1e, 0x73,
+	0xe1, 0x06, 0xb1, 0x07, 0xda, 0xda, 0xc2, 0x55, 0x27, 0xcf, 0x74, 0x9f, 0xd4, 0x53, 0x38, 0x39,
+	0xdd, 0xe9, 0x2e, 0xbe, 0xc8, 0xe6, 0x50, 0x58, 0x78, 0x62, 0xb2, 0x79, 0x9a, 0xbe, 0x23, 0xcd,
+	0x54, 0x3a, 0x4f, 0x2f, 0xcb, 0xe4, 0x0e, 0xbd, 0xc4, 0x13, 0x59, 0x6e, 0x26, 0x33, 0x5c, 0x30,
+	0x0e, 0x7d, 0xb0, 0x5e, 0x61, 0x51, 0xa9, 0x97, 0x44, 0x2d, 0x7c, 0x7b, 0x30, 0xea, 0x5e, 0x31,
+	0xea, 0xc5, 0xac, 0xc9, 0xe6, 0x69, 0xda, 0x25, 0xf5, 0x6c, 0xdc, 0xc9, 0x92, 0xb8, 0xc0, 0x3e,
+	0xee, 0xd9, 0xbc, 0x9c, 0xc4, 0xcb, 0xa4, 0x13, 0xae, 0xc0, 0x35, 0x52, 0x8d, 0x11, 0x3f, 0x48,
+	0xb7, 0xb8, 0xcc, 0xa3, 0x5b, 0x64, 0x35, 0xd3, 0xbf, 0xdd, 0x0a, 0x50, 0xd6, 0x61, 0xa7, 0xd2,
+	0x5d, 0x66, 0x33, 0x5a, 0x81, 0xf9, 0xa4, 0x64, 0x1c, 0x59, 0x47, 0x33, 0x0c, 0x6a, 0x47, 0xdb,
+	0x3f, 0xdf, 0xfa, 0xdc, 0x0c, 0xe3, 0xeb, 0x9e, 0x2b, 0x43, 0x9b, 0xc9, 0xd1, 0xf8, 0x54, 0x71,
+	0x77, 0xa4, 0xa5, 0xb0, 0x3d, 0x19, 0x45, 0x10, 0xf8, 0x8e, 0xf0, 0x41, 0xd9, 0xd1, 0xc8, 0xb7,
+	0xb3, 0x3f, 0x82, 0xeb, 0x47, 0xf8, 0x75, 0xdd, 0xf9, 0x33, 0x00, 0xf6, 0x72, 0x1a, 0x02, 0x37,
+	0x06, 0x00, 0x00,
 }
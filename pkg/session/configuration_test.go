@@ -0,0 +1,85 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+	"github.com/RokyErickson/doppelganger/pkg/url"
+)
+
+func TestUpdateConfigurationReplacesFields(t *testing.T) {
+	current := &Configuration{
+		Ignores:               []string{"*.tmp"},
+		IgnoreVCSMode:         sync.IgnoreVCSMode_IgnoreVCS,
+		WatchPollingInterval:  5,
+		ModificationTimesMode: sync.ModificationTimesMode_PreserveModificationTimes,
+	}
+
+	updated, err := UpdateConfiguration(current, &Configuration{Ignores: []string{"*.log"}}, []string{"ignores", "watchPollingInterval", "modificationTimesMode"})
+	if err != nil {
+		t.Fatal("unable to update configuration:", err)
+	}
+	if len(updated.Ignores) != 1 || updated.Ignores[0] != "*.log" {
+		t.Error("ignores not replaced:", updated.Ignores)
+	}
+	if updated.WatchPollingInterval != 0 {
+		t.Error("watch polling interval not reset")
+	}
+	if !updated.ModificationTimesMode.IsDefault() {
+		t.Error("modification times mode not reset")
+	}
+	if updated.IgnoreVCSMode != sync.IgnoreVCSMode_IgnoreVCS {
+		t.Error("unlisted field modified")
+	}
+	if len(current.Ignores) != 1 || current.Ignores[0] != "*.tmp" {
+		t.Error("current configuration modified")
+	}
+
+	for _, field := range []string{"symlinkMode", "permissionsMode", "extendedAttributeNamespaces"} {
+		if _, err := UpdateConfiguration(current, &Configuration{}, []string{field}); err == nil {
+			t.Error("update of non-updatable field accepted:", field)
+		}
+	}
+}
+
+func TestConfigurationEnsureValidSymlinkFollowRequiresOneWay(t *testing.T) {
+	twoWay := &Configuration{
+		SynchronizationMode: sync.SynchronizationMode_SynchronizationModeTwoWaySafe,
+		SymlinkMode:         sync.SymlinkMode_SymlinkFollow,
+	}
+	if twoWay.EnsureValid(ConfigurationSourceTypeCreate) == nil {
+		t.Error("symbolic link following accepted with two-way synchronization")
+	}
+
+	defaulted := &Configuration{SymlinkMode: sync.SymlinkMode_SymlinkFollowWithinRoot}
+	if err := defaulted.EnsureValid(ConfigurationSourceTypeCreate); err != nil {
+		t.Error("symbolic link following rejected before merge:", err)
+	}
+	if defaulted.EnsureValid(ConfigurationSourceTypeSession) == nil {
+		t.Error("symbolic link following accepted with default synchronization mode")
+	}
+
+	oneWay := &Configuration{
+		SynchronizationMode: sync.SynchronizationMode_SynchronizationModeOneWayReplica,
+		SymlinkMode:         sync.SymlinkMode_SymlinkFollow,
+	}
+	if err := oneWay.EnsureValid(ConfigurationSourceTypeSession); err != nil {
+		t.Error("symbolic link following rejected with one-way synchronization:", err)
+	}
+}
+
+func TestEnsureVersioningSupported(t *testing.T) {
+	staggered := &Configuration{VersioningMode: sync.VersioningMode_VersioningModeStaggered}
+	local := &url.URL{Protocol: url.Protocol_Local, Path: "/tmp/alpha"}
+	remote := &url.URL{Protocol: url.Protocol_SSH, Hostname: "host", Path: "/tmp/beta"}
+
+	if err := ensureVersioningSupported(local, staggered, Version_Version2); err != nil {
+		t.Error("staggered versioning rejected for local endpoint:", err)
+	}
+	if ensureVersioningSupported(remote, staggered, Version_Version2) == nil {
+		t.Error("staggered versioning accepted for remote endpoint")
+	}
+	if err := ensureVersioningSupported(remote, &Configuration{}, Version_Version2); err != nil {
+		t.Error("default versioning rejected for remote endpoint:", err)
+	}
+}
@@ -31,7 +31,11 @@ const (
 type controller struct {
 	sessionPath              string
 	archivePath              string
+	historyPath              string
 	stateLock                *state.TrackingLock
+	events                   *eventHub
+	eventState               State
+	metrics                  sessionMetrics
 	session                  *Session
 	mergedAlphaConfiguration *Configuration
 	mergedBetaConfiguration  *Configuration
@@ -41,12 +45,18 @@ type controller struct {
 	cancel                   contextpkg.CancelFunc
 	flushRequests            chan chan error
 	done                     chan struct{}
+	resolutionsLock          syncpkg.Mutex
+	resolutions              map[string]sync.ConflictWinner
+	acknowledgements         chan HaltAcknowledgement
 }
 
 func newSession(
 	tracker *state.Tracker,
+	events *eventHub,
 	alpha, beta *url.URL,
 	configuration, configurationAlpha, configurationBeta *Configuration,
+	name string,
+	labels map[string]string,
 	prompter string,
 ) (*controller, error) {
 	prompt.Message(prompter, "Creating session...")
@@ -57,18 +67,27 @@ func newSession(
 	}
 
 	mergedConfiguration := MergeConfigurations(globalConfiguration, configuration)
+	if err := mergedConfiguration.EnsureValid(ConfigurationSourceTypeSession); err != nil {
+		return nil, errors.Wrap(err, "invalid session configuration")
+	}
 
 	mergedAlphaConfiguration := MergeConfigurations(mergedConfiguration, configurationAlpha)
 	mergedBetaConfiguration := MergeConfigurations(mergedConfiguration, configurationBeta)
 
+	version := Version_Version2
+
+	if err := ensureVersioningSupported(alpha, mergedAlphaConfiguration, version); err != nil {
+		return nil, errors.Wrap(err, "invalid alpha configuration")
+	} else if err = ensureVersioningSupported(beta, mergedBetaConfiguration, version); err != nil {
+		return nil, errors.Wrap(err, "invalid beta configuration")
+	}
+
 	randomUUID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to generate UUID for session")
 	}
 	identifier := randomUUID.String()
 
-	version := Version_Version1
-
 	creationTime := time.Now()
 	creationTimeProto, err := ptypes.TimestampProto(creationTime)
 	if err != nil {
@@ -97,6 +116,8 @@ func newSession(
 		Configuration:        mergedConfiguration,
 		ConfigurationAlpha:   configurationAlpha,
 		ConfigurationBeta:    configurationBeta,
+		Name:                 name,
+		Labels:               labels,
 	}
 	archive := &sync.Archive{}
 
@@ -112,6 +133,12 @@ func newSession(
 		betaEndpoint.Shutdown()
 		return nil, errors.Wrap(err, "unable to compute archive path")
 	}
+	historyPath, err := pathForHistory(session.Identifier)
+	if err != nil {
+		alphaEndpoint.Shutdown()
+		betaEndpoint.Shutdown()
+		return nil, errors.Wrap(err, "unable to compute history path")
+	}
 
 	if err := encoding.MarshalAndSaveProtobuf(sessionPath, session); err != nil {
 		alphaEndpoint.Shutdown()
@@ -127,7 +154,9 @@ func newSession(
 	controller := &controller{
 		sessionPath:              sessionPath,
 		archivePath:              archivePath,
+		historyPath:              historyPath,
 		stateLock:                state.NewTrackingLock(tracker),
+		events:                   events,
 		session:                  session,
 		mergedAlphaConfiguration: mergedAlphaConfiguration,
 		mergedBetaConfiguration:  mergedBetaConfiguration,
@@ -139,13 +168,14 @@ func newSession(
 	context, cancel := contextpkg.WithCancel(contextpkg.Background())
 	controller.cancel = cancel
 	controller.flushRequests = make(chan chan error, 1)
+	controller.acknowledgements = make(chan HaltAcknowledgement, 1)
 	controller.done = make(chan struct{})
 	go controller.run(context, alphaEndpoint, betaEndpoint)
 
 	return controller, nil
 }
 
-func loadSession(tracker *state.Tracker, identifier string) (*controller, error) {
+func loadSession(tracker *state.Tracker, events *eventHub, identifier string) (*controller, error) {
 	sessionPath, err := pathForSession(identifier)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to compute session path")
@@ -154,6 +184,10 @@ func loadSession(tracker *state.Tracker, identifier string) (*controller, error)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to compute archive path")
 	}
+	historyPath, err := pathForHistory(identifier)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to compute history path")
+	}
 
 	session := &Session{}
 	if err := encoding.LoadAndUnmarshalProtobuf(sessionPath, session); err != nil {
@@ -172,7 +206,9 @@ func loadSession(tracker *state.Tracker, identifier string) (*controller, error)
 	controller := &controller{
 		sessionPath: sessionPath,
 		archivePath: archivePath,
+		historyPath: historyPath,
 		stateLock:   state.NewTrackingLock(tracker),
+		events:      events,
 		session:     session,
 		mergedAlphaConfiguration: MergeConfigurations(
 			session.Configuration,
@@ -191,6 +227,7 @@ func loadSession(tracker *state.Tracker, identifier string) (*controller, error)
 		context, cancel := contextpkg.WithCancel(contextpkg.Background())
 		controller.cancel = cancel
 		controller.flushRequests = make(chan chan error, 1)
+		controller.acknowledgements = make(chan HaltAcknowledgement, 1)
 		controller.done = make(chan struct{})
 		go controller.run(context, nil, nil)
 	}
@@ -198,6 +235,22 @@ func loadSession(tracker *state.Tracker, identifier string) (*controller, error)
 	return controller, nil
 }
 
+func (c *controller) unlockState() {
+	for _, event := range stateEvents(c.session.Identifier, &c.eventState, c.state) {
+		c.events.broadcast(event)
+	}
+	c.metrics.observe(&c.eventState, c.state, time.Now())
+	c.eventState = *c.state
+	c.stateLock.Unlock()
+}
+
+func (c *controller) currentMetrics() *sessionMetrics {
+	c.stateLock.Lock()
+	defer c.stateLock.UnlockWithoutNotify()
+
+	return c.metrics.copy()
+}
+
 func (c *controller) currentState() *State {
 	c.stateLock.Lock()
 	defer c.stateLock.UnlockWithoutNotify()
@@ -248,6 +301,252 @@ func (c *controller) flush(prompter string, skipWait bool, context contextpkg.Co
 	return nil
 }
 
+func (c *controller) resolve(path string, winner sync.ConflictWinner, prompter string) error {
+	prompt.Message(prompter, fmt.Sprintf("Resolving conflict for session %s...", c.session.Identifier))
+
+	c.lifecycleLock.Lock()
+	defer c.lifecycleLock.Unlock()
+
+	if c.disabled {
+		return errors.New("controller disabled")
+	}
+
+	if c.cancel == nil {
+		return errors.New("session is paused")
+	}
+
+	synchronizationMode := c.session.Configuration.SynchronizationMode
+	if synchronizationMode.IsDefault() {
+		synchronizationMode = c.session.Version.DefaultSynchronizationMode()
+	}
+	unidirectional := synchronizationMode.Unidirectional()
+	if unidirectional && winner == sync.ConflictWinner_ConflictWinnerBeta {
+		return errors.New("beta cannot win conflicts in unidirectional synchronization modes")
+	}
+
+	c.stateLock.Lock()
+	conflicted := false
+	for _, conflict := range c.state.Conflicts {
+		if conflict.Root() == path {
+			conflicted = true
+			break
+		}
+	}
+	c.stateLock.UnlockWithoutNotify()
+	if !conflicted {
+		return errors.Errorf("no conflict exists at path \"%s\"", path)
+	}
+
+	c.resolutionsLock.Lock()
+	if c.resolutions == nil {
+		c.resolutions = make(map[string]sync.ConflictWinner)
+	}
+	c.resolutions[path] = winner
+	c.resolutionsLock.Unlock()
+
+	select {
+	case c.flushRequests <- make(chan error, 1):
+	default:
+	}
+
+	return nil
+}
+
+func (c *controller) acknowledge(acknowledgement HaltAcknowledgement, prompter string) error {
+	prompt.Message(prompter, fmt.Sprintf("Acknowledging halt for session %s...", c.session.Identifier))
+
+	c.lifecycleLock.Lock()
+	defer c.lifecycleLock.Unlock()
+
+	if c.disabled {
+		return errors.New("controller disabled")
+	}
+
+	if c.cancel == nil {
+		return errors.New("session is paused")
+	}
+
+	synchronizationMode := c.session.Configuration.SynchronizationMode
+	if synchronizationMode.IsDefault() {
+		synchronizationMode = c.session.Version.DefaultSynchronizationMode()
+	}
+	unidirectional := synchronizationMode.Unidirectional()
+	if unidirectional && acknowledgement == HaltAcknowledgement_HaltAcknowledgementReseed {
+		return errors.New("re-seeding is not supported in unidirectional synchronization modes")
+	}
+
+	c.stateLock.Lock()
+	defer c.stateLock.UnlockWithoutNotify()
+
+	status := c.state.Status
+	if status == Status_HaltedOnMassDeletion {
+		if acknowledgement == HaltAcknowledgement_HaltAcknowledgementReseed {
+			return errors.New("re-seeding is not supported for mass deletion halts")
+		}
+	} else if status != Status_HaltedOnRootDeletion && status != Status_HaltedOnRootTypeChange {
+		return errors.New("session is not halted")
+	}
+
+	select {
+	case c.acknowledgements <- acknowledgement:
+	default:
+		return errors.New("halt already acknowledged")
+	}
+
+	return nil
+}
+
+func (c *controller) haltUntilAcknowledged(context contextpkg.Context, status Status) (HaltAcknowledgement, bool) {
+	c.stateLock.Lock()
+	select {
+	case <-c.acknowledgements:
+	default:
+	}
+	c.state.Status = status
+	c.unlockState()
+
+	select {
+	case acknowledgement := <-c.acknowledgements:
+		return acknowledgement, true
+	case <-context.Done():
+		return HaltAcknowledgement_HaltAcknowledgementInvalid, false
+	}
+}
+
+func (c *controller) plan(synchronizationMode sync.SynchronizationMode, prompter string) (*Plan, error) {
+	prompt.Message(prompter, fmt.Sprintf("Planning synchronization for session %s...", c.session.Identifier))
+
+	c.lifecycleLock.Lock()
+	if c.disabled {
+		c.lifecycleLock.Unlock()
+		return nil, errors.New("controller disabled")
+	} else if c.cancel != nil {
+		c.lifecycleLock.Unlock()
+		return nil, errors.New("session must be paused to plan synchronization")
+	}
+	session := c.session
+	mergedAlphaConfiguration := c.mergedAlphaConfiguration
+	mergedBetaConfiguration := c.mergedBetaConfiguration
+	archive := &sync.Archive{}
+	err := encoding.LoadAndUnmarshalProtobuf(c.archivePath, archive)
+	c.lifecycleLock.Unlock()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load archive")
+	} else if err = archive.Root.EnsureValid(); err != nil {
+		return nil, errors.Wrap(err, "invalid archive found on disk")
+	}
+	ancestor := archive.Root
+
+	if synchronizationMode.IsDefault() {
+		synchronizationMode = session.Configuration.SynchronizationMode
+	}
+	if synchronizationMode.IsDefault() {
+		synchronizationMode = session.Version.DefaultSynchronizationMode()
+	}
+
+	alpha, err := connect(
+		session.Alpha,
+		prompter,
+		session.Identifier,
+		session.Version,
+		mergedAlphaConfiguration,
+		true,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to connect to alpha")
+	}
+	defer alpha.Shutdown()
+
+	beta, err := connect(
+		session.Beta,
+		prompter,
+		session.Identifier,
+		session.Version,
+		mergedBetaConfiguration,
+		false,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to connect to beta")
+	}
+	defer beta.Shutdown()
+
+	prompt.Message(prompter, "Scanning files...")
+	var αSnapshot, βSnapshot *sync.Entry
+	var αPreservesExecutability, βPreservesExecutability bool
+	var αExcluded, βExcluded []string
+	var αScanErr, βScanErr error
+	var αTryAgain, βTryAgain bool
+	scanDone := &syncpkg.WaitGroup{}
+	scanDone.Add(2)
+	go func() {
+		αSnapshot, αPreservesExecutability, αExcluded, _, αScanErr, αTryAgain = alpha.Scan(ancestor)
+		scanDone.Done()
+	}()
+	go func() {
+		βSnapshot, βPreservesExecutability, βExcluded, _, βScanErr, βTryAgain = beta.Scan(ancestor)
+		scanDone.Done()
+	}()
+	scanDone.Wait()
+	if αScanErr != nil {
+		return nil, errors.Wrap(αScanErr, "alpha scan error")
+	} else if βScanErr != nil {
+		return nil, errors.Wrap(βScanErr, "beta scan error")
+	} else if αTryAgain || βTryAgain {
+		return nil, errors.New("scan requested retry")
+	}
+
+	αSnapshot = sync.PreserveExcluded(ancestor, αSnapshot, αExcluded)
+	βSnapshot = sync.PreserveExcluded(ancestor, βSnapshot, βExcluded)
+
+	if αPreservesExecutability && !βPreservesExecutability {
+		βSnapshot = sync.PropagateExecutability(ancestor, αSnapshot, βSnapshot)
+	} else if βPreservesExecutability && !αPreservesExecutability {
+		αSnapshot = sync.PropagateExecutability(ancestor, βSnapshot, αSnapshot)
+	}
+
+	prompt.Message(prompter, "Reconciling changes...")
+	_, αTransitions, βTransitions, conflicts := sync.Reconcile(
+		ancestor,
+		αSnapshot,
+		βSnapshot,
+		synchronizationMode,
+		time.Now(),
+	)
+
+	plan := &Plan{}
+	for _, t := range αTransitions {
+		plan.AlphaTransitions = append(plan.AlphaTransitions, t.CopySlim())
+	}
+	for _, t := range βTransitions {
+		plan.BetaTransitions = append(plan.BetaTransitions, t.CopySlim())
+	}
+	for _, conflict := range conflicts {
+		plan.Conflicts = append(plan.Conflicts, conflict.CopySlim())
+	}
+
+	return plan, nil
+}
+
+func (c *controller) history(pattern string) ([]*HistoryRecord, error) {
+	records, err := readHistory(c.historyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if pattern == "" {
+		return records, nil
+	}
+
+	var filtered []*HistoryRecord
+	for _, r := range records {
+		if f := r.Filter(pattern); f != nil {
+			filtered = append(filtered, f)
+		}
+	}
+
+	return filtered, nil
+}
+
 func (c *controller) resume(prompter string) error {
 
 	prompt.Message(prompter, fmt.Sprintf("Resuming session %s...", c.session.Identifier))
@@ -262,7 +561,8 @@ func (c *controller) resume(prompter string) error {
 	if c.cancel != nil {
 
 		c.stateLock.Lock()
-		connected := c.state.Status >= Status_Watching
+		connected := c.state.Status >= Status_Watching &&
+			c.state.Status != Status_HaltedOnMassDeletion
 		c.stateLock.UnlockWithoutNotify()
 
 		if connected {
@@ -274,17 +574,28 @@ func (c *controller) resume(prompter string) error {
 
 		c.cancel = nil
 		c.flushRequests = nil
+		c.acknowledgements = nil
 		c.done = nil
 	}
 
 	c.stateLock.Lock()
 	c.session.Paused = false
 	saveErr := encoding.MarshalAndSaveProtobuf(c.sessionPath, c.session)
-	c.stateLock.Unlock()
+	c.unlockState()
 
+	connectErr := c.start(prompter)
+
+	if saveErr != nil {
+		return errors.Wrap(saveErr, "unable to save session configuration")
+	}
+
+	return connectErr
+}
+
+func (c *controller) start(prompter string) error {
 	c.stateLock.Lock()
 	c.state.Status = Status_ConnectingAlpha
-	c.stateLock.Unlock()
+	c.unlockState()
 	alpha, alphaConnectErr := connect(
 		c.session.Alpha,
 		prompter,
@@ -295,11 +606,11 @@ func (c *controller) resume(prompter string) error {
 	)
 	c.stateLock.Lock()
 	c.state.AlphaConnected = (alpha != nil)
-	c.stateLock.Unlock()
+	c.unlockState()
 
 	c.stateLock.Lock()
 	c.state.Status = Status_ConnectingBeta
-	c.stateLock.Unlock()
+	c.unlockState()
 	beta, betaConnectErr := connect(
 		c.session.Beta,
 		prompter,
@@ -310,17 +621,16 @@ func (c *controller) resume(prompter string) error {
 	)
 	c.stateLock.Lock()
 	c.state.BetaConnected = (beta != nil)
-	c.stateLock.Unlock()
+	c.unlockState()
 
 	context, cancel := contextpkg.WithCancel(contextpkg.Background())
 	c.cancel = cancel
 	c.flushRequests = make(chan chan error, 1)
+	c.acknowledgements = make(chan HaltAcknowledgement, 1)
 	c.done = make(chan struct{})
 	go c.run(context, alpha, beta)
 
-	if saveErr != nil {
-		return errors.Wrap(saveErr, "unable to save session configuration")
-	} else if alphaConnectErr != nil {
+	if alphaConnectErr != nil {
 		return errors.Wrap(alphaConnectErr, "unable to connect to alpha")
 	} else if betaConnectErr != nil {
 		return errors.Wrap(betaConnectErr, "unable to connect to beta")
@@ -329,6 +639,114 @@ func (c *controller) resume(prompter string) error {
 	return nil
 }
 
+func (c *controller) update(update, updateAlpha, updateBeta *Configuration, fields, fieldsAlpha, fieldsBeta []string, prompter string) error {
+
+	prompt.Message(prompter, fmt.Sprintf("Updating session %s...", c.session.Identifier))
+
+	c.lifecycleLock.Lock()
+	defer c.lifecycleLock.Unlock()
+
+	if c.disabled {
+		return errors.New("controller disabled")
+	}
+
+	updatedConfiguration, err := UpdateConfiguration(c.session.Configuration, update, fields)
+	if err != nil {
+		return errors.Wrap(err, "unable to update configuration")
+	}
+	updatedConfigurationAlpha, err := UpdateConfiguration(c.session.ConfigurationAlpha, updateAlpha, fieldsAlpha)
+	if err != nil {
+		return errors.Wrap(err, "unable to update alpha-specific configuration")
+	}
+	updatedConfigurationBeta, err := UpdateConfiguration(c.session.ConfigurationBeta, updateBeta, fieldsBeta)
+	if err != nil {
+		return errors.Wrap(err, "unable to update beta-specific configuration")
+	}
+
+	if err := updatedConfiguration.EnsureValid(ConfigurationSourceTypeSession); err != nil {
+		return errors.Wrap(err, "invalid updated configuration")
+	} else if err = updatedConfigurationAlpha.EnsureValid(ConfigurationSourceTypeSessionEndpointSpecific); err != nil {
+		return errors.Wrap(err, "invalid updated alpha-specific configuration")
+	} else if err = updatedConfigurationBeta.EnsureValid(ConfigurationSourceTypeSessionEndpointSpecific); err != nil {
+		return errors.Wrap(err, "invalid updated beta-specific configuration")
+	}
+
+	running := c.cancel != nil
+	if running {
+		c.cancel()
+		<-c.done
+
+		c.cancel = nil
+		c.flushRequests = nil
+		c.acknowledgements = nil
+		c.done = nil
+	}
+
+	if err := c.saveUpdatedConfiguration(updatedConfiguration, updatedConfigurationAlpha, updatedConfigurationBeta); err != nil {
+		if running {
+			if startErr := c.start(prompter); startErr != nil {
+				return errors.Wrap(startErr, "unable to restart session after failed update")
+			}
+		}
+		return err
+	}
+
+	c.stateLock.Lock()
+	c.session.Configuration = updatedConfiguration
+	c.session.ConfigurationAlpha = updatedConfigurationAlpha
+	c.session.ConfigurationBeta = updatedConfigurationBeta
+	c.mergedAlphaConfiguration = MergeConfigurations(updatedConfiguration, updatedConfigurationAlpha)
+	c.mergedBetaConfiguration = MergeConfigurations(updatedConfiguration, updatedConfigurationBeta)
+	c.unlockState()
+
+	if !running {
+		return nil
+	}
+
+	return c.start(prompter)
+}
+
+func (c *controller) clearName() error {
+	c.lifecycleLock.Lock()
+	defer c.lifecycleLock.Unlock()
+
+	c.stateLock.Lock()
+	c.session.Name = ""
+	err := encoding.MarshalAndSaveProtobuf(c.sessionPath, c.session)
+	c.unlockState()
+	if err != nil {
+		return errors.Wrap(err, "unable to save session")
+	}
+
+	return nil
+}
+
+func (c *controller) saveUpdatedConfiguration(configuration, configurationAlpha, configurationBeta *Configuration) error {
+
+	if configuration.ModificationTimesMode != c.session.Configuration.ModificationTimesMode {
+		archive := &sync.Archive{}
+		if err := encoding.LoadAndUnmarshalProtobuf(c.archivePath, archive); err != nil {
+			return errors.Wrap(err, "unable to load archive")
+		}
+		archive.Root = sync.StripModificationTimes(archive.Root)
+		if err := encoding.MarshalAndSaveProtobuf(c.archivePath, archive); err != nil {
+			return errors.Wrap(err, "unable to reset recorded modification times")
+		}
+	}
+
+	c.stateLock.Lock()
+	updatedSession := *c.session
+	c.stateLock.UnlockWithoutNotify()
+	updatedSession.Configuration = configuration
+	updatedSession.ConfigurationAlpha = configurationAlpha
+	updatedSession.ConfigurationBeta = configurationBeta
+	if err := encoding.MarshalAndSaveProtobuf(c.sessionPath, &updatedSession); err != nil {
+		return errors.Wrap(err, "unable to save session configuration")
+	}
+
+	return nil
+}
+
 type haltMode uint8
 
 const (
@@ -362,6 +780,7 @@ func (c *controller) halt(mode haltMode, prompter string) error {
 		<-c.done
 		c.cancel = nil
 		c.flushRequests = nil
+		c.acknowledgements = nil
 		c.done = nil
 	}
 
@@ -369,7 +788,7 @@ func (c *controller) halt(mode haltMode, prompter string) error {
 		c.stateLock.Lock()
 		c.session.Paused = true
 		err := encoding.MarshalAndSaveProtobuf(c.sessionPath, c.session)
-		c.stateLock.Unlock()
+		c.unlockState()
 		if err != nil {
 			return errors.Wrap(err, "unable to save session state")
 		}
@@ -379,10 +798,13 @@ func (c *controller) halt(mode haltMode, prompter string) error {
 		c.disabled = true
 		sessionRemoveErr := os.Remove(c.sessionPath)
 		archiveRemoveErr := os.Remove(c.archivePath)
+		historyRemoveErr := removeHistory(c.historyPath)
 		if sessionRemoveErr != nil {
 			return errors.Wrap(sessionRemoveErr, "unable to remove session from disk")
 		} else if archiveRemoveErr != nil {
 			return errors.Wrap(archiveRemoveErr, "unable to remove archive from disk")
+		} else if historyRemoveErr != nil {
+			return errors.Wrap(historyRemoveErr, "unable to remove history from disk")
 		}
 	} else {
 		panic("invalid halt mode specified")
@@ -403,7 +825,7 @@ func (c *controller) run(context contextpkg.Context, alpha, beta Endpoint) {
 		c.state = &State{
 			Session: c.session,
 		}
-		c.stateLock.Unlock()
+		c.unlockState()
 
 		close(c.done)
 	}()
@@ -414,7 +836,8 @@ func (c *controller) run(context contextpkg.Context, alpha, beta Endpoint) {
 			if alpha == nil {
 				c.stateLock.Lock()
 				c.state.Status = Status_ConnectingAlpha
-				c.stateLock.Unlock()
+				c.metrics.alphaReconnects++
+				c.unlockState()
 				alpha, _ = reconnect(
 					context,
 					c.session.Alpha,
@@ -426,7 +849,7 @@ func (c *controller) run(context contextpkg.Context, alpha, beta Endpoint) {
 			}
 			c.stateLock.Lock()
 			c.state.AlphaConnected = (alpha != nil)
-			c.stateLock.Unlock()
+			c.unlockState()
 
 			select {
 			case <-context.Done():
@@ -437,7 +860,8 @@ func (c *controller) run(context contextpkg.Context, alpha, beta Endpoint) {
 			if beta == nil {
 				c.stateLock.Lock()
 				c.state.Status = Status_ConnectingBeta
-				c.stateLock.Unlock()
+				c.metrics.betaReconnects++
+				c.unlockState()
 				beta, _ = reconnect(
 					context,
 					c.session.Beta,
@@ -449,7 +873,7 @@ func (c *controller) run(context contextpkg.Context, alpha, beta Endpoint) {
 			}
 			c.stateLock.Lock()
 			c.state.BetaConnected = (beta != nil)
-			c.stateLock.Unlock()
+			c.unlockState()
 
 			if alpha != nil && beta != nil {
 				break
@@ -474,7 +898,7 @@ func (c *controller) run(context contextpkg.Context, alpha, beta Endpoint) {
 			Session:   c.session,
 			LastError: err.Error(),
 		}
-		c.stateLock.Unlock()
+		c.unlockState()
 
 		select {
 		case <-context.Done():
@@ -488,7 +912,7 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 	c.stateLock.Lock()
 	if c.state.LastError != "" {
 		c.state.LastError = ""
-		c.stateLock.Unlock()
+		c.unlockState()
 	} else {
 		c.stateLock.UnlockWithoutNotify()
 	}
@@ -531,7 +955,7 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 		if !skipPolling {
 			c.stateLock.Lock()
 			c.state.Status = Status_Watching
-			c.stateLock.Unlock()
+			c.unlockState()
 
 			pollContext, pollCancel := contextpkg.WithCancel(contextpkg.Background())
 			αPollResults := make(chan error, 1)
@@ -588,21 +1012,25 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 			skipPolling = false
 		}
 
+		startTime := time.Now()
+
 		c.stateLock.Lock()
 		c.state.Status = Status_Scanning
-		c.stateLock.Unlock()
+		c.unlockState()
 		var αSnapshot, βSnapshot *sync.Entry
 		var αPreservesExecutability, βPreservesExecutability bool
+		var αExcluded, βExcluded []string
+		var αScanProblems, βScanProblems []*sync.Problem
 		var αScanErr, βScanErr error
 		var αTryAgain, βTryAgain bool
 		scanDone := &syncpkg.WaitGroup{}
 		scanDone.Add(2)
 		go func() {
-			αSnapshot, αPreservesExecutability, αScanErr, αTryAgain = alpha.Scan(ancestor)
+			αSnapshot, αPreservesExecutability, αExcluded, αScanProblems, αScanErr, αTryAgain = alpha.Scan(ancestor)
 			scanDone.Done()
 		}()
 		go func() {
-			βSnapshot, βPreservesExecutability, βScanErr, βTryAgain = beta.Scan(ancestor)
+			βSnapshot, βPreservesExecutability, βExcluded, βScanProblems, βScanErr, βTryAgain = beta.Scan(ancestor)
 			scanDone.Done()
 		}()
 		scanDone.Wait()
@@ -613,7 +1041,7 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 			} else {
 				c.stateLock.Lock()
 				c.state.LastError = αScanErr.Error()
-				c.stateLock.Unlock()
+				c.unlockState()
 			}
 		}
 		if βScanErr != nil {
@@ -623,14 +1051,14 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 			} else {
 				c.stateLock.Lock()
 				c.state.LastError = βScanErr.Error()
-				c.stateLock.Unlock()
+				c.unlockState()
 			}
 		}
 
 		if αTryAgain || βTryAgain {
 			c.stateLock.Lock()
 			c.state.Status = Status_WaitingForRescan
-			c.stateLock.Unlock()
+			c.unlockState()
 
 			select {
 			case <-time.After(rescanWaitDuration):
@@ -645,11 +1073,14 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 		c.stateLock.Lock()
 		if c.state.LastError != "" {
 			c.state.LastError = ""
-			c.stateLock.Unlock()
+			c.unlockState()
 		} else {
 			c.stateLock.UnlockWithoutNotify()
 		}
 
+		αSnapshot = sync.PreserveExcluded(ancestor, αSnapshot, αExcluded)
+		βSnapshot = sync.PreserveExcluded(ancestor, βSnapshot, βExcluded)
+
 		if αPreservesExecutability && !βPreservesExecutability {
 			βSnapshot = sync.PropagateExecutability(ancestor, αSnapshot, βSnapshot)
 		} else if βPreservesExecutability && !αPreservesExecutability {
@@ -658,15 +1089,36 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 
 		c.stateLock.Lock()
 		c.state.Status = Status_Reconciling
-		c.stateLock.Unlock()
+		c.metrics.alphaEntries = αSnapshot.Count()
+		c.metrics.betaEntries = βSnapshot.Count()
+		c.unlockState()
 
 		ancestorChanges, αTransitions, βTransitions, conflicts := sync.Reconcile(
 			ancestor,
 			αSnapshot,
 			βSnapshot,
 			synchronizationMode,
+			time.Now(),
 		)
 
+		c.resolutionsLock.Lock()
+		resolutions := c.resolutions
+		c.resolutions = nil
+		c.resolutionsLock.Unlock()
+		if len(resolutions) > 0 {
+			var unresolved []*sync.Conflict
+			for _, conflict := range conflicts {
+				if winner, ok := resolutions[conflict.Root()]; ok {
+					αResolutions, βResolutions := conflict.Resolve(αSnapshot, βSnapshot, winner)
+					αTransitions = append(αTransitions, αResolutions...)
+					βTransitions = append(βTransitions, βResolutions...)
+				} else {
+					unresolved = append(unresolved, conflict)
+				}
+			}
+			conflicts = unresolved
+		}
+
 		var slimConflicts []*sync.Conflict
 		if len(conflicts) > 0 {
 			slimConflicts = make([]*sync.Conflict, len(conflicts))
@@ -676,67 +1128,50 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 		}
 		c.stateLock.Lock()
 		c.state.Conflicts = slimConflicts
-		c.stateLock.Unlock()
-
-		rootDeletion := false
-		for _, t := range αTransitions {
-			if isRootDeletion(t) {
-				rootDeletion = true
-				break
-			}
-		}
-		if !rootDeletion {
-			for _, t := range βTransitions {
-				if isRootDeletion(t) {
-					rootDeletion = true
-					break
-				}
+		c.unlockState()
+
+		if haltStatus, onAlpha, halt := rootHalt(αTransitions, βTransitions); halt {
+			acknowledgement, acknowledged := c.haltUntilAcknowledged(context, haltStatus)
+			if !acknowledged && haltStatus == Status_HaltedOnRootDeletion {
+				return errors.New("cancelled while halted on root deletion")
+			} else if !acknowledged {
+				return errors.New("cancelled while halted on root type change")
 			}
-		}
-		if rootDeletion {
-			c.stateLock.Lock()
-			c.state.Status = Status_HaltedOnRootDeletion
-			c.stateLock.Unlock()
-			<-context.Done()
-			return errors.New("cancelled while halted on root deletion")
-		}
-
-		rootTypeChange := false
-		for _, t := range αTransitions {
-			if isRootTypeChange(t) {
-				rootTypeChange = true
-				break
+			if acknowledgement == HaltAcknowledgement_HaltAcknowledgementReseed {
+				ancestorChanges = nil
+				αTransitions, βTransitions = reseedTransitions(αSnapshot, βSnapshot, onAlpha)
+				c.stateLock.Lock()
+				c.state.Conflicts = nil
+				c.unlockState()
 			}
-		}
-		if !rootTypeChange {
-			for _, t := range βTransitions {
-				if isRootTypeChange(t) {
-					rootTypeChange = true
-					break
+		} else {
+			deletions := sync.DeletionCount(αTransitions) + sync.DeletionCount(βTransitions)
+			if exceedsDeletionThreshold(c.session.Configuration, ancestor, deletions) {
+				if _, acknowledged := c.haltUntilAcknowledged(context, Status_HaltedOnMassDeletion); !acknowledged {
+					return errors.New("cancelled while halted on mass deletion")
 				}
 			}
 		}
-		if rootTypeChange {
-			c.stateLock.Lock()
-			c.state.Status = Status_HaltedOnRootTypeChange
-			c.stateLock.Unlock()
-			<-context.Done()
-			return errors.New("cancelled while halted on root type change")
-		}
 
 		monitor := func(status *rsync.ReceiverStatus) error {
 			c.stateLock.Lock()
 			c.state.StagingStatus = status
-			c.stateLock.Unlock()
+			c.unlockState()
 			return nil
 		}
 
+		var αBytesStaged, βBytesStaged uint64
+
 		c.stateLock.Lock()
 		c.state.Status = Status_StagingAlpha
-		c.stateLock.Unlock()
+		c.unlockState()
 		if paths, digests, err := sync.TransitionDependencies(αTransitions); err != nil {
 			return errors.Wrap(err, "unable to determine paths for staging on alpha")
 		} else if len(paths) > 0 {
+			pathDigests := make(map[string][]byte, len(paths))
+			for p, path := range paths {
+				pathDigests[path] = digests[p]
+			}
 			filteredPaths, signatures, receiver, err := alpha.Stage(paths, digests)
 			if err != nil {
 				return errors.Wrap(err, "unable to begin staging on alpha")
@@ -747,7 +1182,9 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 			if len(filteredPaths) > 0 {
 				receiver = rsync.NewMonitoringReceiver(receiver, filteredPaths, monitor)
 				receiver = rsync.NewPreemptableReceiver(receiver, context)
-				if err = beta.Supply(filteredPaths, signatures, receiver); err != nil {
+				receiver = rsync.NewCountingReceiver(receiver, &αBytesStaged)
+				supplyPaths := sync.SupplyPaths(βSnapshot, filteredPaths, pathDigests)
+				if err = beta.Supply(supplyPaths, signatures, receiver); err != nil {
 					return errors.Wrap(err, "unable to stage files on alpha")
 				}
 			}
@@ -755,10 +1192,14 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 
 		c.stateLock.Lock()
 		c.state.Status = Status_StagingBeta
-		c.stateLock.Unlock()
+		c.unlockState()
 		if paths, digests, err := sync.TransitionDependencies(βTransitions); err != nil {
 			return errors.Wrap(err, "unable to determine paths for staging on beta")
 		} else if len(paths) > 0 {
+			pathDigests := make(map[string][]byte, len(paths))
+			for p, path := range paths {
+				pathDigests[path] = digests[p]
+			}
 			filteredPaths, signatures, receiver, err := beta.Stage(paths, digests)
 			if err != nil {
 				return errors.Wrap(err, "unable to begin staging on beta")
@@ -769,7 +1210,9 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 			if len(filteredPaths) > 0 {
 				receiver = rsync.NewMonitoringReceiver(receiver, filteredPaths, monitor)
 				receiver = rsync.NewPreemptableReceiver(receiver, context)
-				if err = alpha.Supply(filteredPaths, signatures, receiver); err != nil {
+				receiver = rsync.NewCountingReceiver(receiver, &βBytesStaged)
+				supplyPaths := sync.SupplyPaths(αSnapshot, filteredPaths, pathDigests)
+				if err = alpha.Supply(supplyPaths, signatures, receiver); err != nil {
 					return errors.Wrap(err, "unable to stage files on beta")
 				}
 			}
@@ -777,7 +1220,7 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 
 		c.stateLock.Lock()
 		c.state.Status = Status_Transitioning
-		c.stateLock.Unlock()
+		c.unlockState()
 		var αResults, βResults []*sync.Entry
 		var αProblems, βProblems []*sync.Problem
 		var αTransitionErr, βTransitionErr error
@@ -794,7 +1237,7 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 				αResults, αProblems, αTransitionErr = alpha.Transition(αTransitions)
 				if αTransitionErr == nil {
 					for t, transition := range αTransitions {
-						αChanges = append(αChanges, &sync.Change{Path: transition.Path, New: αResults[t]})
+						αChanges = append(αChanges, &sync.Change{Path: transition.Path, Old: transition.Old, New: αResults[t]})
 					}
 				}
 				transitionDone.Done()
@@ -805,7 +1248,7 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 				βResults, βProblems, βTransitionErr = beta.Transition(βTransitions)
 				if βTransitionErr == nil {
 					for t, transition := range βTransitions {
-						βChanges = append(βChanges, &sync.Change{Path: transition.Path, New: βResults[t]})
+						βChanges = append(βChanges, &sync.Change{Path: transition.Path, Old: transition.Old, New: βResults[t]})
 					}
 				}
 				transitionDone.Done()
@@ -813,11 +1256,14 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 		}
 		transitionDone.Wait()
 
+		αProblems = append(αScanProblems, αProblems...)
+		βProblems = append(βScanProblems, βProblems...)
+
 		c.stateLock.Lock()
 		c.state.Status = Status_Saving
 		c.state.AlphaProblems = αProblems
 		c.state.BetaProblems = βProblems
-		c.stateLock.Unlock()
+		c.unlockState()
 		ancestorChanges = append(ancestorChanges, αChanges...)
 		ancestorChanges = append(ancestorChanges, βChanges...)
 		if newAncestor, err := sync.Apply(ancestor, ancestorChanges); err != nil {
@@ -835,6 +1281,23 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 			return errors.Wrap(err, "unable to save ancestor")
 		}
 
+		record := &HistoryRecord{
+			AlphaChanges:     historyChanges(αTransitions, αResults),
+			BetaChanges:      historyChanges(βTransitions, βResults),
+			AlphaBytesStaged: αBytesStaged,
+			BetaBytesStaged:  βBytesStaged,
+			AlphaProblems:    αProblems,
+			BetaProblems:     βProblems,
+			Conflicts:        slimConflicts,
+		}
+		if !record.empty() {
+			if err := recordHistory(c.historyPath, startTime, record); err != nil {
+				c.stateLock.Lock()
+				c.state.LastError = errors.Wrap(err, "unable to record synchronization history").Error()
+				c.unlockState()
+			}
+		}
+
 		if αTransitionErr != nil {
 			return errors.Wrap(αTransitionErr, "unable to apply changes to alpha")
 		} else if βTransitionErr != nil {
@@ -843,7 +1306,24 @@ func (c *controller) synchronize(context contextpkg.Context, alpha, beta Endpoin
 
 		c.stateLock.Lock()
 		c.state.SuccessfulSynchronizationCycles++
-		c.stateLock.Unlock()
+		c.metrics.cycles++
+		c.metrics.lastCycleTime = time.Now()
+		c.metrics.alphaBytesStaged += αBytesStaged
+		c.metrics.betaBytesStaged += βBytesStaged
+		c.unlockState()
+
+		c.events.broadcast(&Event{
+			Type:    EventType_EventTypeCycleCompleted,
+			Session: c.session.Identifier,
+			Time:    ptypes.TimestampNow(),
+			Cycle: &CycleSummary{
+				AlphaChanges:     uint64(len(record.AlphaChanges)),
+				BetaChanges:      uint64(len(record.BetaChanges)),
+				AlphaBytesStaged: αBytesStaged,
+				BetaBytesStaged:  βBytesStaged,
+				Conflicts:        uint64(len(slimConflicts)),
+			},
+		})
 
 		if flushRequest != nil {
 			flushRequest <- nil
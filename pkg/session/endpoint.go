@@ -10,7 +10,7 @@ import (
 type Endpoint interface {
 	Poll(context context.Context) error
 
-	Scan(ancestor *sync.Entry) (*sync.Entry, bool, error, bool)
+	Scan(ancestor *sync.Entry) (*sync.Entry, bool, []string, []*sync.Problem, error, bool)
 
 	Stage(paths []string, digests [][]byte) ([]string, []*rsync.Signature, rsync.Receiver, error)
 
@@ -0,0 +1,235 @@
+package session
+
+import (
+	syncpkg "sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+const (
+	eventSubscriptionCapacity = 256
+
+	eventSubscriptionProgressCapacity = eventSubscriptionCapacity / 2
+)
+
+func (t EventType) Description() string {
+	switch t {
+	case EventType_EventTypeDefault:
+		return "Default"
+	case EventType_EventTypeStatusChanged:
+		return "Status changed"
+	case EventType_EventTypeConnected:
+		return "Connected"
+	case EventType_EventTypeDisconnected:
+		return "Disconnected"
+	case EventType_EventTypeCycleCompleted:
+		return "Cycle completed"
+	case EventType_EventTypeConflictAdded:
+		return "Conflict added"
+	case EventType_EventTypeConflictCleared:
+		return "Conflict cleared"
+	case EventType_EventTypeProblemReported:
+		return "Problem reported"
+	case EventType_EventTypeStagingProgress:
+		return "Staging progress"
+	default:
+		return "Unknown"
+	}
+}
+
+func (e *Event) EnsureValid() error {
+	if e == nil {
+		return errors.New("nil event")
+	}
+
+	if e.Session == "" {
+		return errors.New("empty session identifier")
+	}
+
+	if e.Time == nil {
+		return errors.New("missing event time")
+	}
+
+	switch e.Type {
+	case EventType_EventTypeStatusChanged:
+	case EventType_EventTypeConnected:
+	case EventType_EventTypeDisconnected:
+	case EventType_EventTypeCycleCompleted:
+		if e.Cycle == nil {
+			return errors.New("missing cycle summary")
+		}
+	case EventType_EventTypeConflictAdded, EventType_EventTypeConflictCleared:
+		if err := e.Conflict.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid conflict")
+		}
+	case EventType_EventTypeProblemReported:
+		if err := e.Problem.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid problem")
+		}
+	case EventType_EventTypeStagingProgress:
+		if e.StagingStatus == nil {
+			return errors.New("missing staging status")
+		} else if err := e.StagingStatus.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid staging status")
+		}
+	default:
+		return errors.New("unknown event type")
+	}
+
+	return nil
+}
+
+func problemKey(problem *sync.Problem) string {
+	return problem.Path + "\x00" + problem.Error
+}
+
+func stateEvents(session string, previous, current *State) []*Event {
+	var events []*Event
+	emit := func(event *Event) {
+		event.Session = session
+		event.Time = ptypes.TimestampNow()
+		events = append(events, event)
+	}
+
+	if current.Status != previous.Status {
+		emit(&Event{Type: EventType_EventTypeStatusChanged, Status: current.Status})
+	}
+
+	if current.AlphaConnected != previous.AlphaConnected {
+		if current.AlphaConnected {
+			emit(&Event{Type: EventType_EventTypeConnected})
+		} else {
+			emit(&Event{Type: EventType_EventTypeDisconnected})
+		}
+	}
+	if current.BetaConnected != previous.BetaConnected {
+		if current.BetaConnected {
+			emit(&Event{Type: EventType_EventTypeConnected, Beta: true})
+		} else {
+			emit(&Event{Type: EventType_EventTypeDisconnected, Beta: true})
+		}
+	}
+
+	previousConflicts := make(map[string]bool, len(previous.Conflicts))
+	for _, c := range previous.Conflicts {
+		previousConflicts[c.Root()] = true
+	}
+	currentConflicts := make(map[string]bool, len(current.Conflicts))
+	for _, c := range current.Conflicts {
+		currentConflicts[c.Root()] = true
+		if !previousConflicts[c.Root()] {
+			emit(&Event{Type: EventType_EventTypeConflictAdded, Conflict: c})
+		}
+	}
+	for _, c := range previous.Conflicts {
+		if !currentConflicts[c.Root()] {
+			emit(&Event{Type: EventType_EventTypeConflictCleared, Conflict: c})
+		}
+	}
+
+	previousProblems := make(map[string]bool, len(previous.AlphaProblems)+len(previous.BetaProblems))
+	for _, p := range previous.AlphaProblems {
+		previousProblems["α"+problemKey(p)] = true
+	}
+	for _, p := range previous.BetaProblems {
+		previousProblems["β"+problemKey(p)] = true
+	}
+	for _, p := range current.AlphaProblems {
+		if !previousProblems["α"+problemKey(p)] {
+			emit(&Event{Type: EventType_EventTypeProblemReported, Problem: p})
+		}
+	}
+	for _, p := range current.BetaProblems {
+		if !previousProblems["β"+problemKey(p)] {
+			emit(&Event{Type: EventType_EventTypeProblemReported, Problem: p, Beta: true})
+		}
+	}
+
+	if current.StagingStatus != nil && current.StagingStatus != previous.StagingStatus {
+		emit(&Event{Type: EventType_EventTypeStagingProgress, StagingStatus: current.StagingStatus})
+	}
+
+	return events
+}
+
+type eventSubscription struct {
+	events     chan *Event
+	overflowed bool
+}
+
+type eventHub struct {
+	lock        syncpkg.Mutex
+	closed      bool
+	subscribers map[*eventSubscription]bool
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{
+		subscribers: make(map[*eventSubscription]bool),
+	}
+}
+
+func (h *eventHub) subscribe() *eventSubscription {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	subscription := &eventSubscription{
+		events: make(chan *Event, eventSubscriptionCapacity),
+	}
+	if h.closed {
+		close(subscription.events)
+	} else {
+		h.subscribers[subscription] = true
+	}
+
+	return subscription
+}
+
+func (h *eventHub) unsubscribe(subscription *eventSubscription) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.subscribers[subscription] {
+		delete(h.subscribers, subscription)
+		close(subscription.events)
+	}
+}
+
+func (h *eventHub) broadcast(event *Event) {
+	if h == nil {
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for subscription := range h.subscribers {
+		if event.Type == EventType_EventTypeStagingProgress &&
+			len(subscription.events) >= eventSubscriptionProgressCapacity {
+			continue
+		}
+
+		select {
+		case subscription.events <- event:
+		default:
+			subscription.overflowed = true
+			delete(h.subscribers, subscription)
+			close(subscription.events)
+		}
+	}
+}
+
+func (h *eventHub) close() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.closed = true
+	for subscription := range h.subscribers {
+		delete(h.subscribers, subscription)
+		close(subscription.events)
+	}
+}
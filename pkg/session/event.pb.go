@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: session/event.proto
+
+package session // import "github.com/RokyErickson/doppelganger/pkg/session"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import rsync "github.com/RokyErickson/doppelganger/pkg/rsync"
+import sync "github.com/RokyErickson/doppelganger/pkg/sync"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+const _ = proto.ProtoPackageIsVersion2
+
+type EventType int32
+
+const (
+	EventType_EventTypeDefault         EventType = 0
+	EventType_EventTypeStatusChanged   EventType = 1
+	EventType_EventTypeConnected       EventType = 2
+	EventType_EventTypeDisconnected    EventType = 3
+	EventType_EventTypeCycleCompleted  EventType = 4
+	EventType_EventTypeConflictAdded   EventType = 5
+	EventType_EventTypeConflictCleared EventType = 6
+	EventType_EventTypeProblemReported EventType = 7
+	EventType_EventTypeStagingProgress EventType = 8
+)
+
+var EventType_name = map[int32]string{
+	0: "EventTypeDefault",
+	1: "EventTypeStatusChanged",
+	2: "EventTypeConnected",
+	3: "EventTypeDisconnected",
+	4: "EventTypeCycleCompleted",
+	5: "EventTypeConflictAdded",
+	6: "EventTypeConflictCleared",
+	7: "EventTypeProblemReported",
+	8: "EventTypeStagingProgress",
+}
+var EventType_value = map[string]int32{
+	"EventTypeDefault":         0,
+	"EventTypeStatusChanged":   1,
+	"EventTypeConnected":       2,
+	"EventTypeDisconnected":    3,
+	"EventTypeCycleCompleted":  4,
+	"EventTypeConflictAdded":   5,
+	"EventTypeConflictCleared": 6,
+	"EventTypeProblemReported": 7,
+	"EventTypeStagingProgress": 8,
+}
+
+func (x EventType) String() string {
+	return proto.EnumName(EventType_name, int32(x))
+}
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_event_cbaeee71c9006806, []int{0}
+}
+
+type CycleSummary struct {
+	AlphaChanges         uint64   `protobuf:"varint,1,opt,name=alphaChanges,proto3" json:"alphaChanges,omitempty"`
+	BetaChanges          uint64   `protobuf:"varint,2,opt,name=betaChanges,proto3" json:"betaChanges,omitempty"`
+	AlphaBytesStaged     uint64   `protobuf:"varint,3,opt,name=alphaBytesStaged,proto3" json:"alphaBytesStaged,omitempty"`
+	BetaBytesStaged      uint64   `protobuf:"varint,4,opt,name=betaBytesStaged,proto3" json:"betaBytesStaged,omitempty"`
+	Conflicts            uint64   `protobuf:"varint,5,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CycleSummary) Reset()         { *m = CycleSummary{} }
+func (m *CycleSummary) String() string { return proto.CompactTextString(m) }
+func (*CycleSummary) ProtoMessage()    {}
+func (*CycleSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_cbaeee71c9006806, []int{0}
+}
+func (m *CycleSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CycleSummary.Unmarshal(m, b)
+}
+func (m *CycleSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CycleSummary.Marshal(b, m, deterministic)
+}
+func (dst *CycleSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CycleSummary.Merge(dst, src)
+}
+func (m *CycleSummary) XXX_Size() int {
+	return xxx_messageInfo_CycleSummary.Size(m)
+}
+func (m *CycleSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_CycleSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CycleSummary proto.InternalMessageInfo
+
+func (m *CycleSummary) GetAlphaChanges() uint64 {
+	if m != nil {
+		return m.AlphaChanges
+	}
+	return 0
+}
+
+func (m *CycleSummary) GetBetaChanges() uint64 {
+	if m != nil {
+		return m.BetaChanges
+	}
+	return 0
+}
+
+func (m *CycleSummary) GetAlphaBytesStaged() uint64 {
+	if m != nil {
+		return m.AlphaBytesStaged
+	}
+	return 0
+}
+
+func (m *CycleSummary) GetBetaBytesStaged() uint64 {
+	if m != nil {
+		return m.BetaBytesStaged
+	}
+	return 0
+}
+
+func (m *CycleSummary) GetConflicts() uint64 {
+	if m != nil {
+		return m.Conflicts
+	}
+	return 0
+}
+
+type Event struct {
+	Type                 EventType             `protobuf:"varint,1,opt,name=type,proto3,enum=session.EventType" json:"type,omitempty"`
+	Session              string                `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	Time                 *timestamp.Timestamp  `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Beta                 bool                  `protobuf:"varint,4,opt,name=beta,proto3" json:"beta,omitempty"`
+	Status               Status                `protobuf:"varint,5,opt,name=status,proto3,enum=session.Status" json:"status,omitempty"`
+	Cycle                *CycleSummary         `protobuf:"bytes,6,opt,name=cycle,proto3" json:"cycle,omitempty"`
+	Conflict             *sync.Conflict        `protobuf:"bytes,7,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	Problem              *sync.Problem         `protobuf:"bytes,8,opt,name=problem,proto3" json:"problem,omitempty"`
+	StagingStatus        *rsync.ReceiverStatus `protobuf:"bytes,9,opt,name=stagingStatus,proto3" json:"stagingStatus,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_cbaeee71c9006806, []int{1}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (dst *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(dst, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetType() EventType {
+	if m != nil {
+		return m.Type
+	}
+	return EventType_EventTypeDefault
+}
+
+func (m *Event) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *Event) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Event) GetBeta() bool {
+	if m != nil {
+		return m.Beta
+	}
+	return false
+}
+
+func (m *Event) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_Disconnected
+}
+
+func (m *Event) GetCycle() *CycleSummary {
+	if m != nil {
+		return m.Cycle
+	}
+	return nil
+}
+
+func (m *Event) GetConflict() *sync.Conflict {
+	if m != nil {
+		return m.Conflict
+	}
+	return nil
+}
+
+func (m *Event) GetProblem() *sync.Problem {
+	if m != nil {
+		return m.Problem
+	}
+	return nil
+}
+
+func (m *Event) GetStagingStatus() *rsync.ReceiverStatus {
+	if m != nil {
+		return m.StagingStatus
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CycleSummary)(nil), "session.CycleSummary")
+	proto.RegisterType((*Event)(nil), "session.Event")
+	proto.RegisterEnum("session.EventType", EventType_name, EventType_value)
+}
+
+func init() { proto.RegisterFile("session/event.proto", fileDescriptor_event_cbaeee71c9006806) }
+
+var fileDescriptor_event_cbaeee71c9006806 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xcd, 0x6e, 0xdb, 0x3c,
+	0x10, 0xfc, 0xec, 0xc8, 0x7f, 0x9b, 0x3f, 0x61, 0xf3, 0x25, 0x55, 0xdd, 0x00, 0x0d, 0x7c, 0x68,
+	0x02, 0x17, 0x90, 0x0a, 0xf7, 0xd8, 0x53, 0xa3, 0xe6, 0x1e, 0xc8, 0x39, 0xf5, 0x26, 0x4b, 0x6b,
+	0x59, 0xb0, 0x24, 0x0a, 0x24, 0x1d, 0x40, 0xe7, 0xbe, 0x5c, 0x5f, 0xa8, 0xf7, 0x82, 0xa4, 0x28,
+	0xdb, 0xcd, 0x4d, 0x9a, 0x19, 0x92, 0xb3, 0x33, 0x0b, 0x57, 0x82, 0x84, 0xc8, 0x59, 0x15, 0xd0,
+	0x2b, 0x55, 0xd2, 0xaf, 0x39, 0x93, 0x0c, 0x47, 0x2d, 0x38, 0xfd, 0x98, 0x31, 0x96, 0x15, 0x14,
+	0x68, 0x78, 0xb5, 0x5b, 0x07, 0x32, 0x2f, 0x49, 0xc8, 0xb8, 0xac, 0x8d, 0x72, 0x7a, 0xc5, 0x45,
+	0x53, 0x25, 0x01, 0xa7, 0x84, 0xf2, 0x57, 0xb2, 0xa0, 0xbd, 0x53, 0xc8, 0x58, 0xee, 0x41, 0x25,
+	0x4c, 0x58, 0xb5, 0x2e, 0xf2, 0xa4, 0x7d, 0x68, 0x8a, 0x1a, 0xac, 0x39, 0x5b, 0x15, 0x54, 0x1a,
+	0x6c, 0xf6, 0xbb, 0x07, 0x67, 0x61, 0x93, 0x14, 0xb4, 0xdc, 0x95, 0x65, 0xcc, 0x1b, 0x9c, 0xc1,
+	0x59, 0x5c, 0xd4, 0x9b, 0x38, 0xdc, 0xc4, 0x55, 0x46, 0xc2, 0xeb, 0xdd, 0xf5, 0x1e, 0x9c, 0xe8,
+	0x08, 0xc3, 0x3b, 0x38, 0x5d, 0x91, 0xec, 0x24, 0x7d, 0x2d, 0x39, 0x84, 0x70, 0x0e, 0xae, 0x3e,
+	0xf1, 0xd8, 0x48, 0x12, 0x4b, 0x19, 0x67, 0x94, 0x7a, 0x27, 0x5a, 0xf6, 0x06, 0xc7, 0x07, 0xb8,
+	0x54, 0x47, 0x0f, 0xa5, 0x8e, 0x96, 0xfe, 0x0b, 0xe3, 0x2d, 0x4c, 0xec, 0x48, 0xc2, 0x1b, 0x68,
+	0xcd, 0x1e, 0x98, 0xfd, 0xe9, 0xc3, 0xe0, 0x49, 0xe5, 0x8a, 0x9f, 0xc0, 0x91, 0x4d, 0x4d, 0xda,
+	0xfb, 0xc5, 0x02, 0xfd, 0x36, 0x21, 0x5f, 0xb3, 0x2f, 0x4d, 0x4d, 0x91, 0xe6, 0xd1, 0x03, 0x9b,
+	0xbd, 0x9e, 0x61, 0x12, 0xd9, 0x5f, 0xf4, 0xc1, 0x51, 0xe1, 0x6b, 0xcf, 0xa7, 0x8b, 0xa9, 0x6f,
+	0x9a, 0xf1, 0x6d, 0x33, 0xfe, 0x8b, 0x6d, 0x26, 0xd2, 0x3a, 0x44, 0x70, 0x94, 0x59, 0x6d, 0x7c,
+	0x1c, 0xe9, 0x6f, 0xbc, 0x87, 0xa1, 0xaa, 0x64, 0x67, 0xac, 0x5e, 0x2c, 0x2e, 0x3b, 0x1f, 0x4b,
+	0x0d, 0x47, 0x2d, 0x8d, 0x9f, 0x61, 0x90, 0xa8, 0x0a, 0xbc, 0xa1, 0x7e, 0xed, 0xba, 0xd3, 0x1d,
+	0x16, 0x13, 0x19, 0x0d, 0xce, 0x61, 0x6c, 0x47, 0xf6, 0x46, 0x5a, 0x7f, 0xe1, 0xab, 0x5e, 0xfd,
+	0xb0, 0x45, 0xa3, 0x8e, 0xc7, 0x7b, 0x18, 0xb5, 0x6d, 0x7b, 0x63, 0x2d, 0x3d, 0x37, 0xd2, 0x67,
+	0x03, 0x46, 0x96, 0xc5, 0x6f, 0x70, 0x2e, 0x64, 0x9c, 0xe5, 0x55, 0x66, 0xac, 0x79, 0x93, 0xd6,
+	0x89, 0x5e, 0x38, 0x3f, 0x32, 0x0b, 0xc7, 0x5b, 0xdf, 0xc7, 0xda, 0xf9, 0xaf, 0x3e, 0x4c, 0xba,
+	0x64, 0xf1, 0x7f, 0x70, 0xbb, 0x9f, 0x1f, 0xb4, 0x8e, 0x77, 0x85, 0x74, 0xff, 0xc3, 0x29, 0xdc,
+	0x74, 0xa8, 0x39, 0x66, 0x36, 0x25, 0x75, 0x7b, 0x78, 0x03, 0xd8, 0x71, 0x21, 0xab, 0x2a, 0x4a,
+	0x24, 0xa5, 0x6e, 0x1f, 0xdf, 0xc3, 0xf5, 0xfe, 0xa6, 0x5c, 0x24, 0x1d, 0x75, 0x82, 0x1f, 0xe0,
+	0xdd, 0xfe, 0x88, 0x8a, 0x25, 0x64, 0x65, 0x5d, 0x90, 0x22, 0x9d, 0xa3, 0xb7, 0x6c, 0x28, 0xdf,
+	0xd3, 0x94, 0x52, 0x77, 0x80, 0xb7, 0xe0, 0xbd, 0xe1, 0xc2, 0x82, 0x62, 0x4e, 0xa9, 0x3b, 0x3c,
+	0x62, 0x6d, 0x46, 0x54, 0x33, 0xae, 0xee, 0x1d, 0x1d, 0xb1, 0x4b, 0x93, 0xc0, 0x33, 0x67, 0x19,
+	0x27, 0x21, 0xdc, 0xf1, 0xe3, 0xe2, 0xe7, 0x97, 0x2c, 0x97, 0x9b, 0xdd, 0xca, 0x4f, 0x58, 0x19,
+	0x44, 0x6c, 0xdb, 0x3c, 0xf1, 0x3c, 0xd9, 0x0a, 0x56, 0x05, 0x29, 0xab, 0x6b, 0x2a, 0x32, 0x35,
+	0x2f, 0x0f, 0xea, 0x6d, 0x16, 0xb4, 0xfd, 0xae, 0x86, 0x7a, 0x9f, 0xbe, 0xfe, 0x1d, 0x00, 0x62,
+	0x36, 0x19, 0xbf, 0x17, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,105 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/RokyErickson/doppelganger/pkg/rsync"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+func TestStateEventsUnchanged(t *testing.T) {
+	state := &State{Status: Status_Watching, AlphaConnected: true}
+	if events := stateEvents("session", state, state); len(events) != 0 {
+		t.Error("events generated for unchanged state:", len(events))
+	}
+}
+
+func TestStateEvents(t *testing.T) {
+	conflict := &sync.Conflict{
+		AlphaChanges: []*sync.Change{{Path: "a", New: &sync.Entry{Kind: sync.EntryKind_File}}},
+		BetaChanges:  []*sync.Change{{Path: "a"}},
+	}
+	previous := &State{
+		Status:        Status_Scanning,
+		AlphaProblems: []*sync.Problem{{Path: "b", Error: "failed"}},
+	}
+	current := &State{
+		Status:         Status_StagingAlpha,
+		AlphaConnected: true,
+		Conflicts:      []*sync.Conflict{conflict},
+		AlphaProblems:  []*sync.Problem{{Path: "b", Error: "failed"}},
+		BetaProblems:   []*sync.Problem{{Path: "c", Error: "failed"}},
+		StagingStatus:  &rsync.ReceiverStatus{Path: "a", Total: 1},
+	}
+
+	events := stateEvents("session", previous, current)
+	expected := []EventType{
+		EventType_EventTypeStatusChanged,
+		EventType_EventTypeConnected,
+		EventType_EventTypeConflictAdded,
+		EventType_EventTypeProblemReported,
+		EventType_EventTypeStagingProgress,
+	}
+	if len(events) != len(expected) {
+		t.Fatal("unexpected number of events:", len(events))
+	}
+	for e, event := range events {
+		if event.Type != expected[e] {
+			t.Error("unexpected event type:", event.Type, "!=", expected[e])
+		}
+		if err := event.EnsureValid(); err != nil {
+			t.Error("invalid event generated:", err)
+		}
+	}
+	if !events[3].Beta {
+		t.Error("problem event not attributed to beta")
+	}
+
+	cleared := stateEvents("session", current, &State{Status: current.Status, AlphaConnected: true})
+	if len(cleared) != 1 || cleared[0].Type != EventType_EventTypeConflictCleared {
+		t.Error("conflict clearing not reported")
+	}
+}
+
+func TestEventHubOverflow(t *testing.T) {
+	hub := newEventHub()
+	subscription := hub.subscribe()
+
+	for i := 0; i < eventSubscriptionCapacity+1; i++ {
+		hub.broadcast(&Event{Type: EventType_EventTypeStatusChanged})
+	}
+
+	received := 0
+	for range subscription.events {
+		received++
+	}
+	if received != eventSubscriptionCapacity {
+		t.Error("unexpected number of buffered events:", received)
+	}
+	if !subscription.overflowed {
+		t.Error("overflow not recorded on subscription")
+	}
+
+	hub.unsubscribe(subscription)
+	hub.close()
+	if _, ok := <-hub.subscribe().events; ok {
+		t.Error("subscription to closed hub is open")
+	}
+}
+
+func TestEventHubProgressCoalesced(t *testing.T) {
+	hub := newEventHub()
+	subscription := hub.subscribe()
+	defer hub.unsubscribe(subscription)
+
+	for i := 0; i < eventSubscriptionCapacity+1; i++ {
+		hub.broadcast(&Event{Type: EventType_EventTypeStagingProgress})
+	}
+	hub.broadcast(&Event{Type: EventType_EventTypeCycleCompleted})
+
+	if subscription.overflowed {
+		t.Fatal("progress events overflowed subscription")
+	} else if len(subscription.events) != eventSubscriptionProgressCapacity+1 {
+		t.Error("unexpected number of buffered events:", len(subscription.events))
+	}
+}
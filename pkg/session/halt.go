@@ -0,0 +1,42 @@
+package session
+
+import (
+	"github.com/pkg/errors"
+)
+
+func (a *HaltAcknowledgement) UnmarshalText(textBytes []byte) error {
+	text := string(textBytes)
+
+	switch text {
+	case "propagate":
+		*a = HaltAcknowledgement_HaltAcknowledgementPropagate
+	case "reseed":
+		*a = HaltAcknowledgement_HaltAcknowledgementReseed
+	default:
+		return errors.Errorf("unknown halt acknowledgement specification: %s", text)
+	}
+
+	return nil
+}
+
+func (a HaltAcknowledgement) Supported() bool {
+	switch a {
+	case HaltAcknowledgement_HaltAcknowledgementPropagate:
+		return true
+	case HaltAcknowledgement_HaltAcknowledgementReseed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (a HaltAcknowledgement) Description() string {
+	switch a {
+	case HaltAcknowledgement_HaltAcknowledgementPropagate:
+		return "Propagate"
+	case HaltAcknowledgement_HaltAcknowledgementReseed:
+		return "Re-seed"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: session/halt.proto
+
+package session // import "github.com/RokyErickson/doppelganger/pkg/session"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+const _ = proto.ProtoPackageIsVersion2
+
+type HaltAcknowledgement int32
+
+const (
+	HaltAcknowledgement_HaltAcknowledgementInvalid   HaltAcknowledgement = 0
+	HaltAcknowledgement_HaltAcknowledgementPropagate HaltAcknowledgement = 1
+	HaltAcknowledgement_HaltAcknowledgementReseed    HaltAcknowledgement = 2
+)
+
+var HaltAcknowledgement_name = map[int32]string{
+	0: "HaltAcknowledgementInvalid",
+	1: "HaltAcknowledgementPropagate",
+	2: "HaltAcknowledgementReseed",
+}
+var HaltAcknowledgement_value = map[string]int32{
+	"HaltAcknowledgementInvalid":   0,
+	"HaltAcknowledgementPropagate": 1,
+	"HaltAcknowledgementReseed":    2,
+}
+
+func (x HaltAcknowledgement) String() string {
+	return proto.EnumName(HaltAcknowledgement_name, int32(x))
+}
+func (HaltAcknowledgement) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_halt_cda6dd4c6a1e150a, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("session.HaltAcknowledgement", HaltAcknowledgement_name, HaltAcknowledgement_value)
+}
+
+func init() { proto.RegisterFile("session/halt.proto", fileDescriptor_halt_cda6dd4c6a1e150a) }
+
+var fileDescriptor_halt_cda6dd4c6a1e150a = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xce, 0xb1, 0x0a, 0xc2, 0x30,
+	0x10, 0xc6, 0x71, 0x75, 0x50, 0xc8, 0x54, 0xe2, 0xa4, 0xa8, 0x38, 0x3b, 0x34, 0xa2, 0x4f, 0xa0,
+	0x20, 0xe8, 0x26, 0x1d, 0xdd, 0xd2, 0xe6, 0x48, 0x43, 0xd2, 0xbb, 0x90, 0x9c, 0x15, 0xdf, 0x5e,
+	0x90, 0x8e, 0x5d, 0xff, 0xdf, 0x37, 0xfc, 0x84, 0xcc, 0x90, 0xb3, 0x23, 0x54, 0xad, 0x0e, 0x5c,
+	0xc6, 0x44, 0x4c, 0x72, 0x31, 0xb4, 0x43, 0x2f, 0x96, 0x77, 0x1d, 0xf8, 0xd2, 0x78, 0xa4, 0x4f,
+	0x00, 0x63, 0xa1, 0x03, 0x64, 0xb9, 0x13, 0xeb, 0x91, 0xfc, 0xc0, 0x5e, 0x07, 0x67, 0x8a, 0x89,
+	0xdc, 0x8b, 0xcd, 0xc8, 0xfe, 0x4c, 0x14, 0xb5, 0xd5, 0x0c, 0xc5, 0x54, 0x6e, 0xc5, 0x6a, 0xe4,
+	0x51, 0x41, 0x06, 0x30, 0xc5, 0xec, 0x7a, 0x7a, 0x1d, 0xad, 0xe3, 0xf6, 0x5d, 0x97, 0x0d, 0x75,
+	0xaa, 0x22, 0xff, 0xbd, 0x25, 0xd7, 0xf8, 0x4c, 0xa8, 0x0c, 0xc5, 0x08, 0xc1, 0x6a, 0xb4, 0x90,
+	0x54, 0xf4, 0x56, 0x0d, 0xd6, 0x7a, 0xfe, 0xb7, 0x9f, 0x7f, 0x03, 0x00, 0xb3, 0x04, 0x61, 0x36,
+	0xd1, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,302 @@
+package session
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/bmatcuk/doublestar"
+
+	"github.com/RokyErickson/doppelganger/pkg/encoding"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+const (
+	maximumHistorySize = 4 * 1024 * 1024
+
+	historyFilePermissions = 0600
+
+	rotatedHistorySuffix = ".1"
+)
+
+func (k HistoryChangeKind) Description() string {
+	switch k {
+	case HistoryChangeKind_HistoryChangeKindDefault:
+		return "Default"
+	case HistoryChangeKind_HistoryChangeKindCreated:
+		return "Created"
+	case HistoryChangeKind_HistoryChangeKindModified:
+		return "Modified"
+	case HistoryChangeKind_HistoryChangeKindDeleted:
+		return "Deleted"
+	default:
+		return "Unknown"
+	}
+}
+
+func (r *HistoryRecord) EnsureValid() error {
+	if r == nil {
+		return errors.New("nil history record")
+	}
+
+	if r.StartTime == nil {
+		return errors.New("missing start time")
+	}
+
+	if r.EndTime == nil {
+		return errors.New("missing end time")
+	}
+
+	for _, c := range r.AlphaChanges {
+		if c == nil {
+			return errors.New("nil alpha change")
+		} else if c.Kind == HistoryChangeKind_HistoryChangeKindDefault {
+			return errors.New("alpha change with unspecified kind")
+		}
+	}
+
+	for _, c := range r.BetaChanges {
+		if c == nil {
+			return errors.New("nil beta change")
+		} else if c.Kind == HistoryChangeKind_HistoryChangeKindDefault {
+			return errors.New("beta change with unspecified kind")
+		}
+	}
+
+	for _, p := range r.AlphaProblems {
+		if err := p.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid alpha problem detected")
+		}
+	}
+
+	for _, p := range r.BetaProblems {
+		if err := p.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid beta problem detected")
+		}
+	}
+
+	for _, c := range r.Conflicts {
+		if err := c.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid conflict detected")
+		}
+	}
+
+	return nil
+}
+
+func (r *HistoryRecord) empty() bool {
+	return len(r.AlphaChanges) == 0 && len(r.BetaChanges) == 0 &&
+		r.AlphaBytesStaged == 0 && r.BetaBytesStaged == 0 &&
+		len(r.AlphaProblems) == 0 && len(r.BetaProblems) == 0 &&
+		len(r.Conflicts) == 0
+}
+
+func (r *HistoryRecord) Filter(pattern string) *HistoryRecord {
+	matches := func(path string) bool {
+		match, _ := doublestar.Match(pattern, path)
+		return match
+	}
+
+	result := &HistoryRecord{
+		StartTime:        r.StartTime,
+		EndTime:          r.EndTime,
+		AlphaBytesStaged: r.AlphaBytesStaged,
+		BetaBytesStaged:  r.BetaBytesStaged,
+	}
+	for _, c := range r.AlphaChanges {
+		if matches(c.Path) {
+			result.AlphaChanges = append(result.AlphaChanges, c)
+		}
+	}
+	for _, c := range r.BetaChanges {
+		if matches(c.Path) {
+			result.BetaChanges = append(result.BetaChanges, c)
+		}
+	}
+	for _, p := range r.AlphaProblems {
+		if matches(p.Path) {
+			result.AlphaProblems = append(result.AlphaProblems, p)
+		}
+	}
+	for _, p := range r.BetaProblems {
+		if matches(p.Path) {
+			result.BetaProblems = append(result.BetaProblems, p)
+		}
+	}
+	for _, c := range r.Conflicts {
+		if matches(c.Root()) {
+			result.Conflicts = append(result.Conflicts, c)
+		}
+	}
+
+	if len(result.AlphaChanges) == 0 && len(result.BetaChanges) == 0 &&
+		len(result.AlphaProblems) == 0 && len(result.BetaProblems) == 0 &&
+		len(result.Conflicts) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func historyPathJoin(base, leaf string) string {
+	if base == "" {
+		return leaf
+	}
+	return base + "/" + leaf
+}
+
+func historyEntriesEqualShallow(first, second *sync.Entry) bool {
+	if first.Kind != second.Kind {
+		return false
+	}
+
+	switch first.Kind {
+	case sync.EntryKind_File:
+		return first.Executable == second.Executable && bytes.Equal(first.Digest, second.Digest)
+	case sync.EntryKind_Symlink:
+		return first.Target == second.Target
+	default:
+		return true
+	}
+}
+
+func appendHistoryChanges(changes []*HistoryChange, path string, base, target *sync.Entry) []*HistoryChange {
+	if base == nil && target == nil {
+		return changes
+	} else if base == nil {
+		changes = append(changes, &HistoryChange{Path: path, Kind: HistoryChangeKind_HistoryChangeKindCreated})
+	} else if target == nil {
+		changes = append(changes, &HistoryChange{Path: path, Kind: HistoryChangeKind_HistoryChangeKindDeleted})
+	} else if !historyEntriesEqualShallow(base, target) {
+		changes = append(changes, &HistoryChange{Path: path, Kind: HistoryChangeKind_HistoryChangeKindModified})
+	}
+
+	var baseContents, targetContents map[string]*sync.Entry
+	if base != nil && base.Kind == sync.EntryKind_Directory {
+		baseContents = base.Contents
+	}
+	if target != nil && target.Kind == sync.EntryKind_Directory {
+		targetContents = target.Contents
+	}
+	for name, entry := range baseContents {
+		changes = appendHistoryChanges(changes, historyPathJoin(path, name), entry, targetContents[name])
+	}
+	for name, entry := range targetContents {
+		if _, ok := baseContents[name]; !ok {
+			changes = appendHistoryChanges(changes, historyPathJoin(path, name), nil, entry)
+		}
+	}
+
+	return changes
+}
+
+func historyChanges(transitions []*sync.Change, results []*sync.Entry) []*HistoryChange {
+	if len(results) != len(transitions) {
+		return nil
+	}
+
+	var changes []*HistoryChange
+	for t, transition := range transitions {
+		changes = appendHistoryChanges(changes, transition.Path, transition.Old, results[t])
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+func loadHistory(path string) ([]*HistoryRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []*HistoryRecord
+	decoder := encoding.NewProtobufDecoder(file)
+	for {
+		record := &HistoryRecord{}
+		if err := decoder.Decode(record); err != nil {
+			if cause := errors.Cause(err); cause == io.EOF || cause == io.ErrUnexpectedEOF {
+				break
+			}
+			return nil, errors.Wrap(err, "unable to decode history record")
+		} else if err = record.EnsureValid(); err != nil {
+			return nil, errors.Wrap(err, "invalid history record")
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func recordHistory(path string, startTime time.Time, record *HistoryRecord) error {
+	var err error
+	if record.StartTime, err = ptypes.TimestampProto(startTime); err != nil {
+		return errors.Wrap(err, "unable to convert cycle start time format")
+	} else if record.EndTime, err = ptypes.TimestampProto(time.Now()); err != nil {
+		return errors.Wrap(err, "unable to convert cycle end time format")
+	}
+
+	return appendHistoryRecord(path, record)
+}
+
+func appendHistoryRecord(path string, record *HistoryRecord) error {
+	if info, err := os.Stat(path); err == nil && info.Size() >= maximumHistorySize {
+		if err := os.Rename(path, path+rotatedHistorySuffix); err != nil {
+			return errors.Wrap(err, "unable to rotate history")
+		}
+	} else if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "unable to query history")
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, historyFilePermissions)
+	if err != nil {
+		return errors.Wrap(err, "unable to open history")
+	}
+
+	encodeErr := encoding.NewProtobufEncoder(file).Encode(record)
+	closeErr := file.Close()
+	if encodeErr != nil {
+		return errors.Wrap(encodeErr, "unable to append history record")
+	} else if closeErr != nil {
+		return errors.Wrap(closeErr, "unable to close history")
+	}
+
+	return nil
+}
+
+func readHistory(path string) ([]*HistoryRecord, error) {
+	rotated, err := loadHistory(path + rotatedHistorySuffix)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load rotated history")
+	}
+
+	current, err := loadHistory(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load history")
+	}
+
+	return append(rotated, current...), nil
+}
+
+func removeHistory(path string) error {
+	if err := os.Remove(path + rotatedHistorySuffix); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: session/history.proto
+
+package session // import "github.com/RokyErickson/doppelganger/pkg/session"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import sync "github.com/RokyErickson/doppelganger/pkg/sync"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+const _ = proto.ProtoPackageIsVersion2
+
+type HistoryChangeKind int32
+
+const (
+	HistoryChangeKind_HistoryChangeKindDefault  HistoryChangeKind = 0
+	HistoryChangeKind_HistoryChangeKindCreated  HistoryChangeKind = 1
+	HistoryChangeKind_HistoryChangeKindModified HistoryChangeKind = 2
+	HistoryChangeKind_HistoryChangeKindDeleted  HistoryChangeKind = 3
+)
+
+var HistoryChangeKind_name = map[int32]string{
+	0: "HistoryChangeKindDefault",
+	1: "HistoryChangeKindCreated",
+	2: "HistoryChangeKindModified",
+	3: "HistoryChangeKindDeleted",
+}
+var HistoryChangeKind_value = map[string]int32{
+	"HistoryChangeKindDefault":  0,
+	"HistoryChangeKindCreated":  1,
+	"HistoryChangeKindModified": 2,
+	"HistoryChangeKindDeleted":  3,
+}
+
+func (x HistoryChangeKind) String() string {
+	return proto.EnumName(HistoryChangeKind_name, int32(x))
+}
+func (HistoryChangeKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_history_90f4cbaecc279091, []int{0}
+}
+
+type HistoryChange struct {
+	Path                 string            `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Kind                 HistoryChangeKind `protobuf:"varint,2,opt,name=kind,proto3,enum=session.HistoryChangeKind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HistoryChange) Reset()         { *m = HistoryChange{} }
+func (m *HistoryChange) String() string { return proto.CompactTextString(m) }
+func (*HistoryChange) ProtoMessage()    {}
+func (*HistoryChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_history_90f4cbaecc279091, []int{0}
+}
+func (m *HistoryChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryChange.Unmarshal(m, b)
+}
+func (m *HistoryChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryChange.Marshal(b, m, deterministic)
+}
+func (dst *HistoryChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryChange.Merge(dst, src)
+}
+func (m *HistoryChange) XXX_Size() int {
+	return xxx_messageInfo_HistoryChange.Size(m)
+}
+func (m *HistoryChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryChange proto.InternalMessageInfo
+
+func (m *HistoryChange) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *HistoryChange) GetKind() HistoryChangeKind {
+	if m != nil {
+		return m.Kind
+	}
+	return HistoryChangeKind_HistoryChangeKindDefault
+}
+
+type HistoryRecord struct {
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,2,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	AlphaChanges         []*HistoryChange     `protobuf:"bytes,3,rep,name=alphaChanges,proto3" json:"alphaChanges,omitempty"`
+	BetaChanges          []*HistoryChange     `protobuf:"bytes,4,rep,name=betaChanges,proto3" json:"betaChanges,omitempty"`
+	AlphaBytesStaged     uint64               `protobuf:"varint,5,opt,name=alphaBytesStaged,proto3" json:"alphaBytesStaged,omitempty"`
+	BetaBytesStaged      uint64               `protobuf:"varint,6,opt,name=betaBytesStaged,proto3" json:"betaBytesStaged,omitempty"`
+	AlphaProblems        []*sync.Problem      `protobuf:"bytes,7,rep,name=alphaProblems,proto3" json:"alphaProblems,omitempty"`
+	BetaProblems         []*sync.Problem      `protobuf:"bytes,8,rep,name=betaProblems,proto3" json:"betaProblems,omitempty"`
+	Conflicts            []*sync.Conflict     `protobuf:"bytes,9,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *HistoryRecord) Reset()         { *m = HistoryRecord{} }
+func (m *HistoryRecord) String() string { return proto.CompactTextString(m) }
+func (*HistoryRecord) ProtoMessage()    {}
+func (*HistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_history_90f4cbaecc279091, []int{1}
+}
+func (m *HistoryRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryRecord.Unmarshal(m, b)
+}
+func (m *HistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryRecord.Marshal(b, m, deterministic)
+}
+func (dst *HistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRecord.Merge(dst, src)
+}
+func (m *HistoryRecord) XXX_Size() int {
+	return xxx_messageInfo_HistoryRecord.Size(m)
+}
+func (m *HistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryRecord proto.InternalMessageInfo
+
+func (m *HistoryRecord) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *HistoryRecord) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *HistoryRecord) GetAlphaChanges() []*HistoryChange {
+	if m != nil {
+		return m.AlphaChanges
+	}
+	return nil
+}
+
+func (m *HistoryRecord) GetBetaChanges() []*HistoryChange {
+	if m != nil {
+		return m.BetaChanges
+	}
+	return nil
+}
+
+func (m *HistoryRecord) GetAlphaBytesStaged() uint64 {
+	if m != nil {
+		return m.AlphaBytesStaged
+	}
+	return 0
+}
+
+func (m *HistoryRecord) GetBetaBytesStaged() uint64 {
+	if m != nil {
+		return m.BetaBytesStaged
+	}
+	return 0
+}
+
+func (m *HistoryRecord) GetAlphaProblems() []*sync.Problem {
+	if m != nil {
+		return m.AlphaProblems
+	}
+	return nil
+}
+
+func (m *HistoryRecord) GetBetaProblems() []*sync.Problem {
+	if m != nil {
+		return m.BetaProblems
+	}
+	return nil
+}
+
+func (m *HistoryRecord) GetConflicts() []*sync.Conflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*HistoryChange)(nil), "session.HistoryChange")
+	proto.RegisterType((*HistoryRecord)(nil), "session.HistoryRecord")
+	proto.RegisterEnum("session.HistoryChangeKind", HistoryChangeKind_name, HistoryChangeKind_value)
+}
+
+func init() { proto.RegisterFile("session/history.proto", fileDescriptor_history_90f4cbaecc279091) }
+
+var fileDescriptor_history_90f4cbaecc279091 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x49, 0x5b, 0x56, 0xfa, 0xba, 0x8e, 0xf2, 0x10, 0x28, 0x54, 0x20, 0xaa, 0x9d, 0xa2,
+	0x09, 0x39, 0xd0, 0x71, 0x98, 0x38, 0xae, 0x20, 0x21, 0x21, 0x24, 0xe4, 0xed, 0xc4, 0xcd, 0x89,
+	0x5f, 0x13, 0xab, 0x49, 0x1c, 0xc5, 0xee, 0xa1, 0xdf, 0x81, 0x2f, 0xc9, 0x37, 0x41, 0x71, 0xd2,
+	0x6e, 0x25, 0x85, 0xdd, 0xa2, 0xbf, 0x7f, 0xbf, 0x7f, 0x9e, 0xf5, 0x0c, 0x2f, 0x0c, 0x19, 0xa3,
+	0x74, 0x11, 0xa6, 0xca, 0x58, 0x5d, 0x6d, 0x59, 0x59, 0x69, 0xab, 0x71, 0xd8, 0xc6, 0xb3, 0xb7,
+	0x89, 0xd6, 0x49, 0x46, 0xa1, 0x8b, 0xa3, 0xcd, 0x2a, 0xb4, 0x2a, 0x27, 0x63, 0x45, 0x5e, 0x36,
+	0xe4, 0xec, 0xb9, 0xd9, 0x16, 0x71, 0x18, 0xeb, 0x62, 0x95, 0xa9, 0xd8, 0xb6, 0x21, 0xba, 0xb0,
+	0xac, 0x74, 0x94, 0x51, 0xde, 0x64, 0xe7, 0x37, 0x30, 0xf9, 0xda, 0xfc, 0x63, 0x99, 0x8a, 0x22,
+	0x21, 0x44, 0x18, 0x94, 0xc2, 0xa6, 0xbe, 0x37, 0xf7, 0x82, 0x11, 0x77, 0xdf, 0xc8, 0x60, 0xb0,
+	0x56, 0x85, 0xf4, 0x7b, 0x73, 0x2f, 0x38, 0x5b, 0xcc, 0x58, 0x3b, 0x06, 0x3b, 0x30, 0xbf, 0xa9,
+	0x42, 0x72, 0xc7, 0x9d, 0xff, 0xee, 0xef, 0x5b, 0x39, 0xc5, 0xba, 0x92, 0x78, 0x05, 0x23, 0x63,
+	0x45, 0x65, 0x6f, 0x55, 0x4e, 0xae, 0x7a, 0xbc, 0x98, 0xb1, 0xe6, 0x12, 0x6c, 0x77, 0x09, 0x76,
+	0xbb, 0xbb, 0x04, 0xbf, 0x83, 0xf1, 0x23, 0x0c, 0xa9, 0x90, 0xce, 0xeb, 0x3d, 0xe8, 0xed, 0x50,
+	0xfc, 0x04, 0xa7, 0x22, 0x2b, 0x53, 0xd1, 0x8c, 0x66, 0xfc, 0xfe, 0xbc, 0x1f, 0x8c, 0x17, 0x2f,
+	0x8f, 0x4f, 0xce, 0x0f, 0x58, 0xbc, 0x82, 0x71, 0x44, 0x76, 0xaf, 0x0e, 0xfe, 0xab, 0xde, 0x47,
+	0xf1, 0x02, 0xa6, 0xae, 0xe9, 0x7a, 0x6b, 0xc9, 0xdc, 0x58, 0x91, 0x90, 0xf4, 0x1f, 0xcf, 0xbd,
+	0x60, 0xc0, 0x3b, 0x39, 0x06, 0xf0, 0xb4, 0x56, 0xef, 0xa3, 0x27, 0x0e, 0xfd, 0x3b, 0xc6, 0x4b,
+	0x98, 0x38, 0xfb, 0x47, 0xb3, 0x38, 0xe3, 0x0f, 0xdd, 0x44, 0x13, 0x56, 0xaf, 0x93, 0xb5, 0x29,
+	0x3f, 0x64, 0xf0, 0x03, 0x9c, 0xd6, 0x3d, 0x7b, 0xe7, 0xc9, 0x31, 0xe7, 0x00, 0xc1, 0x77, 0x30,
+	0xda, 0x3d, 0x18, 0xe3, 0x8f, 0x1c, 0x7f, 0xd6, 0xf0, 0xcb, 0x36, 0xe6, 0x77, 0xc0, 0xc5, 0x2f,
+	0x0f, 0x9e, 0x75, 0xf6, 0x8f, 0xaf, 0xc1, 0xef, 0x84, 0x9f, 0x69, 0x25, 0x36, 0x99, 0x9d, 0x3e,
+	0x3a, 0x7a, 0xba, 0xac, 0x48, 0x58, 0x92, 0x53, 0x0f, 0xdf, 0xc0, 0xab, 0xce, 0xe9, 0x77, 0x2d,
+	0xd5, 0x4a, 0x91, 0x9c, 0xf6, 0xfe, 0x51, 0x9d, 0x51, 0x2d, 0xf7, 0xaf, 0x17, 0x3f, 0xdf, 0x27,
+	0xca, 0xa6, 0x9b, 0x88, 0xc5, 0x3a, 0x0f, 0xb9, 0x5e, 0x6f, 0xbf, 0x54, 0x2a, 0x5e, 0x1b, 0x5d,
+	0x84, 0x52, 0x97, 0x25, 0x65, 0x49, 0xed, 0x54, 0x61, 0xb9, 0x4e, 0xc2, 0x76, 0x93, 0xd1, 0x89,
+	0x7b, 0x41, 0x97, 0x7f, 0x06, 0x00, 0x16, 0xc2, 0x8c, 0x40, 0x6e, 0x03, 0x00, 0x00,
+}
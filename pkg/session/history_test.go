@@ -0,0 +1,149 @@
+package session
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+)
+
+func TestHistoryChangesDirectoryDeletion(t *testing.T) {
+	directory := &sync.Entry{
+		Kind: sync.EntryKind_Directory,
+		Contents: map[string]*sync.Entry{
+			"file": {Kind: sync.EntryKind_File, Digest: []byte{0}},
+		},
+	}
+	transitions := []*sync.Change{{Path: "directory", Old: directory}}
+
+	changes := historyChanges(transitions, []*sync.Entry{nil})
+	if len(changes) != 2 {
+		t.Fatal("unexpected number of changes:", len(changes))
+	}
+	if changes[0].Path != "directory" || changes[0].Kind != HistoryChangeKind_HistoryChangeKindDeleted {
+		t.Error("directory deletion not recorded")
+	}
+	if changes[1].Path != "directory/file" || changes[1].Kind != HistoryChangeKind_HistoryChangeKindDeleted {
+		t.Error("nested file deletion not recorded")
+	}
+}
+
+func TestHistoryChangesFileModification(t *testing.T) {
+	transitions := []*sync.Change{{
+		Path: "file",
+		Old:  &sync.Entry{Kind: sync.EntryKind_File, Digest: []byte{0}},
+		New:  &sync.Entry{Kind: sync.EntryKind_File, Digest: []byte{1}},
+	}}
+	results := []*sync.Entry{{Kind: sync.EntryKind_File, Digest: []byte{1}}}
+
+	changes := historyChanges(transitions, results)
+	if len(changes) != 1 {
+		t.Fatal("unexpected number of changes:", len(changes))
+	}
+	if changes[0].Kind != HistoryChangeKind_HistoryChangeKindModified {
+		t.Error("file modification not recorded")
+	}
+}
+
+func TestHistoryRecordFilter(t *testing.T) {
+	record := &HistoryRecord{
+		AlphaChanges: []*HistoryChange{
+			{Path: "a/b.txt", Kind: HistoryChangeKind_HistoryChangeKindCreated},
+			{Path: "c.go"},
+		},
+	}
+
+	if filtered := record.Filter("**/*.txt"); filtered == nil {
+		t.Fatal("filter removed matching record")
+	} else if len(filtered.AlphaChanges) != 1 || filtered.AlphaChanges[0].Path != "a/b.txt" {
+		t.Error("filter returned incorrect changes")
+	}
+
+	if record.Filter("*.md") != nil {
+		t.Error("filter retained non-matching record")
+	}
+}
+
+func TestHistoryRotation(t *testing.T) {
+	directory, err := ioutil.TempDir("", "doppelganger_history")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(directory)
+	path := filepath.Join(directory, "history")
+
+	now := ptypes.TimestampNow()
+	problem := &sync.Problem{Path: "file", Error: strings.Repeat("x", 64*1024)}
+	count := maximumHistorySize/len(problem.Error) + 2
+	for i := 0; i < count; i++ {
+		record := &HistoryRecord{StartTime: now, EndTime: now, AlphaProblems: []*sync.Problem{problem}}
+		if err := appendHistoryRecord(path, record); err != nil {
+			t.Fatal("unable to append history record:", err)
+		}
+	}
+
+	if _, err := os.Stat(path + rotatedHistorySuffix); err != nil {
+		t.Error("history not rotated:", err)
+	}
+	if records, err := readHistory(path); err != nil {
+		t.Fatal("unable to read history:", err)
+	} else if len(records) != count {
+		t.Error("unexpected number of history records:", len(records))
+	}
+
+	if err := removeHistory(path); err != nil {
+		t.Fatal("unable to remove history:", err)
+	}
+	if records, err := readHistory(path); err != nil {
+		t.Fatal("unable to read removed history:", err)
+	} else if len(records) != 0 {
+		t.Error("history records remain after removal")
+	}
+}
+
+func TestHistoryTruncatedRecordIgnored(t *testing.T) {
+	directory, err := ioutil.TempDir("", "doppelganger_history")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(directory)
+	path := filepath.Join(directory, "history")
+
+	now := ptypes.TimestampNow()
+	for i := 0; i < 2; i++ {
+		record := &HistoryRecord{
+			StartTime:    now,
+			EndTime:      now,
+			AlphaChanges: []*HistoryChange{{Path: "file", Kind: HistoryChangeKind_HistoryChangeKindCreated}},
+		}
+		if err := appendHistoryRecord(path, record); err != nil {
+			t.Fatal("unable to append history record:", err)
+		}
+	}
+
+	if info, err := os.Stat(path); err != nil {
+		t.Fatal("unable to query history:", err)
+	} else if err = os.Truncate(path, info.Size()-1); err != nil {
+		t.Fatal("unable to truncate history:", err)
+	}
+
+	if records, err := readHistory(path); err != nil {
+		t.Fatal("unable to read truncated history:", err)
+	} else if len(records) != 1 {
+		t.Error("unexpected number of history records:", len(records))
+	}
+}
+
+func TestHistoryRecordEmpty(t *testing.T) {
+	if !(&HistoryRecord{}).empty() {
+		t.Error("record without activity not considered empty")
+	}
+	if (&HistoryRecord{AlphaBytesStaged: 1}).empty() {
+		t.Error("record with staged bytes considered empty")
+	}
+}
@@ -2,25 +2,32 @@ package session
 
 import (
 	contextpkg "context"
+	"io"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/RokyErickson/doppelganger/pkg/filesystem"
+	"github.com/RokyErickson/doppelganger/pkg/selection"
 	"github.com/RokyErickson/doppelganger/pkg/state"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
 	"github.com/RokyErickson/doppelganger/pkg/url"
 )
 
 type Manager struct {
 	tracker      *state.Tracker
+	events       *eventHub
 	sessionsLock *state.TrackingLock
 	sessions     map[string]*controller
+	loadErrors   []error
 }
 
 func NewManager() (*Manager, error) {
 
 	tracker := state.NewTracker()
+	events := newEventHub()
 	sessionsLock := state.NewTrackingLock(tracker)
 	sessions := make(map[string]*controller)
 
@@ -32,22 +39,63 @@ func NewManager() (*Manager, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to read contents of sessions directory")
 	}
+	var loaded []*controller
 	for _, c := range sessionsDirectoryContents {
-		identifier := c.Name()
-		if controller, err := loadSession(tracker, identifier); err != nil {
+		if controller, err := loadSession(tracker, events, c.Name()); err != nil {
 			continue
 		} else {
-			sessions[identifier] = controller
+			loaded = append(loaded, controller)
 		}
 	}
 
+	sort.Slice(loaded, func(i, j int) bool {
+		iTime, jTime := loaded[i].session.CreationTime, loaded[j].session.CreationTime
+		if iTime.Seconds != jTime.Seconds {
+			return iTime.Seconds < jTime.Seconds
+		}
+		return iTime.Nanos < jTime.Nanos
+	})
+	loadErrors := clearDuplicateNames(loaded)
+	for _, controller := range loaded {
+		sessions[controller.session.Identifier] = controller
+	}
+
 	return &Manager{
 		tracker:      tracker,
+		events:       events,
 		sessionsLock: sessionsLock,
 		sessions:     sessions,
+		loadErrors:   loadErrors,
 	}, nil
 }
 
+func clearDuplicateNames(controllers []*controller) []error {
+	var result []error
+
+	names := make(map[string]bool, len(controllers))
+	for _, controller := range controllers {
+		name := controller.session.Name
+		if name == "" {
+			continue
+		} else if !names[name] {
+			names[name] = true
+			continue
+		}
+
+		if err := controller.clearName(); err != nil {
+			result = append(result, errors.Wrapf(err, "unable to clear duplicate name (%s) of session %s", name, controller.session.Identifier))
+		} else {
+			result = append(result, errors.Errorf("cleared duplicate name (%s) of session %s", name, controller.session.Identifier))
+		}
+	}
+
+	return result
+}
+
+func (m *Manager) LoadErrors() []error {
+	return m.loadErrors
+}
+
 func (m *Manager) allControllers() []*controller {
 	m.sessionsLock.Lock()
 	defer m.sessionsLock.UnlockWithoutNotify()
@@ -60,6 +108,22 @@ func (m *Manager) allControllers() []*controller {
 	return controllers
 }
 
+func (m *Manager) nameInUse(name string) bool {
+	m.sessionsLock.Lock()
+	defer m.sessionsLock.UnlockWithoutNotify()
+
+	return m.nameInUseLocked(name)
+}
+
+func (m *Manager) nameInUseLocked(name string) bool {
+	for _, controller := range m.sessions {
+		if controller.session.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 const (
 	minimumSessionSpecificationLength = 5
 )
@@ -72,19 +136,28 @@ func (m *Manager) findControllers(specifications []string) ([]*controller, error
 	for _, specification := range specifications {
 		if specification == "" {
 			return nil, errors.New("empty session specification is invalid")
-		} else if len(specification) < minimumSessionSpecificationLength {
-			return nil, errors.Errorf(
-				"session specification must be at least %d characters",
-				minimumSessionSpecificationLength,
-			)
 		}
 
 		var match *controller
 		for _, controller := range m.sessions {
-			if controller.session.Identifier == specification {
+			if controller.session.Identifier == specification || controller.session.Name == specification {
 				match = controller
 				break
 			}
+		}
+		if match != nil {
+			controllers = append(controllers, match)
+			continue
+		}
+
+		if len(specification) < minimumSessionSpecificationLength {
+			return nil, errors.Errorf(
+				"session specification must be at least %d characters",
+				minimumSessionSpecificationLength,
+			)
+		}
+
+		for _, controller := range m.sessions {
 			fuzzy := strings.HasPrefix(controller.session.Identifier, specification) ||
 				strings.Contains(controller.session.Alpha.Path, specification) ||
 				strings.Contains(controller.session.Beta.Path, specification) ||
@@ -105,9 +178,35 @@ func (m *Manager) findControllers(specifications []string) ([]*controller, error
 	return controllers, nil
 }
 
+func (m *Manager) selectControllers(specifications []string, labelSelector string) ([]*controller, error) {
+	if labelSelector == "" {
+		if len(specifications) == 0 {
+			return m.allControllers(), nil
+		}
+		return m.findControllers(specifications)
+	} else if len(specifications) > 0 {
+		return nil, errors.New("session specifications and label selector both provided")
+	}
+
+	selector, err := selection.ParseLabelSelector(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var controllers []*controller
+	for _, controller := range m.allControllers() {
+		if selector.Matches(controller.session.Labels) {
+			controllers = append(controllers, controller)
+		}
+	}
+
+	return controllers, nil
+}
+
 func (m *Manager) Shutdown() {
 
 	m.tracker.Poison()
+	m.events.close()
 	m.sessionsLock.Lock()
 	defer m.sessionsLock.UnlockWithoutNotify()
 	for _, controller := range m.sessions {
@@ -120,12 +219,25 @@ func (m *Manager) Shutdown() {
 func (m *Manager) Create(
 	alpha, beta *url.URL,
 	configuration, configurationAlpha, configurationBeta *Configuration,
+	name string,
+	labels map[string]string,
 	prompter string,
 ) (string, error) {
+	if name != "" {
+		if err := EnsureNameValid(name); err != nil {
+			return "", errors.Wrap(err, "invalid session name")
+		} else if m.nameInUse(name) {
+			return "", errors.Errorf("session name already in use: %s", name)
+		}
+	}
+
 	controller, err := newSession(
 		m.tracker,
+		m.events,
 		alpha, beta,
 		configuration, configurationAlpha, configurationBeta,
+		name,
+		labels,
 		prompter,
 	)
 	if err != nil {
@@ -133,25 +245,26 @@ func (m *Manager) Create(
 	}
 
 	m.sessionsLock.Lock()
+	if name != "" && m.nameInUseLocked(name) {
+		m.sessionsLock.UnlockWithoutNotify()
+		controller.halt(haltModeTerminate, "")
+		return "", errors.Errorf("session name already in use: %s", name)
+	}
 	m.sessions[controller.session.Identifier] = controller
 	m.sessionsLock.Unlock()
 
 	return controller.session.Identifier, nil
 }
 
-func (m *Manager) List(previousStateIndex uint64, specifications []string) (uint64, []*State, error) {
+func (m *Manager) List(previousStateIndex uint64, specifications []string, labelSelector string) (uint64, []*State, error) {
 	stateIndex, poisoned := m.tracker.WaitForChange(previousStateIndex)
 	if poisoned {
 		return 0, nil, errors.New("state tracking terminated")
 	}
 
-	var controllers []*controller
-	if len(specifications) == 0 {
-		controllers = m.allControllers()
-	} else if cs, err := m.findControllers(specifications); err != nil {
+	controllers, err := m.selectControllers(specifications, labelSelector)
+	if err != nil {
 		return 0, nil, errors.Wrap(err, "unable to locate requested sessions")
-	} else {
-		controllers = cs
 	}
 
 	states := make([]*State, len(controllers))
@@ -169,15 +282,11 @@ func (m *Manager) List(previousStateIndex uint64, specifications []string) (uint
 	return stateIndex, states, nil
 }
 
-func (m *Manager) Flush(specifications []string, prompter string, skipWait bool, context contextpkg.Context) error {
+func (m *Manager) Flush(specifications []string, labelSelector string, prompter string, skipWait bool, context contextpkg.Context) error {
 
-	var controllers []*controller
-	if len(specifications) == 0 {
-		controllers = m.allControllers()
-	} else if cs, err := m.findControllers(specifications); err != nil {
+	controllers, err := m.selectControllers(specifications, labelSelector)
+	if err != nil {
 		return errors.Wrap(err, "unable to locate requested sessions")
-	} else {
-		controllers = cs
 	}
 
 	for _, controller := range controllers {
@@ -189,15 +298,11 @@ func (m *Manager) Flush(specifications []string, prompter string, skipWait bool,
 	return nil
 }
 
-func (m *Manager) Pause(specifications []string, prompter string) error {
+func (m *Manager) Pause(specifications []string, labelSelector string, prompter string) error {
 
-	var controllers []*controller
-	if len(specifications) == 0 {
-		controllers = m.allControllers()
-	} else if cs, err := m.findControllers(specifications); err != nil {
+	controllers, err := m.selectControllers(specifications, labelSelector)
+	if err != nil {
 		return errors.Wrap(err, "unable to locate requested sessions")
-	} else {
-		controllers = cs
 	}
 
 	for _, controller := range controllers {
@@ -209,15 +314,11 @@ func (m *Manager) Pause(specifications []string, prompter string) error {
 	return nil
 }
 
-func (m *Manager) Resume(specifications []string, prompter string) error {
+func (m *Manager) Resume(specifications []string, labelSelector string, prompter string) error {
 
-	var controllers []*controller
-	if len(specifications) == 0 {
-		controllers = m.allControllers()
-	} else if cs, err := m.findControllers(specifications); err != nil {
+	controllers, err := m.selectControllers(specifications, labelSelector)
+	if err != nil {
 		return errors.Wrap(err, "unable to locate requested sessions")
-	} else {
-		controllers = cs
 	}
 
 	for _, controller := range controllers {
@@ -229,15 +330,11 @@ func (m *Manager) Resume(specifications []string, prompter string) error {
 	return nil
 }
 
-func (m *Manager) Terminate(specifications []string, prompter string) error {
+func (m *Manager) Terminate(specifications []string, labelSelector string, prompter string) error {
 
-	var controllers []*controller
-	if len(specifications) == 0 {
-		controllers = m.allControllers()
-	} else if cs, err := m.findControllers(specifications); err != nil {
+	controllers, err := m.selectControllers(specifications, labelSelector)
+	if err != nil {
 		return errors.Wrap(err, "unable to locate requested sessions")
-	} else {
-		controllers = cs
 	}
 
 	for _, controller := range controllers {
@@ -251,3 +348,141 @@ func (m *Manager) Terminate(specifications []string, prompter string) error {
 
 	return nil
 }
+
+func (m *Manager) Resolve(specification, path string, winner sync.ConflictWinner, prompter string) error {
+
+	controllers, err := m.findControllers([]string{specification})
+	if err != nil {
+		return errors.Wrap(err, "unable to locate requested session")
+	}
+
+	if err := controllers[0].resolve(path, winner, prompter); err != nil {
+		return errors.Wrap(err, "unable to resolve conflict")
+	}
+
+	return nil
+}
+
+func (m *Manager) Acknowledge(specification string, acknowledgement HaltAcknowledgement, prompter string) error {
+
+	controllers, err := m.findControllers([]string{specification})
+	if err != nil {
+		return errors.Wrap(err, "unable to locate requested session")
+	}
+
+	if err := controllers[0].acknowledge(acknowledgement, prompter); err != nil {
+		return errors.Wrap(err, "unable to acknowledge halt")
+	}
+
+	return nil
+}
+
+func (m *Manager) Plan(specification string, synchronizationMode sync.SynchronizationMode, prompter string) (*Plan, error) {
+
+	controllers, err := m.findControllers([]string{specification})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to locate requested session")
+	}
+
+	plan, err := controllers[0].plan(synchronizationMode, prompter)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to plan synchronization")
+	}
+
+	return plan, nil
+}
+
+func (m *Manager) Update(
+	specification string,
+	configuration, configurationAlpha, configurationBeta *Configuration,
+	fields, fieldsAlpha, fieldsBeta []string,
+	prompter string,
+) error {
+
+	controllers, err := m.findControllers([]string{specification})
+	if err != nil {
+		return errors.Wrap(err, "unable to locate requested session")
+	}
+
+	if err := controllers[0].update(configuration, configurationAlpha, configurationBeta, fields, fieldsAlpha, fieldsBeta, prompter); err != nil {
+		return errors.Wrap(err, "unable to update session")
+	}
+
+	return nil
+}
+
+func (m *Manager) History(specification, pattern string) ([]*HistoryRecord, error) {
+	controllers, err := m.findControllers([]string{specification})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to locate requested session")
+	}
+
+	records, err := controllers[0].history(pattern)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read synchronization history")
+	}
+
+	return records, nil
+}
+
+func (m *Manager) Events(context contextpkg.Context, specifications []string, handler func(*Event) error) error {
+	subscription := m.events.subscribe()
+	defer m.events.unsubscribe(subscription)
+
+	var identifiers map[string]bool
+	if len(specifications) > 0 {
+		controllers, err := m.findControllers(specifications)
+		if err != nil {
+			return errors.Wrap(err, "unable to locate requested sessions")
+		}
+		identifiers = make(map[string]bool, len(controllers))
+		for _, controller := range controllers {
+			identifiers[controller.session.Identifier] = true
+		}
+	}
+
+	for {
+		select {
+		case event, ok := <-subscription.events:
+			if !ok {
+				if subscription.overflowed {
+					return errors.New("event stream overflowed because events weren't consumed quickly enough")
+				}
+				return errors.New("event stream terminated")
+			}
+			if identifiers != nil && !identifiers[event.Session] {
+				continue
+			}
+			if err := handler(event); err != nil {
+				return err
+			}
+		case <-context.Done():
+			return errors.New("event stream cancelled")
+		}
+	}
+}
+
+func (m *Manager) WriteMetrics(writer io.Writer) error {
+	controllers := m.allControllers()
+	sort.Slice(controllers, func(i, j int) bool {
+		return controllers[i].session.Identifier < controllers[j].session.Identifier
+	})
+
+	identifiers := make([]string, len(controllers))
+	snapshots := make([]*sessionMetrics, len(controllers))
+	for i, controller := range controllers {
+		identifiers[i] = controller.session.Identifier
+		snapshots[i] = controller.currentMetrics()
+	}
+
+	return writeMetricFamilies(writer, sessionMetricFamilies(identifiers, snapshots, time.Now()))
+}
+
+func (m *Manager) Session(specification string) (*Session, error) {
+	controllers, err := m.findControllers([]string{specification})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to locate requested session")
+	}
+
+	return controllers[0].currentState().Session, nil
+}
@@ -0,0 +1,211 @@
+package session
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type sessionMetrics struct {
+	cycles           uint64
+	lastCycleTime    time.Time
+	status           Status
+	statusChangeTime time.Time
+	phaseDurations   map[Status]time.Duration
+	alphaBytesStaged uint64
+	betaBytesStaged  uint64
+	alphaEntries     uint64
+	betaEntries      uint64
+	alphaProblems    uint64
+	betaProblems     uint64
+	conflicts        uint64
+	alphaReconnects  uint64
+	betaReconnects   uint64
+	lastErrorTime    time.Time
+}
+
+func (m *sessionMetrics) observe(previous, current *State, now time.Time) {
+	if m.statusChangeTime.IsZero() {
+		m.statusChangeTime = now
+	} else if current.Status != previous.Status {
+		if m.phaseDurations == nil {
+			m.phaseDurations = make(map[Status]time.Duration)
+		}
+		m.phaseDurations[previous.Status] += now.Sub(m.statusChangeTime)
+		m.statusChangeTime = now
+	}
+	m.status = current.Status
+
+	if current.LastError != "" && current.LastError != previous.LastError {
+		m.lastErrorTime = now
+	}
+
+	m.alphaProblems = uint64(len(current.AlphaProblems))
+	m.betaProblems = uint64(len(current.BetaProblems))
+	m.conflicts = uint64(len(current.Conflicts))
+}
+
+func (m *sessionMetrics) copy() *sessionMetrics {
+	result := *m
+	result.phaseDurations = make(map[Status]time.Duration, len(m.phaseDurations))
+	for status, duration := range m.phaseDurations {
+		result.phaseDurations[status] = duration
+	}
+	return &result
+}
+
+type metricSample struct {
+	labels string
+	value  float64
+}
+
+type metricFamily struct {
+	name    string
+	kind    string
+	help    string
+	samples []metricSample
+}
+
+func metricLabels(session string, pairs ...string) string {
+	labels := []string{fmt.Sprintf("session=\"%s\"", session)}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		labels = append(labels, fmt.Sprintf("%s=\"%s\"", pairs[i], pairs[i+1]))
+	}
+	return "{" + strings.Join(labels, ",") + "}"
+}
+
+func timestampSeconds(t time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+func sessionMetricFamilies(identifiers []string, snapshots []*sessionMetrics, now time.Time) []*metricFamily {
+	cycles := &metricFamily{
+		name: "doppelganger_session_cycles_total",
+		kind: "counter",
+		help: "Number of completed synchronization cycles.",
+	}
+	lastCycle := &metricFamily{
+		name: "doppelganger_session_last_cycle_timestamp_seconds",
+		kind: "gauge",
+		help: "Time at which the last synchronization cycle completed.",
+	}
+	phases := &metricFamily{
+		name: "doppelganger_session_phase_seconds_total",
+		kind: "counter",
+		help: "Time spent in each synchronization phase.",
+	}
+	status := &metricFamily{
+		name: "doppelganger_session_status_seconds",
+		kind: "gauge",
+		help: "Time spent in the current synchronization phase.",
+	}
+	staged := &metricFamily{
+		name: "doppelganger_session_staged_bytes_total",
+		kind: "counter",
+		help: "Number of bytes transmitted to an endpoint for staging.",
+	}
+	entries := &metricFamily{
+		name: "doppelganger_session_entries",
+		kind: "gauge",
+		help: "Number of entries found by the last scan of an endpoint.",
+	}
+	conflicts := &metricFamily{
+		name: "doppelganger_session_conflicts",
+		kind: "gauge",
+		help: "Number of unresolved conflicts.",
+	}
+	problems := &metricFamily{
+		name: "doppelganger_session_problems",
+		kind: "gauge",
+		help: "Number of problems encountered applying changes to an endpoint.",
+	}
+	reconnects := &metricFamily{
+		name: "doppelganger_session_reconnect_attempts_total",
+		kind: "counter",
+		help: "Number of attempts made to connect to an endpoint.",
+	}
+	lastError := &metricFamily{
+		name: "doppelganger_session_last_error_timestamp_seconds",
+		kind: "gauge",
+		help: "Time at which the last synchronization error occurred.",
+	}
+
+	for i, session := range identifiers {
+		m := snapshots[i]
+
+		cycles.samples = append(cycles.samples, metricSample{metricLabels(session), float64(m.cycles)})
+		lastCycle.samples = append(lastCycle.samples, metricSample{metricLabels(session), timestampSeconds(m.lastCycleTime)})
+
+		phaseDurations := make(map[Status]time.Duration, len(m.phaseDurations)+1)
+		for s, d := range m.phaseDurations {
+			phaseDurations[s] = d
+		}
+		var elapsed time.Duration
+		if !m.statusChangeTime.IsZero() {
+			elapsed = now.Sub(m.statusChangeTime)
+			phaseDurations[m.status] += elapsed
+		}
+		var statuses []Status
+		for s := range phaseDurations {
+			statuses = append(statuses, s)
+		}
+		sort.Slice(statuses, func(i, j int) bool {
+			return statuses[i] < statuses[j]
+		})
+		for _, s := range statuses {
+			phases.samples = append(phases.samples, metricSample{
+				metricLabels(session, "phase", s.String()),
+				phaseDurations[s].Seconds(),
+			})
+		}
+		status.samples = append(status.samples, metricSample{
+			metricLabels(session, "status", m.status.String()),
+			elapsed.Seconds(),
+		})
+
+		staged.samples = append(staged.samples,
+			metricSample{metricLabels(session, "endpoint", "alpha"), float64(m.alphaBytesStaged)},
+			metricSample{metricLabels(session, "endpoint", "beta"), float64(m.betaBytesStaged)},
+		)
+		entries.samples = append(entries.samples,
+			metricSample{metricLabels(session, "endpoint", "alpha"), float64(m.alphaEntries)},
+			metricSample{metricLabels(session, "endpoint", "beta"), float64(m.betaEntries)},
+		)
+		conflicts.samples = append(conflicts.samples, metricSample{metricLabels(session), float64(m.conflicts)})
+		problems.samples = append(problems.samples,
+			metricSample{metricLabels(session, "endpoint", "alpha"), float64(m.alphaProblems)},
+			metricSample{metricLabels(session, "endpoint", "beta"), float64(m.betaProblems)},
+		)
+		reconnects.samples = append(reconnects.samples,
+			metricSample{metricLabels(session, "endpoint", "alpha"), float64(m.alphaReconnects)},
+			metricSample{metricLabels(session, "endpoint", "beta"), float64(m.betaReconnects)},
+		)
+		lastError.samples = append(lastError.samples, metricSample{metricLabels(session), timestampSeconds(m.lastErrorTime)})
+	}
+
+	return []*metricFamily{
+		cycles, lastCycle, phases, status, staged, entries, conflicts, problems, reconnects, lastError,
+	}
+}
+
+func writeMetricFamilies(writer io.Writer, families []*metricFamily) error {
+	for _, f := range families {
+		if _, err := fmt.Fprintf(writer, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.kind); err != nil {
+			return errors.Wrap(err, "unable to write metric header")
+		}
+		for _, s := range f.samples {
+			if _, err := fmt.Fprintf(writer, "%s%s %g\n", f.name, s.labels, s.value); err != nil {
+				return errors.Wrap(err, "unable to write metric sample")
+			}
+		}
+	}
+
+	return nil
+}
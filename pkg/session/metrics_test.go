@@ -0,0 +1,57 @@
+package session
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSessionMetricsObserve(t *testing.T) {
+	m := &sessionMetrics{}
+	start := time.Now()
+
+	m.observe(&State{}, &State{Status: Status_Scanning}, start)
+	m.observe(&State{Status: Status_Scanning}, &State{Status: Status_Reconciling}, start.Add(3*time.Second))
+	m.observe(&State{Status: Status_Reconciling}, &State{Status: Status_Reconciling, LastError: "error"}, start.Add(4*time.Second))
+
+	if m.phaseDurations[Status_Scanning] != 3*time.Second {
+		t.Error("unexpected scanning duration:", m.phaseDurations[Status_Scanning])
+	}
+	if m.status != Status_Reconciling {
+		t.Error("unexpected current status:", m.status)
+	}
+	if !m.lastErrorTime.Equal(start.Add(4 * time.Second)) {
+		t.Error("last error time not recorded")
+	}
+}
+
+func TestWriteMetricFamilies(t *testing.T) {
+	now := time.Now()
+	m := &sessionMetrics{
+		cycles:           2,
+		status:           Status_Watching,
+		statusChangeTime: now.Add(-time.Minute),
+		alphaBytesStaged: 1024,
+	}
+
+	buffer := &bytes.Buffer{}
+	families := sessionMetricFamilies([]string{"identifier"}, []*sessionMetrics{m}, now)
+	if err := writeMetricFamilies(buffer, families); err != nil {
+		t.Fatal("unable to write metrics:", err)
+	}
+	output := buffer.String()
+
+	expected := []string{
+		"# TYPE doppelganger_session_cycles_total counter\n",
+		"doppelganger_session_cycles_total{session=\"identifier\"} 2\n",
+		"doppelganger_session_phase_seconds_total{session=\"identifier\",phase=\"Watching\"} 60\n",
+		"doppelganger_session_status_seconds{session=\"identifier\",status=\"Watching\"} 60\n",
+		"doppelganger_session_staged_bytes_total{session=\"identifier\",endpoint=\"alpha\"} 1024\n",
+	}
+	for _, e := range expected {
+		if !strings.Contains(output, e) {
+			t.Error("metrics output missing line:", e)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package session
+
+import (
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	maximumNameLength = 63
+)
+
+var nameMatcher = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+func EnsureNameValid(name string) error {
+	if name == "" {
+		return errors.New("empty name")
+	} else if len(name) > maximumNameLength {
+		return errors.Errorf("name exceeds %d characters", maximumNameLength)
+	} else if !nameMatcher.MatchString(name) {
+		return errors.Errorf("name contains invalid characters or does not start with a letter: %s", name)
+	} else if _, err := uuid.Parse(name); err == nil {
+		return errors.New("name is a valid session identifier")
+	}
+
+	return nil
+}
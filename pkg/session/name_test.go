@@ -0,0 +1,69 @@
+package session
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/RokyErickson/doppelganger/pkg/state"
+)
+
+func TestEnsureNameValid(t *testing.T) {
+	valid := []string{"web", "Project_1", "api-server"}
+	for _, name := range valid {
+		if err := EnsureNameValid(name); err != nil {
+			t.Error("valid name rejected:", name, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"1web",
+		"-web",
+		"web server",
+		"web/server",
+		"abcdefab-1234-4321-abcd-0123456789ab",
+		"a123456789012345678901234567890123456789012345678901234567890123",
+	}
+	for _, name := range invalid {
+		if EnsureNameValid(name) == nil {
+			t.Error("invalid name accepted:", name)
+		}
+	}
+}
+
+func TestClearDuplicateNames(t *testing.T) {
+	directory, err := ioutil.TempDir("", "doppelganger_session")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(directory)
+
+	tracker := state.NewTracker()
+	var controllers []*controller
+	for i, name := range []string{"web", "api", "web"} {
+		controllers = append(controllers, &controller{
+			sessionPath: filepath.Join(directory, fmt.Sprintf("session%d", i)),
+			stateLock:   state.NewTrackingLock(tracker),
+			events:      newEventHub(),
+			session:     &Session{Identifier: fmt.Sprintf("session%d", i), Name: name},
+			state:       &State{},
+		})
+	}
+
+	errs := clearDuplicateNames(controllers)
+	if len(errs) != 1 {
+		t.Fatal("unexpected number of errors:", len(errs))
+	} else if !strings.Contains(errs[0].Error(), "session2") {
+		t.Error("error doesn't identify renamed session:", errs[0])
+	}
+	if controllers[0].session.Name != "web" || controllers[1].session.Name != "api" {
+		t.Error("unique names cleared")
+	}
+	if controllers[2].session.Name != "" {
+		t.Error("duplicate name not cleared")
+	}
+}
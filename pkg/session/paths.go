@@ -9,8 +9,9 @@ import (
 )
 
 const (
-	sessionsDirectoryName = "sessions"
-	archivesDirectoryName = "archives"
+	sessionsDirectoryName  = "sessions"
+	archivesDirectoryName  = "archives"
+	historiesDirectoryName = "histories"
 )
 
 func pathForSession(sessionIdentifier string) (string, error) {
@@ -30,3 +31,12 @@ func pathForArchive(session string) (string, error) {
 
 	return filepath.Join(archivesDirectoryPath, session), nil
 }
+
+func pathForHistory(session string) (string, error) {
+	historiesDirectoryPath, err := filesystem.Doppelganger(true, historiesDirectoryName)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to compute/create histories directory")
+	}
+
+	return filepath.Join(historiesDirectoryPath, session), nil
+}
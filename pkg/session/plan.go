@@ -0,0 +1,31 @@
+package session
+
+import (
+	"github.com/pkg/errors"
+)
+
+func (p *Plan) EnsureValid() error {
+	if p == nil {
+		return errors.New("nil plan")
+	}
+
+	for _, c := range p.AlphaTransitions {
+		if err := c.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid alpha transition detected")
+		}
+	}
+
+	for _, c := range p.BetaTransitions {
+		if err := c.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid beta transition detected")
+		}
+	}
+
+	for _, c := range p.Conflicts {
+		if err := c.EnsureValid(); err != nil {
+			return errors.Wrap(err, "invalid conflict detected")
+		}
+	}
+
+	return nil
+}
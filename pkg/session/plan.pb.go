@@ -0,0 +1,91 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: session/plan.proto
+
+package session // import "github.com/RokyErickson/doppelganger/pkg/session"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import sync "github.com/RokyErickson/doppelganger/pkg/sync"
+
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+const _ = proto.ProtoPackageIsVersion2
+
+type Plan struct {
+	AlphaTransitions     []*sync.Change   `protobuf:"bytes,1,rep,name=alphaTransitions,proto3" json:"alphaTransitions,omitempty"`
+	BetaTransitions      []*sync.Change   `protobuf:"bytes,2,rep,name=betaTransitions,proto3" json:"betaTransitions,omitempty"`
+	Conflicts            []*sync.Conflict `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Plan) Reset()         { *m = Plan{} }
+func (m *Plan) String() string { return proto.CompactTextString(m) }
+func (*Plan) ProtoMessage()    {}
+func (*Plan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_plan_e560d0eb61b8db36, []int{0}
+}
+func (m *Plan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Plan.Unmarshal(m, b)
+}
+func (m *Plan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Plan.Marshal(b, m, deterministic)
+}
+func (dst *Plan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Plan.Merge(dst, src)
+}
+func (m *Plan) XXX_Size() int {
+	return xxx_messageInfo_Plan.Size(m)
+}
+func (m *Plan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Plan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Plan proto.InternalMessageInfo
+
+func (m *Plan) GetAlphaTransitions() []*sync.Change {
+	if m != nil {
+		return m.AlphaTransitions
+	}
+	return nil
+}
+
+func (m *Plan) GetBetaTransitions() []*sync.Change {
+	if m != nil {
+		return m.BetaTransitions
+	}
+	return nil
+}
+
+func (m *Plan) GetConflicts() []*sync.Conflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Plan)(nil), "session.Plan")
+}
+
+func init() { proto.RegisterFile("session/plan.proto", fileDescriptor_plan_e560d0eb61b8db36) }
+
+var fileDescriptor_plan_e560d0eb61b8db36 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0x4e, 0x2d, 0x2e,
+	0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0xc8, 0x49, 0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
+	0x87, 0x8a, 0x49, 0x09, 0x16, 0x57, 0xe6, 0x25, 0xeb, 0x27, 0x67, 0x24, 0xe6, 0xa5, 0xa7, 0x42,
+	0xe4, 0xa4, 0x84, 0x21, 0x42, 0xf9, 0x79, 0x69, 0x39, 0x99, 0xc9, 0x25, 0x10, 0x41, 0xa5, 0x65,
+	0x8c, 0x5c, 0x2c, 0x01, 0x39, 0x89, 0x79, 0x42, 0x16, 0x5c, 0x02, 0x89, 0x39, 0x05, 0x19, 0x89,
+	0x21, 0x45, 0x89, 0x79, 0xc5, 0x99, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a,
+	0xdc, 0x46, 0x3c, 0x7a, 0x20, 0x8d, 0x7a, 0xce, 0x60, 0xb3, 0x82, 0x30, 0x54, 0x09, 0x99, 0x71,
+	0xf1, 0x27, 0xa5, 0x96, 0xa0, 0x68, 0x64, 0xc2, 0xa2, 0x11, 0x5d, 0x91, 0x90, 0x0e, 0x17, 0x27,
+	0xcc, 0x31, 0xc5, 0x12, 0xcc, 0x60, 0x1d, 0x7c, 0x50, 0x1d, 0x50, 0xe1, 0x20, 0x84, 0x02, 0x27,
+	0xa3, 0x28, 0x83, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xa0, 0xfc,
+	0xec, 0x4a, 0xd7, 0xa2, 0xcc, 0xe4, 0xec, 0xe2, 0xfc, 0x3c, 0xfd, 0x94, 0xfc, 0x82, 0x82, 0xd4,
+	0x9c, 0x74, 0x90, 0x2d, 0x45, 0xfa, 0x05, 0xd9, 0xe9, 0xfa, 0xd0, 0x40, 0x48, 0x62, 0x03, 0xfb,
+	0xd1, 0x18, 0x30, 0x00, 0x92, 0xa8, 0xff, 0xde, 0x2a, 0x01, 0x00, 0x00,
+}
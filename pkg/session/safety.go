@@ -14,6 +14,46 @@ func isRootTypeChange(change *sync.Change) bool {
 		change.Old.Kind != change.New.Kind
 }
 
+func rootHalt(alphaTransitions, betaTransitions []*sync.Change) (Status, bool, bool) {
+	for _, t := range alphaTransitions {
+		if isRootDeletion(t) {
+			return Status_HaltedOnRootDeletion, true, true
+		}
+	}
+	for _, t := range betaTransitions {
+		if isRootDeletion(t) {
+			return Status_HaltedOnRootDeletion, false, true
+		}
+	}
+	for _, t := range alphaTransitions {
+		if isRootTypeChange(t) {
+			return Status_HaltedOnRootTypeChange, true, true
+		}
+	}
+	for _, t := range betaTransitions {
+		if isRootTypeChange(t) {
+			return Status_HaltedOnRootTypeChange, false, true
+		}
+	}
+	return Status_Disconnected, false, false
+}
+
+func exceedsDeletionThreshold(configuration *Configuration, ancestor *sync.Entry, deletions uint64) bool {
+	if configuration.MassDeletionThresholdCount != 0 {
+		return deletions > configuration.MassDeletionThresholdCount
+	} else if configuration.MassDeletionThresholdPercentage != 0 && ancestor != nil {
+		return deletions*100 > uint64(configuration.MassDeletionThresholdPercentage)*ancestor.Count()
+	}
+	return false
+}
+
+func reseedTransitions(alpha, beta *sync.Entry, fromAlpha bool) ([]*sync.Change, []*sync.Change) {
+	if fromAlpha {
+		return nil, []*sync.Change{{Old: beta, New: alpha}}
+	}
+	return []*sync.Change{{Old: alpha, New: beta}}, nil
+}
+
 func filteredPathsAreSubset(filteredPaths, originalPaths []string) bool {
 
 	for _, filtered := range filteredPaths {
@@ -1,9 +1,47 @@
 package session
 
 import (
+	contextpkg "context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/RokyErickson/doppelganger/pkg/state"
+	"github.com/RokyErickson/doppelganger/pkg/sync"
+	"github.com/RokyErickson/doppelganger/pkg/url"
 )
 
+func TestRootHalt(t *testing.T) {
+	directory := &sync.Entry{Kind: sync.EntryKind_Directory}
+	file := &sync.Entry{Kind: sync.EntryKind_File}
+
+	if _, _, halt := rootHalt([]*sync.Change{{Path: "a", Old: file}}, nil); halt {
+		t.Error("halt detected for non-root deletion")
+	}
+
+	status, onAlpha, halt := rootHalt(nil, []*sync.Change{{Old: directory}})
+	if !halt || status != Status_HaltedOnRootDeletion || onAlpha {
+		t.Error("root deletion on beta not detected")
+	}
+
+	status, onAlpha, halt = rootHalt([]*sync.Change{{Old: directory, New: file}}, nil)
+	if !halt || status != Status_HaltedOnRootTypeChange || !onAlpha {
+		t.Error("root type change on alpha not detected")
+	}
+}
+
+func TestReseedTransitions(t *testing.T) {
+	directory := &sync.Entry{Kind: sync.EntryKind_Directory}
+
+	alphaTransitions, betaTransitions := reseedTransitions(directory, nil, true)
+	if len(alphaTransitions) != 0 || len(betaTransitions) != 1 {
+		t.Fatal("unexpected transitions for re-seed from alpha")
+	} else if betaTransitions[0].Old != nil || betaTransitions[0].New != directory {
+		t.Error("re-seed transition does not restore alpha contents")
+	}
+}
+
 func TestFilteredPathsAreSubset(t *testing.T) {
 	testCases := []struct {
 		filteredPaths []string
@@ -39,3 +77,90 @@ func TestFilteredPathsAreSubset(t *testing.T) {
 		}
 	}
 }
+
+func TestExceedsDeletionThreshold(t *testing.T) {
+	ancestor := &sync.Entry{
+		Kind: sync.EntryKind_Directory,
+		Contents: map[string]*sync.Entry{
+			"a": {Kind: sync.EntryKind_File},
+			"b": {Kind: sync.EntryKind_File},
+			"c": {Kind: sync.EntryKind_File},
+		},
+	}
+
+	if exceedsDeletionThreshold(&Configuration{}, ancestor, 4) {
+		t.Error("threshold exceeded without threshold configured")
+	}
+	if exceedsDeletionThreshold(&Configuration{MassDeletionThresholdCount: 2}, ancestor, 2) {
+		t.Error("count threshold exceeded at limit")
+	}
+	if !exceedsDeletionThreshold(&Configuration{MassDeletionThresholdCount: 2}, ancestor, 3) {
+		t.Error("count threshold not exceeded above limit")
+	}
+	if exceedsDeletionThreshold(&Configuration{MassDeletionThresholdPercentage: 50}, ancestor, 2) {
+		t.Error("percentage threshold exceeded at limit")
+	}
+	if !exceedsDeletionThreshold(&Configuration{MassDeletionThresholdPercentage: 50}, ancestor, 3) {
+		t.Error("percentage threshold not exceeded above limit")
+	}
+	if exceedsDeletionThreshold(&Configuration{MassDeletionThresholdPercentage: 50}, nil, 3) {
+		t.Error("percentage threshold exceeded without ancestor")
+	}
+}
+
+func TestHaltIgnoresStaleAcknowledgement(t *testing.T) {
+	controller := &controller{
+		stateLock:        state.NewTrackingLock(state.NewTracker()),
+		events:           newEventHub(),
+		session:          &Session{Identifier: "session"},
+		state:            &State{},
+		acknowledgements: make(chan HaltAcknowledgement, 1),
+	}
+	controller.state.Session = controller.session
+	controller.acknowledgements <- HaltAcknowledgement_HaltAcknowledgementPropagate
+
+	context, cancel := contextpkg.WithCancel(contextpkg.Background())
+	cancel()
+
+	if _, acknowledged := controller.haltUntilAcknowledged(context, Status_HaltedOnMassDeletion); acknowledged {
+		t.Error("halt confirmed by acknowledgement sent before halt")
+	}
+}
+
+func TestResumeClearsMassDeletionHalt(t *testing.T) {
+	directory, err := ioutil.TempDir("", "doppelganger_session")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(directory)
+
+	halted := make(chan struct{})
+	cancelled := false
+	controller := &controller{
+		sessionPath: filepath.Join(directory, "session"),
+		stateLock:   state.NewTrackingLock(state.NewTracker()),
+		events:      newEventHub(),
+		session: &Session{
+			Identifier: "session",
+			Alpha:      &url.URL{Protocol: url.Protocol(-1)},
+			Beta:       &url.URL{Protocol: url.Protocol(-1)},
+		},
+		state:  &State{Status: Status_HaltedOnMassDeletion},
+		cancel: func() { cancelled = true; close(halted) },
+		done:   halted,
+	}
+	controller.state.Session = controller.session
+
+	if controller.resume("") == nil {
+		t.Error("resume succeeded without endpoint protocol handlers")
+	}
+	if !cancelled {
+		t.Error("halted synchronization loop not cancelled by resume")
+	}
+	if controller.done == halted {
+		t.Error("synchronization loop not restarted by resume")
+	}
+
+	controller.cancel()
+	<-controller.done
+}
@@ -7,12 +7,13 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/RokyErickson/doppelganger/pkg/filesystem"
+	"github.com/RokyErickson/doppelganger/pkg/selection"
 	"github.com/RokyErickson/doppelganger/pkg/sync"
 )
 
 func (v Version) Supported() bool {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return true
 	default:
 		return false
@@ -21,7 +22,7 @@ func (v Version) Supported() bool {
 
 func (v Version) Hasher() hash.Hash {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return sha1.New()
 	default:
 		panic("unknown or unsupported session version")
@@ -30,16 +31,43 @@ func (v Version) Hasher() hash.Hash {
 
 func (v Version) DefaultSynchronizationMode() sync.SynchronizationMode {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return sync.SynchronizationMode_SynchronizationModeTwoWaySafe
 	default:
 		panic("unknown or unsupported session version")
 	}
 }
 
+func (v Version) DefaultDeletionMode() sync.DeletionMode {
+	switch v {
+	case Version_Version1, Version_Version2:
+		return sync.DeletionMode_DeletionModePermanent
+	default:
+		panic("unknown or unsupported session version")
+	}
+}
+
+func (v Version) DefaultVersioningMode() sync.VersioningMode {
+	switch v {
+	case Version_Version1, Version_Version2:
+		return sync.VersioningMode_VersioningModeDisabled
+	default:
+		panic("unknown or unsupported session version")
+	}
+}
+
+func (v Version) DefaultModificationTimesMode() sync.ModificationTimesMode {
+	switch v {
+	case Version_Version1, Version_Version2:
+		return sync.ModificationTimesMode_DiscardModificationTimes
+	default:
+		panic("unknown or unsupported session version")
+	}
+}
+
 func (v Version) DefaultSymlinkMode() sync.SymlinkMode {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return sync.SymlinkMode_SymlinkPortable
 	default:
 		panic("unknown or unsupported session version")
@@ -48,7 +76,7 @@ func (v Version) DefaultSymlinkMode() sync.SymlinkMode {
 
 func (v Version) DefaultWatchMode() filesystem.WatchMode {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return filesystem.WatchMode_WatchModePortable
 	default:
 		panic("unknown or unsupported session version")
@@ -57,7 +85,7 @@ func (v Version) DefaultWatchMode() filesystem.WatchMode {
 
 func (v Version) DefaultWatchPollingInterval() uint32 {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return 10
 	default:
 		panic("unknown or unsupported session version")
@@ -66,16 +94,34 @@ func (v Version) DefaultWatchPollingInterval() uint32 {
 
 func (v Version) DefaultIgnoreVCSMode() sync.IgnoreVCSMode {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return sync.IgnoreVCSMode_PropagateVCS
 	default:
 		panic("unknown or unsupported session version")
 	}
 }
 
+func (v Version) DefaultIgnoreFilesMode() sync.IgnoreFilesMode {
+	switch v {
+	case Version_Version1, Version_Version2:
+		return sync.IgnoreFilesMode_IgnoreFilesDisabled
+	default:
+		panic("unknown or unsupported session version")
+	}
+}
+
+func (v Version) DefaultPermissionsMode() sync.PermissionsMode {
+	switch v {
+	case Version_Version1, Version_Version2:
+		return sync.PermissionsMode_PermissionsPortable
+	default:
+		panic("unknown or unsupported session version")
+	}
+}
+
 func (v Version) DefaultFileMode() filesystem.Mode {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return filesystem.ModePermissionUserRead |
 			filesystem.ModePermissionUserWrite
 	default:
@@ -85,7 +131,7 @@ func (v Version) DefaultFileMode() filesystem.Mode {
 
 func (v Version) DefaultDirectoryMode() filesystem.Mode {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return filesystem.ModePermissionUserRead |
 			filesystem.ModePermissionUserWrite |
 			filesystem.ModePermissionUserExecute
@@ -96,7 +142,7 @@ func (v Version) DefaultDirectoryMode() filesystem.Mode {
 
 func (v Version) DefaultOwnerSpecification() string {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return ""
 	default:
 		panic("unknown or unsupported session version")
@@ -105,7 +151,7 @@ func (v Version) DefaultOwnerSpecification() string {
 
 func (v Version) DefaultGroupSpecification() string {
 	switch v {
-	case Version_Version1:
+	case Version_Version1, Version_Version2:
 		return ""
 	default:
 		panic("unknown or unsupported session version")
@@ -149,5 +195,15 @@ func (s *Session) EnsureValid() error {
 		return errors.Wrap(err, "invalid beta-specific configuration")
 	}
 
+	if s.Name != "" {
+		if err := EnsureNameValid(s.Name); err != nil {
+			return errors.Wrap(err, "invalid name")
+		}
+	}
+
+	if err := selection.EnsureLabelsValid(s.Labels); err != nil {
+		return errors.Wrap(err, "invalid labels")
+	}
+
 	return nil
 }
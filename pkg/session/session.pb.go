@@ -6,8 +6,8 @@ package session // import "github.com/RokyErickson/doppelganger/pkg/session"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
-import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import url "github.com/RokyErickson/doppelganger/pkg/url"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 var _ = proto.Marshal
 var _ = fmt.Errorf
@@ -20,22 +20,25 @@ type Version int32
 const (
 	Version_Invalid  Version = 0
 	Version_Version1 Version = 1
+	Version_Version2 Version = 2
 )
 
 var Version_name = map[int32]string{
 	0: "Invalid",
 	1: "Version1",
+	2: "Version2",
 }
 var Version_value = map[string]int32{
 	"Invalid":  0,
 	"Version1": 1,
+	"Version2": 2,
 }
 
 func (x Version) String() string {
 	return proto.EnumName(Version_name, int32(x))
 }
 func (Version) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_46475c2b0f263013, []int{0}
+	return fileDescriptor_session_27d1686dd0306170, []int{0}
 }
 
 type Session struct {
@@ -51,6 +54,8 @@ type Session struct {
 	ConfigurationAlpha   *Configuration       `protobuf:"bytes,11,opt,name=configurationAlpha,proto3" json:"configurationAlpha,omitempty"`
 	ConfigurationBeta    *Configuration       `protobuf:"bytes,12,opt,name=configurationBeta,proto3" json:"configurationBeta,omitempty"`
 	Paused               bool                 `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
+	Labels               map[string]string    `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name                 string               `protobuf:"bytes,14,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -60,7 +65,7 @@ func (m *Session) Reset()         { *m = Session{} }
 func (m *Session) String() string { return proto.CompactTextString(m) }
 func (*Session) ProtoMessage()    {}
 func (*Session) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_46475c2b0f263013, []int{0}
+	return fileDescriptor_session_27d1686dd0306170, []int{0}
 }
 func (m *Session) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Session.Unmarshal(m, b)
@@ -164,37 +169,57 @@ func (m *Session) GetPaused() bool {
 	return false
 }
 
+func (m *Session) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Session) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Session)(nil), "session.Session")
+	proto.RegisterMapType((map[string]string)(nil), "session.Session.LabelsEntry")
 	proto.RegisterEnum("session.Version", Version_name, Version_value)
 }
 
-func init() { proto.RegisterFile("session/session.proto", fileDescriptor_session_46475c2b0f263013) }
-
-var fileDescriptor_session_46475c2b0f263013 = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x6f, 0x8b, 0x9b, 0x30,
-	0x18, 0x9f, 0x5b, 0x5b, 0xed, 0xd3, 0x76, 0x74, 0x61, 0x2b, 0xc1, 0x8d, 0x4e, 0xc6, 0x60, 0xae,
-	0x30, 0x65, 0xdd, 0xdb, 0x31, 0x58, 0x37, 0x06, 0x83, 0x0d, 0x8e, 0x5c, 0xef, 0x5e, 0xdc, 0xbb,
-	0x68, 0x53, 0xcd, 0x9d, 0x1a, 0x89, 0xb1, 0x5f, 0xf4, 0xbe, 0xd0, 0x61, 0xd4, 0x52, 0xef, 0xa4,
-	0xaf, 0xe4, 0xf9, 0xfd, 0xf3, 0xe7, 0x63, 0x02, 0x6f, 0x0a, 0x56, 0x14, 0x5c, 0x64, 0x7e, 0xf3,
-	0xf4, 0x72, 0x29, 0x94, 0x40, 0x66, 0x33, 0xda, 0xef, 0x23, 0x21, 0xa2, 0x84, 0xf9, 0x1a, 0x0e,
-	0xca, 0xbd, 0xaf, 0x78, 0xca, 0x0a, 0x45, 0xd3, 0xbc, 0x56, 0xda, 0x6f, 0xdb, 0x80, 0x50, 0x64,
-	0x7b, 0x1e, 0x95, 0x92, 0xaa, 0x63, 0x8c, 0x3d, 0x2b, 0x65, 0xe2, 0x97, 0x32, 0xa9, 0xc7, 0x0f,
-	0xf7, 0x03, 0x30, 0x2f, 0x6b, 0x39, 0x5a, 0x02, 0xf0, 0x1d, 0xcb, 0x14, 0xdf, 0x73, 0x26, 0xb1,
-	0xe1, 0x18, 0xee, 0x98, 0x9c, 0x20, 0x68, 0x05, 0xe6, 0x81, 0xc9, 0x4a, 0x8a, 0x9f, 0x3b, 0x86,
-	0xfb, 0x72, 0x3d, 0xf7, 0xda, 0x8a, 0xd7, 0x35, 0x4e, 0x5a, 0x01, 0xfa, 0x01, 0xd3, 0x50, 0x32,
-	0xfd, 0xe2, 0x2d, 0x4f, 0x19, 0x7e, 0xe1, 0x18, 0xee, 0x64, 0x6d, 0x7b, 0x75, 0x77, 0xaf, 0xed,
-	0xee, 0x6d, 0xdb, 0xee, 0xa4, 0xa3, 0x47, 0x6b, 0x78, 0x5d, 0xcf, 0x59, 0xd4, 0x64, 0xff, 0xa7,
-	0xb7, 0x42, 0xe2, 0x81, 0x63, 0xb8, 0x33, 0xd2, 0xcb, 0xf5, 0x79, 0x78, 0x26, 0x24, 0x1e, 0xf6,
-	0x7b, 0x2a, 0xae, 0xc7, 0x73, 0x41, 0x55, 0x18, 0xe3, 0x51, 0xaf, 0x47, 0x73, 0x68, 0x09, 0x43,
-	0x9a, 0xe4, 0x31, 0xc5, 0xa6, 0xfe, 0x28, 0xcb, 0xab, 0xd6, 0x79, 0x45, 0xfe, 0x91, 0x1a, 0x46,
-	0xef, 0x60, 0x10, 0x30, 0x45, 0xb1, 0xf5, 0x88, 0xd6, 0x28, 0xfa, 0x0e, 0xb3, 0xce, 0x7f, 0xc1,
-	0x63, 0x2d, 0x5b, 0x1c, 0x77, 0xf9, 0xeb, 0x94, 0x25, 0x5d, 0x31, 0xfa, 0x03, 0xa8, 0x03, 0xfc,
-	0xd4, 0x45, 0x26, 0x67, 0x23, 0x7a, 0x1c, 0xe8, 0x37, 0xbc, 0xea, 0xa0, 0x9b, 0xaa, 0xf0, 0xf4,
-	0x6c, 0xcc, 0x53, 0x03, 0x5a, 0xc0, 0x28, 0xa7, 0x65, 0xc1, 0x76, 0x18, 0x1c, 0xc3, 0xb5, 0x48,
-	0x33, 0xad, 0x3e, 0x82, 0xd9, 0x6c, 0x0c, 0x4d, 0xc0, 0xfc, 0x9b, 0x1d, 0x68, 0xc2, 0x77, 0xf3,
-	0x67, 0x68, 0x0a, 0x56, 0x83, 0x7f, 0x9d, 0x1b, 0x9b, 0xcf, 0x37, 0x9f, 0x22, 0xae, 0xe2, 0x32,
-	0xf0, 0x42, 0x91, 0xfa, 0x31, 0x3d, 0x88, 0xf0, 0x0b, 0x17, 0x7e, 0x5a, 0x2a, 0x1a, 0xb1, 0xcc,
-	0xcf, 0xef, 0xa2, 0xf6, 0x0a, 0x04, 0x23, 0x7d, 0x60, 0xbe, 0x3d, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xc8, 0x24, 0xc4, 0x28, 0x1c, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("session/session.proto", fileDescriptor_session_27d1686dd0306170) }
+
+var fileDescriptor_session_27d1686dd0306170 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x8f, 0xd3, 0x30,
+	0x14, 0x24, 0xfd, 0x4a, 0xfb, 0xd2, 0xae, 0x82, 0xb5, 0xac, 0xac, 0xb0, 0x5a, 0x22, 0x4e, 0xd1,
+	0x1e, 0x12, 0x08, 0x1c, 0x00, 0x21, 0x24, 0x16, 0x16, 0x09, 0x69, 0x91, 0x90, 0x59, 0x38, 0x70,
+	0x73, 0x52, 0x37, 0x35, 0x4d, 0xec, 0xc8, 0x49, 0x2a, 0xf5, 0x9f, 0xf2, 0x73, 0x50, 0x9d, 0xa4,
+	0x6a, 0xd8, 0xa8, 0xa7, 0x7a, 0xe6, 0xcd, 0x4c, 0xa7, 0xf6, 0x2b, 0x3c, 0x29, 0x58, 0x51, 0x70,
+	0x29, 0x82, 0xe6, 0xd3, 0xcf, 0x95, 0x2c, 0x25, 0x32, 0x1b, 0xe8, 0x3c, 0x4b, 0xa4, 0x4c, 0x52,
+	0x16, 0x68, 0x3a, 0xaa, 0x56, 0x41, 0xc9, 0x33, 0x56, 0x94, 0x34, 0xcb, 0x6b, 0xa5, 0xf3, 0xb4,
+	0x0d, 0x88, 0xa5, 0x58, 0xf1, 0xa4, 0x52, 0xb4, 0x3c, 0xc4, 0x38, 0x8b, 0x4a, 0xa5, 0x41, 0xa5,
+	0xd2, 0x1a, 0x3e, 0xff, 0x3b, 0x06, 0xf3, 0x47, 0x2d, 0x47, 0x57, 0x00, 0x7c, 0xc9, 0x44, 0xc9,
+	0x57, 0x9c, 0x29, 0x6c, 0xb8, 0x86, 0x37, 0x23, 0x47, 0x0c, 0xba, 0x06, 0x73, 0xcb, 0xd4, 0x5e,
+	0x8a, 0x07, 0xae, 0xe1, 0x9d, 0x85, 0xb6, 0xdf, 0x56, 0xfc, 0x55, 0xf3, 0xa4, 0x15, 0xa0, 0x0f,
+	0x30, 0x8f, 0x15, 0xd3, 0x5f, 0x7c, 0xcf, 0x33, 0x86, 0x87, 0xae, 0xe1, 0x59, 0xa1, 0xe3, 0xd7,
+	0xdd, 0xfd, 0xb6, 0xbb, 0x7f, 0xdf, 0x76, 0x27, 0x1d, 0x3d, 0x0a, 0xe1, 0xbc, 0xc6, 0x22, 0x69,
+	0xb2, 0xbf, 0xd1, 0x3f, 0x52, 0xe1, 0x91, 0x6b, 0x78, 0x0b, 0xd2, 0x3b, 0xeb, 0xf3, 0x70, 0x21,
+	0x15, 0x1e, 0xf7, 0x7b, 0xb8, 0xe8, 0xf5, 0x7c, 0xa7, 0x65, 0xbc, 0xc6, 0x93, 0x5e, 0x8f, 0x9e,
+	0xa1, 0x2b, 0x18, 0xd3, 0x34, 0x5f, 0x53, 0x6c, 0xea, 0x1f, 0x35, 0xf5, 0xf7, 0xd7, 0xf9, 0x93,
+	0xdc, 0x91, 0x9a, 0x46, 0x97, 0x30, 0x8a, 0x58, 0x49, 0xf1, 0xf4, 0xbf, 0xb1, 0x66, 0xd1, 0x7b,
+	0x58, 0x74, 0xde, 0x05, 0xcf, 0xb4, 0xec, 0xe2, 0x70, 0x97, 0x9f, 0x8e, 0xa7, 0xa4, 0x2b, 0x46,
+	0x5f, 0x00, 0x75, 0x88, 0x8f, 0xba, 0x88, 0x75, 0x32, 0xa2, 0xc7, 0x81, 0x3e, 0xc3, 0xe3, 0x0e,
+	0x7b, 0xb3, 0x2f, 0x3c, 0x3f, 0x19, 0xf3, 0xd0, 0x80, 0x2e, 0x60, 0x92, 0xd3, 0xaa, 0x60, 0x4b,
+	0x0c, 0xae, 0xe1, 0x4d, 0x49, 0x83, 0xd0, 0x6b, 0x98, 0xa4, 0x34, 0x62, 0x69, 0x81, 0x17, 0xee,
+	0xd0, 0xb3, 0xc2, 0xcb, 0x43, 0x64, 0xb3, 0x6b, 0xfe, 0x9d, 0x1e, 0xdf, 0x8a, 0x52, 0xed, 0x48,
+	0xa3, 0x45, 0x08, 0x46, 0x82, 0x66, 0x0c, 0x9f, 0xe9, 0xcd, 0xd3, 0x67, 0xe7, 0x2d, 0x58, 0x47,
+	0x52, 0x64, 0xc3, 0x70, 0xc3, 0x76, 0xcd, 0x6e, 0xee, 0x8f, 0xe8, 0x1c, 0xc6, 0x5b, 0x9a, 0x56,
+	0x4c, 0xaf, 0xe4, 0x8c, 0xd4, 0xe0, 0xdd, 0xe0, 0x8d, 0x71, 0x1d, 0x82, 0xd9, 0x3c, 0x1b, 0xb2,
+	0xc0, 0xfc, 0x2a, 0xb6, 0x34, 0xe5, 0x4b, 0xfb, 0x11, 0x9a, 0xc3, 0xb4, 0xe1, 0x5f, 0xda, 0xc6,
+	0x11, 0x0a, 0xed, 0xc1, 0x4d, 0xf8, 0xfb, 0x45, 0xc2, 0xcb, 0x75, 0x15, 0xf9, 0xb1, 0xcc, 0x02,
+	0x22, 0x37, 0xbb, 0x5b, 0xc5, 0xe3, 0x4d, 0x21, 0x45, 0xb0, 0x94, 0x79, 0xce, 0xd2, 0x84, 0x8a,
+	0x84, 0xa9, 0x20, 0xdf, 0x24, 0xed, 0xdf, 0x33, 0x9a, 0xe8, 0x65, 0x7e, 0xf5, 0x6f, 0x00, 0x63,
+	0x41, 0xac, 0x1b, 0xb8, 0x03, 0x00, 0x00,
 }
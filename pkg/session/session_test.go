@@ -8,6 +8,7 @@ import (
 
 var supportedSessionVersions = []Version{
 	Version_Version1,
+	Version_Version2,
 }
 
 func TestSupportedVersions(t *testing.T) {
@@ -26,6 +27,22 @@ func TestDefaultWatchPollingIntervalNonZero(t *testing.T) {
 	}
 }
 
+func TestDefaultDeletionModeSupported(t *testing.T) {
+	for _, version := range supportedSessionVersions {
+		if !version.DefaultDeletionMode().Supported() {
+			t.Error("unsupported default deletion mode")
+		}
+	}
+}
+
+func TestDefaultVersioningModeSupported(t *testing.T) {
+	for _, version := range supportedSessionVersions {
+		if !version.DefaultVersioningMode().Supported() {
+			t.Error("unsupported default versioning mode")
+		}
+	}
+}
+
 func TestDefaultFileModeValid(t *testing.T) {
 	for _, version := range supportedSessionVersions {
 		if err := sync.EnsureDefaultFileModeValid(version.DefaultFileMode()); err != nil {
@@ -41,3 +58,11 @@ func TestDefaultDirectoryModeValid(t *testing.T) {
 		}
 	}
 }
+
+func TestDefaultModificationTimesModeSupported(t *testing.T) {
+	for _, version := range supportedSessionVersions {
+		if !version.DefaultModificationTimesMode().Supported() {
+			t.Error("unsupported default modification time preservation mode")
+		}
+	}
+}
@@ -32,6 +32,8 @@ func (s Status) Description() string {
 		return "Applying changes"
 	case Status_Saving:
 		return "Saving archive"
+	case Status_HaltedOnMassDeletion:
+		return "Halted due to mass deletion"
 	default:
 		return "Unknown"
 	}
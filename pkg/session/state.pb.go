@@ -31,6 +31,7 @@ const (
 	Status_StagingBeta            Status = 10
 	Status_Transitioning          Status = 11
 	Status_Saving                 Status = 12
+	Status_HaltedOnMassDeletion   Status = 13
 )
 
 var Status_name = map[int32]string{
@@ -47,6 +48,7 @@ var Status_name = map[int32]string{
 	10: "StagingBeta",
 	11: "Transitioning",
 	12: "Saving",
+	13: "HaltedOnMassDeletion",
 }
 var Status_value = map[string]int32{
 	"Disconnected":           0,
@@ -62,13 +64,14 @@ var Status_value = map[string]int32{
 	"StagingBeta":            10,
 	"Transitioning":          11,
 	"Saving":                 12,
+	"HaltedOnMassDeletion":   13,
 }
 
 func (x Status) String() string {
 	return proto.EnumName(Status_name, int32(x))
 }
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_state_cddd0f3d09b59394, []int{0}
+	return fileDescriptor_state_57c3f2a58a11d381, []int{0}
 }
 
 type State struct {
@@ -91,7 +94,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_cddd0f3d09b59394, []int{0}
+	return fileDescriptor_state_57c3f2a58a11d381, []int{0}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_State.Unmarshal(m, b)
@@ -186,40 +189,40 @@ func init() {
 	proto.RegisterEnum("session.Status", Status_name, Status_value)
 }
 
-func init() { proto.RegisterFile("session/state.proto", fileDescriptor_state_cddd0f3d09b59394) }
-
-var fileDescriptor_state_cddd0f3d09b59394 = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x5f, 0x6b, 0xdb, 0x3c,
-	0x14, 0xc6, 0x5f, 0x37, 0x89, 0x93, 0x9c, 0xfc, 0xf3, 0x7b, 0xd2, 0x0e, 0x13, 0x06, 0x33, 0x63,
-	0xac, 0x5e, 0xd9, 0x1c, 0x96, 0x5e, 0xee, 0x6a, 0x4d, 0x37, 0x7a, 0xb7, 0x61, 0x17, 0x0a, 0xbb,
-	0x53, 0x54, 0xd5, 0x16, 0x73, 0xa4, 0x60, 0x29, 0x81, 0xec, 0xfb, 0xee, 0x6b, 0x8c, 0x21, 0x59,
-	0x6e, 0x9a, 0x31, 0xd8, 0x95, 0xac, 0x47, 0xbf, 0xc7, 0xe7, 0xe8, 0x39, 0x08, 0xa6, 0x8a, 0x29,
-	0xc5, 0xa5, 0x98, 0x2b, 0x4d, 0x34, 0x4b, 0x36, 0x95, 0xd4, 0x12, 0xbb, 0x4e, 0x9c, 0x4d, 0x2b,
-	0xb5, 0x17, 0x74, 0x5e, 0x31, 0xca, 0xf8, 0xce, 0x9d, 0xce, 0xce, 0x1e, 0x2d, 0xf5, 0xea, 0xe4,
-	0xa9, 0x45, 0xa9, 0x14, 0x0f, 0x25, 0xa7, 0xda, 0x89, 0x68, 0xc5, 0x4d, 0x25, 0x57, 0x25, 0x5b,
-	0xd7, 0xda, 0xcb, 0x9f, 0x2d, 0xe8, 0x64, 0xa6, 0x1a, 0x5e, 0x40, 0x53, 0x29, 0xf4, 0x22, 0x2f,
-	0x1e, 0x2c, 0x82, 0xa4, 0xf9, 0x67, 0x56, 0xaf, 0x69, 0x03, 0xe0, 0x39, 0xf8, 0xa6, 0xc5, 0xad,
-	0x0a, 0x4f, 0x22, 0x2f, 0x1e, 0x2f, 0x26, 0x07, 0xd4, 0xca, 0xa9, 0x3b, 0xc6, 0xd7, 0x30, 0x26,
-	0xe5, 0xa6, 0x20, 0x4b, 0x29, 0x04, 0xa3, 0x9a, 0xdd, 0x87, 0xad, 0xc8, 0x8b, 0x7b, 0xe9, 0x1f,
-	0x2a, 0xbe, 0x82, 0xd1, 0x8a, 0xe9, 0x27, 0x58, 0xdb, 0x62, 0xc7, 0x22, 0x3e, 0x87, 0x7e, 0x49,
-	0x94, 0xfe, 0x54, 0x55, 0xb2, 0x0a, 0x3b, 0x91, 0x17, 0xf7, 0xd3, 0x83, 0x80, 0x37, 0xf0, 0x42,
-	0x6d, 0x29, 0x65, 0x4a, 0x3d, 0x6c, 0xcb, 0x6c, 0x2f, 0x68, 0x51, 0x49, 0xc1, 0x7f, 0x10, 0xcd,
-	0xa5, 0x58, 0xee, 0x69, 0xc9, 0x54, 0xe8, 0x47, 0x5e, 0xdc, 0x4e, 0xff, 0x85, 0xe1, 0x07, 0x18,
-	0x29, 0x4d, 0x72, 0x2e, 0xf2, 0xfa, 0x3a, 0x61, 0xd7, 0x06, 0x72, 0x96, 0xd8, 0x09, 0x24, 0x69,
-	0x3d, 0x81, 0xca, 0xdd, 0xf5, 0x98, 0xc5, 0xb7, 0xd0, 0x6f, 0x72, 0x57, 0x61, 0x2f, 0x6a, 0xc5,
-	0x83, 0xc5, 0x38, 0xb1, 0xbe, 0xa5, 0x93, 0xd3, 0x03, 0x80, 0x97, 0x30, 0xb2, 0x51, 0x7c, 0xad,
-	0xa7, 0xa2, 0xc2, 0xbe, 0x75, 0x8c, 0x6a, 0x87, 0x53, 0xd3, 0x63, 0x06, 0xdf, 0xc3, 0xd0, 0x04,
-	0xf3, 0xe8, 0x81, 0xbf, 0x79, 0x8e, 0x90, 0x8b, 0x5f, 0x1e, 0xf8, 0xae, 0xc1, 0x00, 0x86, 0xd7,
-	0x5c, 0xd1, 0x26, 0xd5, 0xe0, 0x3f, 0x0c, 0xe1, 0xf4, 0x86, 0x94, 0x9a, 0xdd, 0x7f, 0x11, 0xa9,
-	0x94, 0xfa, 0x9a, 0x95, 0xcc, 0xa4, 0x11, 0x78, 0x38, 0x83, 0x67, 0x4f, 0x4f, 0x6e, 0xf7, 0x1b,
-	0xb6, 0x2c, 0x88, 0xc8, 0x59, 0x70, 0x82, 0x53, 0x98, 0xb8, 0xd1, 0x70, 0x91, 0x7f, 0x34, 0x0d,
-	0x06, 0x2d, 0x44, 0x18, 0x1f, 0xc4, 0x2b, 0xa6, 0x49, 0xd0, 0xc6, 0x21, 0xf4, 0xee, 0x88, 0xa6,
-	0x05, 0x17, 0x79, 0xd0, 0x31, 0xbb, 0x8c, 0x12, 0x21, 0xcc, 0xce, 0xc7, 0x53, 0x08, 0xee, 0x08,
-	0x37, 0xf0, 0x67, 0x59, 0xa5, 0x4c, 0x51, 0x22, 0x82, 0x2e, 0x4e, 0x60, 0x90, 0x32, 0x2a, 0x05,
-	0xe5, 0xa5, 0xc1, 0x7a, 0xa6, 0xe7, 0xac, 0x4e, 0xb9, 0x2e, 0xd4, 0x37, 0x88, 0x53, 0x6c, 0x15,
-	0xc0, 0xff, 0x61, 0x74, 0x5b, 0x11, 0xa1, 0xb8, 0x69, 0xdd, 0xb8, 0x06, 0x08, 0xe0, 0x67, 0x64,
-	0x67, 0xbe, 0x87, 0x57, 0x6f, 0xbe, 0x9d, 0xe7, 0x5c, 0x17, 0xdb, 0x55, 0x42, 0xe5, 0x7a, 0x5e,
-	0x90, 0x9d, 0xa4, 0xef, 0xb8, 0x9c, 0xaf, 0xb7, 0x9a, 0xe4, 0x4c, 0xcc, 0x37, 0xdf, 0xf3, 0xe6,
-	0x09, 0xad, 0x7c, 0xfb, 0x34, 0x2e, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x87, 0x0b, 0xb9,
-	0x8f, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("session/state.proto", fileDescriptor_state_57c3f2a58a11d381) }
+
+var fileDescriptor_state_57c3f2a58a11d381 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xdf, 0x6a, 0xdb, 0x30,
+	0x14, 0xc6, 0xe7, 0xa6, 0x71, 0x92, 0x93, 0x38, 0xf1, 0x94, 0x76, 0x98, 0x30, 0x98, 0x19, 0x63,
+	0x33, 0x65, 0x38, 0x5b, 0x7a, 0xb9, 0xab, 0x35, 0xed, 0xe8, 0xcd, 0xd8, 0x90, 0x0b, 0x85, 0xdd,
+	0x29, 0x8a, 0xea, 0x88, 0xb8, 0x92, 0x91, 0x94, 0x42, 0xf6, 0x24, 0x7b, 0xc1, 0xbd, 0xc7, 0x90,
+	0x2d, 0xe7, 0xcf, 0x18, 0xec, 0xca, 0xd1, 0xa7, 0xdf, 0x97, 0x73, 0xce, 0x77, 0x10, 0x8c, 0x35,
+	0xd3, 0x9a, 0x4b, 0x31, 0xd5, 0x86, 0x18, 0x96, 0x96, 0x4a, 0x1a, 0x89, 0x3a, 0x4e, 0x9c, 0x8c,
+	0x95, 0xde, 0x0a, 0x3a, 0x55, 0x8c, 0x32, 0xfe, 0xe4, 0x6e, 0x27, 0xe7, 0x3b, 0x4b, 0xfd, 0x75,
+	0xf2, 0xb8, 0x42, 0xa9, 0x14, 0x0f, 0x05, 0xa7, 0xc6, 0x89, 0xa8, 0x12, 0x4b, 0x25, 0x17, 0x05,
+	0x7b, 0xac, 0xb5, 0xd7, 0xbf, 0x5b, 0xd0, 0xce, 0x6c, 0x35, 0x74, 0x01, 0x4d, 0xa5, 0xc8, 0x8b,
+	0xbd, 0xa4, 0x3f, 0x0b, 0xd3, 0xe6, 0x3f, 0xb3, 0xfa, 0x8b, 0x1b, 0x00, 0xbd, 0x03, 0xdf, 0xb6,
+	0xb8, 0xd1, 0xd1, 0x49, 0xec, 0x25, 0xc3, 0xd9, 0x68, 0x8f, 0x56, 0x32, 0x76, 0xd7, 0xe8, 0x2d,
+	0x0c, 0x49, 0x51, 0xae, 0xc8, 0x5c, 0x0a, 0xc1, 0xa8, 0x61, 0xcb, 0xa8, 0x15, 0x7b, 0x49, 0x17,
+	0xff, 0xa5, 0xa2, 0x37, 0x10, 0x2c, 0x98, 0x39, 0xc0, 0x4e, 0x2b, 0xec, 0x58, 0x44, 0x2f, 0xa1,
+	0x57, 0x10, 0x6d, 0x6e, 0x94, 0x92, 0x2a, 0x6a, 0xc7, 0x5e, 0xd2, 0xc3, 0x7b, 0x01, 0xdd, 0xc2,
+	0x2b, 0xbd, 0xa1, 0x94, 0x69, 0xfd, 0xb0, 0x29, 0xb2, 0xad, 0xa0, 0x2b, 0x25, 0x05, 0xff, 0x49,
+	0x0c, 0x97, 0x62, 0xbe, 0xa5, 0x05, 0xd3, 0x91, 0x1f, 0x7b, 0xc9, 0x29, 0xfe, 0x1f, 0x86, 0x3e,
+	0x41, 0xa0, 0x0d, 0xc9, 0xb9, 0xc8, 0xeb, 0x71, 0xa2, 0x4e, 0x15, 0xc8, 0x79, 0x5a, 0x6d, 0x20,
+	0xc5, 0xf5, 0x06, 0x94, 0x9b, 0xf5, 0x98, 0x45, 0xef, 0xa1, 0xd7, 0xe4, 0xae, 0xa3, 0x6e, 0xdc,
+	0x4a, 0xfa, 0xb3, 0x61, 0x5a, 0xf9, 0xe6, 0x4e, 0xc6, 0x7b, 0x00, 0x5d, 0x42, 0x50, 0x45, 0xf1,
+	0xbd, 0xde, 0x8a, 0x8e, 0x7a, 0x95, 0x23, 0xa8, 0x1d, 0x4e, 0xc5, 0xc7, 0x0c, 0xfa, 0x08, 0x03,
+	0x1b, 0xcc, 0xce, 0x03, 0xff, 0xf2, 0x1c, 0x21, 0x17, 0xbf, 0x4e, 0xc0, 0x77, 0x0d, 0x86, 0x30,
+	0xb8, 0xe6, 0x9a, 0x36, 0xa9, 0x86, 0xcf, 0x50, 0x04, 0x67, 0xb7, 0xa4, 0x30, 0x6c, 0xf9, 0x4d,
+	0x60, 0x29, 0xcd, 0x35, 0x2b, 0x98, 0x4d, 0x23, 0xf4, 0xd0, 0x04, 0x5e, 0x1c, 0xde, 0xdc, 0x6d,
+	0x4b, 0x36, 0x5f, 0x11, 0x91, 0xb3, 0xf0, 0x04, 0x8d, 0x61, 0xe4, 0x56, 0xc3, 0x45, 0xfe, 0xd9,
+	0x36, 0x18, 0xb6, 0x10, 0x82, 0xe1, 0x5e, 0xbc, 0x62, 0x86, 0x84, 0xa7, 0x68, 0x00, 0xdd, 0x7b,
+	0x62, 0xe8, 0x8a, 0x8b, 0x3c, 0x6c, 0xdb, 0x53, 0x46, 0x89, 0x10, 0xf6, 0xe4, 0xa3, 0x33, 0x08,
+	0xef, 0x09, 0xb7, 0xf0, 0x17, 0xa9, 0x30, 0xd3, 0x94, 0x88, 0xb0, 0x83, 0x46, 0xd0, 0xc7, 0x8c,
+	0x4a, 0x41, 0x79, 0x61, 0xb1, 0xae, 0xed, 0x39, 0xab, 0x53, 0xae, 0x0b, 0xf5, 0x2c, 0xe2, 0x94,
+	0xaa, 0x0a, 0xa0, 0xe7, 0x10, 0xdc, 0x29, 0x22, 0x34, 0xb7, 0xad, 0x5b, 0x57, 0x1f, 0x01, 0xf8,
+	0x19, 0x79, 0xb2, 0xbf, 0x07, 0x87, 0x33, 0x7e, 0x25, 0x5a, 0xef, 0x66, 0x0c, 0xae, 0x66, 0x3f,
+	0x3e, 0xe4, 0xdc, 0xac, 0x36, 0x8b, 0x94, 0xca, 0xc7, 0x29, 0x96, 0xeb, 0xed, 0x8d, 0xe2, 0x74,
+	0xad, 0xa5, 0x98, 0x2e, 0x65, 0x59, 0xb2, 0x22, 0xb7, 0x03, 0xab, 0x69, 0xb9, 0xce, 0x9b, 0x57,
+	0xb6, 0xf0, 0xab, 0xd7, 0x73, 0xf9, 0x67, 0x00, 0xb0, 0xb5, 0x48, 0x4a, 0xb2, 0x03, 0x00, 0x00,
 }
@@ -6,12 +6,27 @@ import (
 	"github.com/pkg/errors"
 )
 
+func replaceDirectoryMetadata(existing, metadata *Entry) *Entry {
+	if existing == nil || existing.Kind != EntryKind_Directory {
+		return metadata
+	}
+
+	result := metadata.copySlim()
+	result.Contents = existing.Contents
+
+	return result
+}
+
 func Apply(base *Entry, changes []*Change) (*Entry, error) {
 	result := base.Copy()
 
 	for _, c := range changes {
 		if c.Path == "" {
-			result = c.New
+			if c.metadataOnly() {
+				result = replaceDirectoryMetadata(result, c.New)
+			} else {
+				result = c.New
+			}
 			continue
 		}
 
@@ -32,7 +47,11 @@ func Apply(base *Entry, changes []*Change) (*Entry, error) {
 			if parent.Contents == nil {
 				parent.Contents = make(map[string]*Entry)
 			}
-			parent.Contents[components[0]] = c.New
+			if c.metadataOnly() {
+				parent.Contents[components[0]] = replaceDirectoryMetadata(parent.Contents[components[0]], c.New)
+			} else {
+				parent.Contents[components[0]] = c.New
+			}
 		}
 	}
 
@@ -41,3 +41,23 @@ func TestApplyMissingParentPath(t *testing.T) {
 		t.Fatal("change referencing invalid path did not fail to apply")
 	}
 }
+
+func TestApplyDirectoryMetadata(t *testing.T) {
+	metadata := testDirectory1Entry.copySlim()
+	metadata.Permissions = 0700
+	metadata.PermissionsRecorded = true
+	changes := []*Change{
+		{
+			Old: testDirectory1Entry.copySlim(),
+			New: metadata,
+		},
+	}
+
+	if result, err := Apply(testDirectory1Entry, changes); err != nil {
+		t.Fatal("unable to apply changes:", err)
+	} else if result.Permissions != 0700 {
+		t.Error("directory metadata not applied")
+	} else if len(result.Contents) != len(testDirectory1Entry.Contents) {
+		t.Error("directory contents not retained after metadata change")
+	}
+}
@@ -15,21 +15,23 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2
 
 type CacheEntry struct {
-	Mode                 uint32               `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
-	ModificationTime     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=modificationTime,proto3" json:"modificationTime,omitempty"`
-	Size                 uint64               `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	FileID               uint64               `protobuf:"varint,4,opt,name=fileID,proto3" json:"fileID,omitempty"`
-	Digest               []byte               `protobuf:"bytes,9,opt,name=digest,proto3" json:"digest,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Mode                     uint32               `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	ModificationTime         *timestamp.Timestamp `protobuf:"bytes,2,opt,name=modificationTime,proto3" json:"modificationTime,omitempty"`
+	Size                     uint64               `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	FileID                   uint64               `protobuf:"varint,4,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	Digest                   []byte               `protobuf:"bytes,9,opt,name=digest,proto3" json:"digest,omitempty"`
+	ExtendedAttributes       map[string][]byte    `protobuf:"bytes,10,rep,name=extendedAttributes,proto3" json:"extendedAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExtendedAttributesDigest []byte               `protobuf:"bytes,11,opt,name=extendedAttributesDigest,proto3" json:"extendedAttributesDigest,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}             `json:"-"`
+	XXX_unrecognized         []byte               `json:"-"`
+	XXX_sizecache            int32                `json:"-"`
 }
 
 func (m *CacheEntry) Reset()         { *m = CacheEntry{} }
 func (m *CacheEntry) String() string { return proto.CompactTextString(m) }
 func (*CacheEntry) ProtoMessage()    {}
 func (*CacheEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cache_713b5d5d73f5fd58, []int{0}
+	return fileDescriptor_cache_d548c3b7dccd5bc4, []int{0}
 }
 func (m *CacheEntry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CacheEntry.Unmarshal(m, b)
@@ -84,6 +86,20 @@ func (m *CacheEntry) GetDigest() []byte {
 	return nil
 }
 
+func (m *CacheEntry) GetExtendedAttributes() map[string][]byte {
+	if m != nil {
+		return m.ExtendedAttributes
+	}
+	return nil
+}
+
+func (m *CacheEntry) GetExtendedAttributesDigest() []byte {
+	if m != nil {
+		return m.ExtendedAttributesDigest
+	}
+	return nil
+}
+
 type Cache struct {
 	Entries              map[string]*CacheEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -95,7 +111,7 @@ func (m *Cache) Reset()         { *m = Cache{} }
 func (m *Cache) String() string { return proto.CompactTextString(m) }
 func (*Cache) ProtoMessage()    {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cache_713b5d5d73f5fd58, []int{1}
+	return fileDescriptor_cache_d548c3b7dccd5bc4, []int{1}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Cache.Unmarshal(m, b)
@@ -124,30 +140,35 @@ func (m *Cache) GetEntries() map[string]*CacheEntry {
 
 func init() {
 	proto.RegisterType((*CacheEntry)(nil), "sync.CacheEntry")
+	proto.RegisterMapType((map[string][]byte)(nil), "sync.CacheEntry.ExtendedAttributesEntry")
 	proto.RegisterType((*Cache)(nil), "sync.Cache")
 	proto.RegisterMapType((map[string]*CacheEntry)(nil), "sync.Cache.EntriesEntry")
 }
 
-func init() { proto.RegisterFile("sync/cache.proto", fileDescriptor_cache_713b5d5d73f5fd58) }
-
-var fileDescriptor_cache_713b5d5d73f5fd58 = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x6a, 0x32, 0x31,
-	0x14, 0x85, 0x89, 0x8e, 0xfe, 0x18, 0xfd, 0x61, 0xc8, 0xa2, 0x04, 0x37, 0x1d, 0xa4, 0xc8, 0x6c,
-	0x9a, 0x80, 0xdd, 0x94, 0x2e, 0xdb, 0x5a, 0x28, 0x74, 0x15, 0x5c, 0x75, 0x17, 0xe3, 0x35, 0x06,
-	0xcd, 0x64, 0x98, 0xc9, 0x08, 0xd3, 0x37, 0xe8, 0xbb, 0xf4, 0x21, 0x4b, 0x32, 0x0e, 0x08, 0xdd,
-	0xdd, 0x7b, 0xce, 0x3d, 0x1f, 0x39, 0xc1, 0x69, 0xdd, 0x16, 0x8a, 0x2b, 0xa9, 0x0e, 0xc0, 0xca,
-	0xca, 0x79, 0x47, 0x92, 0xa0, 0xcc, 0x6f, 0xb5, 0x73, 0xfa, 0x04, 0x3c, 0x6a, 0xdb, 0x66, 0xcf,
-	0xbd, 0xb1, 0x50, 0x7b, 0x69, 0xcb, 0xee, 0x6c, 0xf1, 0x83, 0x30, 0x7e, 0x09, 0xb1, 0x75, 0xe1,
-	0xab, 0x96, 0x10, 0x9c, 0x58, 0xb7, 0x03, 0x8a, 0x32, 0x94, 0xff, 0x17, 0x71, 0x26, 0x6f, 0x38,
-	0xb5, 0x6e, 0x67, 0xf6, 0x46, 0x49, 0x6f, 0x5c, 0xb1, 0x31, 0x16, 0xe8, 0x20, 0x43, 0xf9, 0x74,
-	0x35, 0x67, 0x1d, 0x9e, 0xf5, 0x78, 0xb6, 0xe9, 0xf1, 0xe2, 0x4f, 0x26, 0xb0, 0x6b, 0xf3, 0x05,
-	0x74, 0x98, 0xa1, 0x3c, 0x11, 0x71, 0x26, 0x37, 0x78, 0xbc, 0x37, 0x27, 0x78, 0x7f, 0xa5, 0x49,
-	0x54, 0x2f, 0x5b, 0xd0, 0x77, 0x46, 0x43, 0xed, 0xe9, 0x24, 0x43, 0xf9, 0x4c, 0x5c, 0xb6, 0xc5,
-	0x37, 0xc2, 0xa3, 0xf8, 0x5c, 0xb2, 0xc2, 0xff, 0xa0, 0xf0, 0x95, 0x81, 0x9a, 0xa2, 0x6c, 0x98,
-	0x4f, 0x57, 0x94, 0x85, 0xc6, 0x2c, 0xba, 0x6c, 0xdd, 0x59, 0xb1, 0x94, 0xe8, 0x0f, 0xe7, 0x1f,
-	0x78, 0x76, 0x6d, 0x90, 0x14, 0x0f, 0x8f, 0xd0, 0xc6, 0xb2, 0x13, 0x11, 0x46, 0xb2, 0xc4, 0xa3,
-	0xb3, 0x3c, 0x35, 0x7d, 0xc1, 0xf4, 0x8a, 0xd9, 0xb1, 0x3a, 0xfb, 0x69, 0xf0, 0x88, 0x9e, 0x97,
-	0x9f, 0x77, 0xda, 0xf8, 0x43, 0xb3, 0x65, 0xca, 0x59, 0x7e, 0x90, 0x67, 0xa7, 0xee, 0x8d, 0xe3,
-	0xb6, 0xf1, 0x52, 0x43, 0xc1, 0xcb, 0xa3, 0xe6, 0x21, 0xbd, 0x1d, 0xc7, 0xdf, 0x79, 0xf8, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x00, 0x94, 0xf9, 0xb3, 0xa4, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("sync/cache.proto", fileDescriptor_cache_d548c3b7dccd5bc4) }
+
+var fileDescriptor_cache_d548c3b7dccd5bc4 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0xab, 0xdb, 0x30,
+	0x10, 0x44, 0xb1, 0x93, 0x12, 0x39, 0x05, 0x23, 0x4a, 0x2b, 0x7c, 0xa9, 0xc9, 0xa1, 0xf8, 0x52,
+	0x09, 0xdc, 0x4b, 0xc9, 0xad, 0x6d, 0x5c, 0x28, 0xf4, 0x24, 0x72, 0x28, 0xbd, 0xf9, 0x63, 0xe3,
+	0x08, 0x7f, 0xc8, 0xd8, 0x72, 0xa9, 0xdf, 0x3f, 0xc8, 0xbf, 0x7e, 0x58, 0x4e, 0x78, 0xe6, 0x25,
+	0xb9, 0xed, 0xce, 0xae, 0x66, 0x67, 0x06, 0x61, 0xb7, 0x1b, 0xea, 0x94, 0xa7, 0x71, 0x7a, 0x02,
+	0xd6, 0xb4, 0x4a, 0x2b, 0x62, 0x8f, 0x88, 0xf7, 0x31, 0x57, 0x2a, 0x2f, 0x81, 0x1b, 0x2c, 0xe9,
+	0x8f, 0x5c, 0xcb, 0x0a, 0x3a, 0x1d, 0x57, 0xcd, 0xb4, 0xb6, 0x3d, 0x5b, 0x18, 0xff, 0x18, 0x9f,
+	0x45, 0xb5, 0x6e, 0x07, 0x42, 0xb0, 0x5d, 0xa9, 0x0c, 0x28, 0xf2, 0x51, 0xf0, 0x56, 0x98, 0x9a,
+	0xfc, 0xc4, 0x6e, 0xa5, 0x32, 0x79, 0x94, 0x69, 0xac, 0xa5, 0xaa, 0x0f, 0xb2, 0x02, 0xba, 0xf0,
+	0x51, 0xe0, 0x84, 0x1e, 0x9b, 0xe8, 0xd9, 0x95, 0x9e, 0x1d, 0xae, 0xf4, 0xe2, 0xe6, 0xcd, 0xc8,
+	0xdd, 0xc9, 0x27, 0xa0, 0x96, 0x8f, 0x02, 0x5b, 0x98, 0x9a, 0xbc, 0xc7, 0xab, 0xa3, 0x2c, 0xe1,
+	0xd7, 0x9e, 0xda, 0x06, 0xbd, 0x74, 0x23, 0x9e, 0xc9, 0x1c, 0x3a, 0x4d, 0xd7, 0x3e, 0x0a, 0x36,
+	0xe2, 0xd2, 0x91, 0x3f, 0x98, 0xc0, 0x7f, 0x0d, 0x75, 0x06, 0xd9, 0x37, 0xad, 0x5b, 0x99, 0xf4,
+	0x1a, 0x3a, 0x8a, 0x7d, 0x2b, 0x70, 0xc2, 0x80, 0x8d, 0x96, 0xd9, 0x8b, 0x1b, 0x16, 0xdd, 0xac,
+	0x1a, 0x5c, 0xdc, 0xe1, 0x20, 0x3b, 0x4c, 0x6f, 0xd1, 0xfd, 0xa4, 0xc1, 0x31, 0x1a, 0x1e, 0xce,
+	0xbd, 0x08, 0x7f, 0x78, 0x70, 0x8a, 0xb8, 0xd8, 0x2a, 0x60, 0x30, 0x79, 0xae, 0xc5, 0x58, 0x92,
+	0x77, 0x78, 0xf9, 0x2f, 0x2e, 0xfb, 0x29, 0xc3, 0x8d, 0x98, 0x9a, 0xdd, 0xe2, 0x2b, 0xda, 0x9e,
+	0x11, 0x5e, 0x1a, 0xf5, 0x24, 0xc4, 0x6f, 0xa0, 0xd6, 0xad, 0x84, 0x8e, 0x22, 0xe3, 0x8d, 0xce,
+	0xbc, 0xb1, 0x68, 0x1a, 0x4d, 0x5e, 0xae, 0x8b, 0xde, 0x6f, 0xbc, 0x99, 0x0f, 0xee, 0x5c, 0xfe,
+	0x34, 0xbf, 0xec, 0x84, 0xee, 0xeb, 0xbc, 0x66, 0x5a, 0xbe, 0xf3, 0xbf, 0x9f, 0x73, 0xa9, 0x4f,
+	0x7d, 0xc2, 0x52, 0x55, 0x71, 0xa1, 0x8a, 0x21, 0x6a, 0x65, 0x5a, 0x74, 0xaa, 0xe6, 0x99, 0x6a,
+	0x1a, 0x28, 0xf3, 0xb8, 0xce, 0xa1, 0xe5, 0x4d, 0x91, 0xf3, 0x91, 0x26, 0x59, 0x99, 0x3f, 0xf0,
+	0xe5, 0x79, 0x00, 0x0e, 0x7a, 0x45, 0x8d, 0x8a, 0x02, 0x00, 0x00,
 }
@@ -4,7 +4,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (c *Change) copySlim() *Change {
+func (c *Change) CopySlim() *Change {
 	return &Change{
 		Path: c.Path,
 		Old:  c.Old.copySlim(),
@@ -12,6 +12,12 @@ func (c *Change) copySlim() *Change {
 	}
 }
 
+func (c *Change) metadataOnly() bool {
+	return c.Old != nil && c.New != nil &&
+		c.Old.Kind == EntryKind_Directory && c.New.Kind == EntryKind_Directory &&
+		len(c.Old.Contents) == 0 && len(c.New.Contents) == 0
+}
+
 func (c *Change) EnsureValid() error {
 	if c == nil {
 		return errors.New("nil change")
@@ -11,7 +11,7 @@ func TestChangeCopySlim(t *testing.T) {
 		New:  testDirectory2Entry,
 	}
 
-	slim := change.copySlim()
+	slim := change.CopySlim()
 
 	if err := slim.EnsureValid(); err != nil {
 		t.Fatal("slim copy of change is invalid:", err)
@@ -8,12 +8,12 @@ func (c *Conflict) CopySlim() *Conflict {
 
 	alphaChanges := make([]*Change, len(c.AlphaChanges))
 	for a, change := range c.AlphaChanges {
-		alphaChanges[a] = change.copySlim()
+		alphaChanges[a] = change.CopySlim()
 	}
 
 	betaChanges := make([]*Change, len(c.BetaChanges))
 	for b, change := range c.BetaChanges {
-		betaChanges[b] = change.copySlim()
+		betaChanges[b] = change.CopySlim()
 	}
 
 	return &Conflict{
@@ -70,3 +70,61 @@ func (c *Conflict) EnsureValid() error {
 
 	return nil
 }
+
+func (w *ConflictWinner) UnmarshalText(textBytes []byte) error {
+	text := string(textBytes)
+
+	switch text {
+	case "alpha":
+		*w = ConflictWinner_ConflictWinnerAlpha
+	case "beta":
+		*w = ConflictWinner_ConflictWinnerBeta
+	default:
+		return errors.Errorf("unknown conflict winner specification: %s", text)
+	}
+
+	return nil
+}
+
+func (w ConflictWinner) Supported() bool {
+	switch w {
+	case ConflictWinner_ConflictWinnerAlpha:
+		return true
+	case ConflictWinner_ConflictWinnerBeta:
+		return true
+	default:
+		return false
+	}
+}
+
+func (w ConflictWinner) Description() string {
+	switch w {
+	case ConflictWinner_ConflictWinnerAlpha:
+		return "Alpha"
+	case ConflictWinner_ConflictWinnerBeta:
+		return "Beta"
+	default:
+		return "Unknown"
+	}
+}
+
+func (c *Conflict) Resolve(alpha, beta *Entry, winner ConflictWinner) ([]*Change, []*Change) {
+
+	root := c.Root()
+
+	alphaRoot := alpha.lookup(root)
+	betaRoot := beta.lookup(root)
+	if alphaRoot != nil && betaRoot != nil &&
+		alphaRoot.Kind == EntryKind_Directory && betaRoot.Kind == EntryKind_Directory {
+		alphaRoot, betaRoot = alphaRoot.copySlim(), betaRoot.copySlim()
+	}
+
+	switch winner {
+	case ConflictWinner_ConflictWinnerAlpha:
+		return nil, []*Change{{Path: root, Old: betaRoot, New: alphaRoot}}
+	case ConflictWinner_ConflictWinnerBeta:
+		return []*Change{{Path: root, Old: alphaRoot, New: betaRoot}}, nil
+	default:
+		panic("unhandled conflict winner")
+	}
+}
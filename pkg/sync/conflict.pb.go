@@ -13,6 +13,32 @@ var _ = math.Inf
 
 const _ = proto.ProtoPackageIsVersion2
 
+type ConflictWinner int32
+
+const (
+	ConflictWinner_ConflictWinnerInvalid ConflictWinner = 0
+	ConflictWinner_ConflictWinnerAlpha   ConflictWinner = 1
+	ConflictWinner_ConflictWinnerBeta    ConflictWinner = 2
+)
+
+var ConflictWinner_name = map[int32]string{
+	0: "ConflictWinnerInvalid",
+	1: "ConflictWinnerAlpha",
+	2: "ConflictWinnerBeta",
+}
+var ConflictWinner_value = map[string]int32{
+	"ConflictWinnerInvalid": 0,
+	"ConflictWinnerAlpha":   1,
+	"ConflictWinnerBeta":    2,
+}
+
+func (x ConflictWinner) String() string {
+	return proto.EnumName(ConflictWinner_name, int32(x))
+}
+func (ConflictWinner) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_conflict_714c531d11034400, []int{0}
+}
+
 type Conflict struct {
 	AlphaChanges         []*Change `protobuf:"bytes,1,rep,name=alphaChanges,proto3" json:"alphaChanges,omitempty"`
 	BetaChanges          []*Change `protobuf:"bytes,2,rep,name=betaChanges,proto3" json:"betaChanges,omitempty"`
@@ -25,7 +51,7 @@ func (m *Conflict) Reset()         { *m = Conflict{} }
 func (m *Conflict) String() string { return proto.CompactTextString(m) }
 func (*Conflict) ProtoMessage()    {}
 func (*Conflict) Descriptor() ([]byte, []int) {
-	return fileDescriptor_conflict_0fab4c0309eb9cac, []int{0}
+	return fileDescriptor_conflict_714c531d11034400, []int{0}
 }
 func (m *Conflict) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Conflict.Unmarshal(m, b)
@@ -61,19 +87,24 @@ func (m *Conflict) GetBetaChanges() []*Change {
 
 func init() {
 	proto.RegisterType((*Conflict)(nil), "sync.Conflict")
+	proto.RegisterEnum("sync.ConflictWinner", ConflictWinner_name, ConflictWinner_value)
 }
 
-func init() { proto.RegisterFile("sync/conflict.proto", fileDescriptor_conflict_0fab4c0309eb9cac) }
+func init() { proto.RegisterFile("sync/conflict.proto", fileDescriptor_conflict_714c531d11034400) }
 
-var fileDescriptor_conflict_0fab4c0309eb9cac = []byte{
+var fileDescriptor_conflict_714c531d11034400 = []byte{
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2e, 0xae, 0xcc, 0x4b,
 	0xd6, 0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0xc9, 0x4c, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0x62, 0x01, 0x09, 0x4a, 0x09, 0x42, 0xa4, 0x32, 0x12, 0xf3, 0xd2, 0x53, 0x21, 0x12, 0x4a, 0x39,
 	0x5c, 0x1c, 0xce, 0x50, 0xa5, 0x42, 0x06, 0x5c, 0x3c, 0x89, 0x39, 0x05, 0x19, 0x89, 0xce, 0x60,
 	0x05, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x3c, 0x7a, 0x20, 0x5d, 0x7a, 0x10, 0xc1,
 	0x20, 0x14, 0x15, 0x42, 0x7a, 0x5c, 0xdc, 0x49, 0xa9, 0x25, 0x70, 0x0d, 0x4c, 0x58, 0x34, 0x20,
-	0x2b, 0x70, 0x52, 0x8b, 0x52, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0xcf, 0x48, 0x2c, 0xcb, 0x4f, 0xd6, 0xcd, 0xcc, 0xd7, 0xcf, 0x2d, 0x2d, 0x49, 0x4c, 0x4f, 0xcd,
-	0xd3, 0x2f, 0xc8, 0x4e, 0xd7, 0x07, 0xe9, 0x4d, 0x62, 0x03, 0x3b, 0xce, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0x81, 0xc9, 0x55, 0xcc, 0x00, 0x00, 0x00,
+	0x2b, 0xd0, 0x8a, 0xe1, 0xe2, 0x83, 0xd9, 0x16, 0x9e, 0x99, 0x97, 0x97, 0x5a, 0x24, 0x24, 0xc9,
+	0x25, 0x8a, 0x2a, 0xe2, 0x99, 0x57, 0x96, 0x98, 0x93, 0x99, 0x22, 0xc0, 0x20, 0x24, 0xce, 0x25,
+	0x8c, 0x2a, 0xe5, 0x08, 0xb2, 0x5a, 0x80, 0x51, 0x48, 0x8c, 0x4b, 0x08, 0x55, 0xc2, 0x29, 0xb5,
+	0x24, 0x51, 0x80, 0xc9, 0x49, 0x3f, 0x4a, 0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
+	0x3f, 0x57, 0x3f, 0x28, 0x3f, 0xbb, 0xd2, 0xb5, 0x28, 0x33, 0x39, 0xbb, 0x38, 0x3f, 0x4f, 0x3f,
+	0x25, 0xbf, 0xa0, 0x20, 0x35, 0x27, 0x1d, 0xe4, 0x8a, 0x22, 0xfd, 0x82, 0xec, 0x74, 0x7d, 0x90,
+	0x13, 0x93, 0xd8, 0xc0, 0x61, 0x60, 0x0c, 0x18, 0x00, 0x56, 0x84, 0x2b, 0x05, 0x33, 0x01, 0x00,
+	0x00,
 }
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"testing"
+	"time"
 )
 
 func TestConflictCopySlim(t *testing.T) {
@@ -337,3 +338,168 @@ func TestConflictValid(t *testing.T) {
 		t.Error("valid conflict considered invalid:", err)
 	}
 }
+
+func TestConflictWinnerUnmarshal(t *testing.T) {
+	testCases := []struct {
+		Text           string
+		ExpectedWinner ConflictWinner
+		ExpectFailure  bool
+	}{
+		{"", ConflictWinner_ConflictWinnerInvalid, true},
+		{"asdf", ConflictWinner_ConflictWinnerInvalid, true},
+		{"alpha", ConflictWinner_ConflictWinnerAlpha, false},
+		{"beta", ConflictWinner_ConflictWinnerBeta, false},
+	}
+
+	for _, testCase := range testCases {
+		var winner ConflictWinner
+		if err := winner.UnmarshalText([]byte(testCase.Text)); err != nil {
+			if !testCase.ExpectFailure {
+				t.Errorf("unable to unmarshal text (%s): %s", testCase.Text, err)
+			}
+		} else if testCase.ExpectFailure {
+			t.Error("unmarshaling succeeded unexpectedly for text:", testCase.Text)
+		} else if winner != testCase.ExpectedWinner {
+			t.Errorf(
+				"unmarshaled winner (%s) does not match expected (%s)",
+				winner,
+				testCase.ExpectedWinner,
+			)
+		}
+	}
+}
+
+func TestConflictWinnerSupported(t *testing.T) {
+	testCases := []struct {
+		Winner          ConflictWinner
+		ExpectSupported bool
+	}{
+		{ConflictWinner_ConflictWinnerInvalid, false},
+		{ConflictWinner_ConflictWinnerAlpha, true},
+		{ConflictWinner_ConflictWinnerBeta, true},
+		{(ConflictWinner_ConflictWinnerBeta + 1), false},
+	}
+
+	for _, testCase := range testCases {
+		if supported := testCase.Winner.Supported(); supported != testCase.ExpectSupported {
+			t.Errorf(
+				"conflict winner supported status (%t) does not match expected (%t)",
+				supported,
+				testCase.ExpectSupported,
+			)
+		}
+	}
+}
+
+func TestConflictResolveAlphaWins(t *testing.T) {
+	alpha := &Entry{
+		Kind:     EntryKind_Directory,
+		Contents: map[string]*Entry{"file": testFile1Entry},
+	}
+	beta := &Entry{
+		Kind:     EntryKind_Directory,
+		Contents: map[string]*Entry{"file": testFile2Entry},
+	}
+
+	_, _, _, conflicts := Reconcile(
+		testEmptyDirectory,
+		alpha,
+		beta,
+		SynchronizationMode_SynchronizationModeTwoWaySafe,
+		time.Now(),
+	)
+	if len(conflicts) != 1 {
+		t.Fatal("unexpected number of conflicts:", len(conflicts))
+	}
+
+	alphaTransitions, betaTransitions := conflicts[0].Resolve(alpha, beta, ConflictWinner_ConflictWinnerAlpha)
+	if len(alphaTransitions) != 0 {
+		t.Error("alpha transitions generated for alpha winner")
+	}
+	if len(betaTransitions) != 1 {
+		t.Fatal("unexpected number of beta transitions:", len(betaTransitions))
+	}
+	transition := betaTransitions[0]
+	if transition.Path != "file" {
+		t.Error("beta transition has incorrect path:", transition.Path)
+	}
+	if !transition.Old.Equal(testFile2Entry) {
+		t.Error("beta transition has incorrect old entry")
+	}
+	if !transition.New.Equal(testFile1Entry) {
+		t.Error("beta transition has incorrect new entry")
+	}
+}
+
+func TestConflictResolveBetaWins(t *testing.T) {
+	conflict := &Conflict{
+		AlphaChanges: []*Change{{New: testFile1Entry}},
+		BetaChanges:  []*Change{{New: testDirectory1Entry}},
+	}
+
+	alphaTransitions, betaTransitions := conflict.Resolve(
+		testFile1Entry,
+		testDirectory1Entry,
+		ConflictWinner_ConflictWinnerBeta,
+	)
+	if len(betaTransitions) != 0 {
+		t.Error("beta transitions generated for beta winner")
+	}
+	if len(alphaTransitions) != 1 {
+		t.Fatal("unexpected number of alpha transitions:", len(alphaTransitions))
+	}
+	transition := alphaTransitions[0]
+	if transition.Path != "" {
+		t.Error("alpha transition has incorrect path:", transition.Path)
+	}
+	if !transition.Old.Equal(testFile1Entry) {
+		t.Error("alpha transition has incorrect old entry")
+	}
+	if !transition.New.Equal(testDirectory1Entry) {
+		t.Error("alpha transition has incorrect new entry")
+	}
+}
+
+func TestConflictResolveDirectoryMetadata(t *testing.T) {
+	ancestor := &Entry{
+		Kind:                EntryKind_Directory,
+		Contents:            map[string]*Entry{"file": testFile1Entry},
+		Permissions:         0755,
+		PermissionsRecorded: true,
+	}
+	alpha := &Entry{
+		Kind:                EntryKind_Directory,
+		Contents:            map[string]*Entry{"file": testFile1Entry},
+		Permissions:         0700,
+		PermissionsRecorded: true,
+	}
+	beta := &Entry{
+		Kind:                EntryKind_Directory,
+		Contents:            map[string]*Entry{"file": testFile1Entry, "other": testFile2Entry},
+		Permissions:         0750,
+		PermissionsRecorded: true,
+	}
+
+	_, _, _, conflicts := Reconcile(
+		ancestor,
+		alpha,
+		beta,
+		SynchronizationMode_SynchronizationModeTwoWaySafe,
+		time.Now(),
+	)
+	if len(conflicts) != 1 {
+		t.Fatal("unexpected number of conflicts:", len(conflicts))
+	}
+
+	_, betaTransitions := conflicts[0].Resolve(alpha, beta, ConflictWinner_ConflictWinnerAlpha)
+	if len(betaTransitions) != 1 {
+		t.Fatal("unexpected number of beta transitions:", len(betaTransitions))
+	}
+	transition := betaTransitions[0]
+	if !transition.Old.Equal(beta.copySlim()) {
+		t.Error("beta transition has incorrect old entry")
+	}
+	if !transition.New.Equal(alpha.copySlim()) {
+		t.Error("beta transition has incorrect new entry")
+	}
+}
@@ -0,0 +1,48 @@
+package sync
+
+import (
+	"github.com/pkg/errors"
+)
+
+func (m DeletionMode) IsDefault() bool {
+	return m == DeletionMode_DeletionModeDefault
+}
+
+func (m *DeletionMode) UnmarshalText(textBytes []byte) error {
+	text := string(textBytes)
+
+	switch text {
+	case "permanent":
+		*m = DeletionMode_DeletionModePermanent
+	case "trash":
+		*m = DeletionMode_DeletionModeTrash
+	default:
+		return errors.Errorf("unknown deletion mode specification: %s", text)
+	}
+
+	return nil
+}
+
+func (m DeletionMode) Supported() bool {
+	switch m {
+	case DeletionMode_DeletionModePermanent:
+		return true
+	case DeletionMode_DeletionModeTrash:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m DeletionMode) Description() string {
+	switch m {
+	case DeletionMode_DeletionModeDefault:
+		return "Default"
+	case DeletionMode_DeletionModePermanent:
+		return "Permanent"
+	case DeletionMode_DeletionModeTrash:
+		return "Trash"
+	default:
+		return "Unknown"
+	}
+}
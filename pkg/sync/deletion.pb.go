@@ -0,0 +1,59 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sync/deletion.proto
+
+package sync // import "github.com/RokyErickson/doppelganger/pkg/sync"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+const _ = proto.ProtoPackageIsVersion2
+
+type DeletionMode int32
+
+const (
+	DeletionMode_DeletionModeDefault   DeletionMode = 0
+	DeletionMode_DeletionModePermanent DeletionMode = 1
+	DeletionMode_DeletionModeTrash     DeletionMode = 2
+)
+
+var DeletionMode_name = map[int32]string{
+	0: "DeletionModeDefault",
+	1: "DeletionModePermanent",
+	2: "DeletionModeTrash",
+}
+var DeletionMode_value = map[string]int32{
+	"DeletionModeDefault":   0,
+	"DeletionModePermanent": 1,
+	"DeletionModeTrash":     2,
+}
+
+func (x DeletionMode) String() string {
+	return proto.EnumName(DeletionMode_name, int32(x))
+}
+func (DeletionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_deletion_0fa67cdc0cdcb165, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("sync.DeletionMode", DeletionMode_name, DeletionMode_value)
+}
+
+func init() { proto.RegisterFile("sync/deletion.proto", fileDescriptor_deletion_0fa67cdc0cdcb165) }
+
+var fileDescriptor_deletion_0fa67cdc0cdcb165 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2e, 0xae, 0xcc, 0x4b,
+	0xd6, 0x4f, 0x49, 0xcd, 0x49, 0x2d, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+	0x62, 0x01, 0x09, 0x6a, 0x45, 0x72, 0xf1, 0xb8, 0x40, 0xc5, 0x7d, 0xf3, 0x53, 0x52, 0x85, 0xc4,
+	0xb9, 0x84, 0x91, 0xf9, 0x2e, 0xa9, 0x69, 0x89, 0xa5, 0x39, 0x25, 0x02, 0x0c, 0x42, 0x92, 0x5c,
+	0xa2, 0xc8, 0x12, 0x01, 0xa9, 0x45, 0xb9, 0x89, 0x79, 0xa9, 0x79, 0x25, 0x02, 0x8c, 0x42, 0xa2,
+	0x5c, 0x82, 0xc8, 0x52, 0x21, 0x45, 0x89, 0xc5, 0x19, 0x02, 0x4c, 0x4e, 0xfa, 0x51, 0xba, 0xe9,
+	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x41, 0xf9, 0xd9, 0x95, 0xae, 0x45,
+	0x99, 0xc9, 0xd9, 0xc5, 0xf9, 0x79, 0xfa, 0x29, 0xf9, 0x05, 0x05, 0xa9, 0x39, 0xe9, 0x89, 0x79,
+	0xe9, 0xa9, 0x45, 0xfa, 0x05, 0xd9, 0xe9, 0xfa, 0x20, 0xb7, 0x24, 0xb1, 0x81, 0x1d, 0x66, 0x0c,
+	0x18, 0x00, 0x9d, 0xaa, 0xba, 0x53, 0xaf, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"testing"
+)
+
+func TestDeletionModeUnmarshal(t *testing.T) {
+	testCases := []struct {
+		Text          string
+		ExpectedMode  DeletionMode
+		ExpectFailure bool
+	}{
+		{"", DeletionMode_DeletionModeDefault, true},
+		{"asdf", DeletionMode_DeletionModeDefault, true},
+		{"permanent", DeletionMode_DeletionModePermanent, false},
+		{"trash", DeletionMode_DeletionModeTrash, false},
+	}
+
+	for _, testCase := range testCases {
+		var mode DeletionMode
+		if err := mode.UnmarshalText([]byte(testCase.Text)); err != nil {
+			if !testCase.ExpectFailure {
+				t.Errorf("unable to unmarshal text (%s): %s", testCase.Text, err)
+			}
+		} else if testCase.ExpectFailure {
+			t.Error("unmarshaling succeeded unexpectedly for text:", testCase.Text)
+		} else if mode != testCase.ExpectedMode {
+			t.Errorf(
+				"unmarshaled mode (%s) does not match expected (%s)",
+				mode,
+				testCase.ExpectedMode,
+			)
+		}
+	}
+}
+
+func TestDeletionModeSupported(t *testing.T) {
+	testCases := []struct {
+		Mode            DeletionMode
+		ExpectSupported bool
+	}{
+		{DeletionMode_DeletionModeDefault, false},
+		{DeletionMode_DeletionModePermanent, true},
+		{DeletionMode_DeletionModeTrash, true},
+		{(DeletionMode_DeletionModeTrash + 1), false},
+	}
+
+	for _, testCase := range testCases {
+		if supported := testCase.Mode.Supported(); supported != testCase.ExpectSupported {
+			t.Errorf(
+				"mode support status (%t) does not match expected (%t)",
+				supported,
+				testCase.ExpectSupported,
+			)
+		}
+	}
+}
+
+func TestDeletionModeDescription(t *testing.T) {
+	testCases := []struct {
+		Mode                DeletionMode
+		ExpectedDescription string
+	}{
+		{DeletionMode_DeletionModeDefault, "Default"},
+		{DeletionMode_DeletionModePermanent, "Permanent"},
+		{DeletionMode_DeletionModeTrash, "Trash"},
+		{(DeletionMode_DeletionModeTrash + 1), "Unknown"},
+	}
+
+	for _, testCase := range testCases {
+		if description := testCase.Mode.Description(); description != testCase.ExpectedDescription {
+			t.Errorf(
+				"mode description (%s) does not match expected (%s)",
+				description,
+				testCase.ExpectedDescription,
+			)
+		}
+	}
+}
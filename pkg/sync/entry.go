@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+
+	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
 )
 
 func (e *Entry) EnsureValid() error {
@@ -20,6 +22,16 @@ func (e *Entry) EnsureValid() error {
 			return errors.New("non-nil directory digest detected")
 		} else if e.Target != "" {
 			return errors.New("non-empty symlink target detected for directory")
+		} else if e.ModificationTime != nil {
+			return errors.New("non-nil modification time detected for directory")
+		} else if e.Permissions&^uint32(fs.ModePermissionsMask) != 0 {
+			return errors.New("non-permission bits detected in directory permissions")
+		} else if e.Permissions != 0 && !e.PermissionsRecorded {
+			return errors.New("unrecorded directory permissions detected")
+		} else if len(e.ExtendedAttributes) > 0 && len(e.ExtendedAttributesDigest) == 0 {
+			return errors.New("directory extended attributes without digest detected")
+		} else if e.HardLinkGroup != "" {
+			return errors.New("non-empty hard link group detected for directory")
 		}
 
 		for name, entry := range e.Contents {
@@ -43,6 +55,12 @@ func (e *Entry) EnsureValid() error {
 
 		if len(e.Digest) == 0 {
 			return errors.New("file with empty digest detected")
+		} else if e.Permissions&^uint32(fs.ModePermissionsMask) != 0 {
+			return errors.New("non-permission bits detected in file permissions")
+		} else if e.Permissions != 0 && !e.PermissionsRecorded {
+			return errors.New("unrecorded file permissions detected")
+		} else if len(e.ExtendedAttributes) > 0 && len(e.ExtendedAttributesDigest) == 0 {
+			return errors.New("file extended attributes without digest detected")
 		}
 	} else if e.Kind == EntryKind_Symlink {
 
@@ -52,6 +70,16 @@ func (e *Entry) EnsureValid() error {
 			return errors.New("non-nil symlink digest detected")
 		} else if e.Contents != nil {
 			return errors.New("non-nil symlink contents detected")
+		} else if e.ModificationTime != nil {
+			return errors.New("non-nil modification time detected for symlink")
+		} else if e.Permissions != 0 || e.PermissionsRecorded {
+			return errors.New("permissions detected for symlink")
+		} else if e.Owner != "" || e.Group != "" {
+			return errors.New("non-empty ownership detected for symlink")
+		} else if e.ExtendedAttributes != nil || e.ExtendedAttributesDigest != nil {
+			return errors.New("non-nil extended attributes detected for symlink")
+		} else if e.HardLinkGroup != "" {
+			return errors.New("non-empty hard link group detected for symlink")
 		}
 
 		if e.Target == "" {
@@ -83,6 +111,22 @@ func (e *Entry) Count() uint64 {
 	return result
 }
 
+func (e *Entry) lookup(path string) *Entry {
+
+	if path == "" {
+		return e
+	}
+
+	for _, component := range strings.Split(path, "/") {
+		if e == nil || e.Kind != EntryKind_Directory {
+			return nil
+		}
+		e = e.Contents[component]
+	}
+
+	return e
+}
+
 func (e *Entry) equalShallow(other *Entry) bool {
 
 	if e == nil && other == nil {
@@ -93,10 +137,64 @@ func (e *Entry) equalShallow(other *Entry) bool {
 		return false
 	}
 
+	return e.equalShallowExceptModificationTime(other) && e.equalModificationTimes(other)
+}
+
+func (e *Entry) equalShallowExceptModificationTime(other *Entry) bool {
+
+	if e == nil && other == nil {
+		return true
+	}
+
+	if e == nil || other == nil {
+		return false
+	}
+
 	return e.Kind == other.Kind &&
 		e.Executable == other.Executable &&
 		bytes.Equal(e.Digest, other.Digest) &&
-		e.Target == other.Target
+		e.Target == other.Target &&
+		e.equalPermissions(other) &&
+		e.equalExtendedAttributes(other)
+}
+
+func (e *Entry) newerThan(other *Entry) bool {
+	if e.ModificationTime == nil || other.ModificationTime == nil {
+		return false
+	}
+
+	return e.ModificationTime.Seconds > other.ModificationTime.Seconds ||
+		(e.ModificationTime.Seconds == other.ModificationTime.Seconds &&
+			e.ModificationTime.Nanos > other.ModificationTime.Nanos)
+}
+
+func (e *Entry) equalModificationTimes(other *Entry) bool {
+	if e.ModificationTime == nil || other.ModificationTime == nil {
+		return true
+	}
+
+	return e.ModificationTime.Seconds == other.ModificationTime.Seconds &&
+		e.ModificationTime.Nanos == other.ModificationTime.Nanos
+}
+
+func (e *Entry) equalPermissions(other *Entry) bool {
+	if e.PermissionsRecorded && other.PermissionsRecorded && e.Permissions != other.Permissions {
+		return false
+	}
+
+	if e.Owner != "" && other.Owner != "" && e.Owner != other.Owner {
+		return false
+	}
+
+	return e.Group == "" || other.Group == "" || e.Group == other.Group
+}
+
+func (e *Entry) equalExtendedAttributes(other *Entry) bool {
+	if len(e.ExtendedAttributesDigest) == 0 || len(other.ExtendedAttributesDigest) == 0 {
+		return true
+	}
+
+	return bytes.Equal(e.ExtendedAttributesDigest, other.ExtendedAttributesDigest)
 }
 
 func (e *Entry) Equal(other *Entry) bool {
@@ -127,10 +225,18 @@ func (e *Entry) copySlim() *Entry {
 	}
 
 	return &Entry{
-		Kind:       e.Kind,
-		Executable: e.Executable,
-		Digest:     e.Digest,
-		Target:     e.Target,
+		Kind:                     e.Kind,
+		Executable:               e.Executable,
+		Digest:                   e.Digest,
+		Target:                   e.Target,
+		ModificationTime:         e.ModificationTime,
+		Permissions:              e.Permissions,
+		PermissionsRecorded:      e.PermissionsRecorded,
+		Owner:                    e.Owner,
+		Group:                    e.Group,
+		ExtendedAttributes:       e.ExtendedAttributes,
+		ExtendedAttributesDigest: e.ExtendedAttributesDigest,
+		HardLinkGroup:            e.HardLinkGroup,
 	}
 }
 
@@ -140,10 +246,18 @@ func (e *Entry) Copy() *Entry {
 	}
 
 	result := &Entry{
-		Kind:       e.Kind,
-		Executable: e.Executable,
-		Digest:     e.Digest,
-		Target:     e.Target,
+		Kind:                     e.Kind,
+		Executable:               e.Executable,
+		Digest:                   e.Digest,
+		Target:                   e.Target,
+		ModificationTime:         e.ModificationTime,
+		Permissions:              e.Permissions,
+		PermissionsRecorded:      e.PermissionsRecorded,
+		Owner:                    e.Owner,
+		Group:                    e.Group,
+		ExtendedAttributes:       e.ExtendedAttributes,
+		ExtendedAttributesDigest: e.ExtendedAttributesDigest,
+		HardLinkGroup:            e.HardLinkGroup,
 	}
 
 	if len(e.Contents) == 0 {
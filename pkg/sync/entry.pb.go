@@ -6,6 +6,7 @@ package sync // import "github.com/RokyErickson/doppelganger/pkg/sync"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 var _ = proto.Marshal
 var _ = fmt.Errorf
@@ -36,25 +37,33 @@ func (x EntryKind) String() string {
 	return proto.EnumName(EntryKind_name, int32(x))
 }
 func (EntryKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_entry_29deca7dbfaed434, []int{0}
+	return fileDescriptor_entry_3bbb0e612f59dfe3, []int{0}
 }
 
 type Entry struct {
-	Kind                 EntryKind         `protobuf:"varint,1,opt,name=kind,proto3,enum=sync.EntryKind" json:"kind,omitempty"`
-	Contents             map[string]*Entry `protobuf:"bytes,5,rep,name=contents,proto3" json:"contents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Digest               []byte            `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`
-	Executable           bool              `protobuf:"varint,9,opt,name=executable,proto3" json:"executable,omitempty"`
-	Target               string            `protobuf:"bytes,12,opt,name=target,proto3" json:"target,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Kind                     EntryKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=sync.EntryKind" json:"kind,omitempty"`
+	Contents                 map[string]*Entry    `protobuf:"bytes,5,rep,name=contents,proto3" json:"contents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Digest                   []byte               `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`
+	Executable               bool                 `protobuf:"varint,9,opt,name=executable,proto3" json:"executable,omitempty"`
+	Target                   string               `protobuf:"bytes,12,opt,name=target,proto3" json:"target,omitempty"`
+	ModificationTime         *timestamp.Timestamp `protobuf:"bytes,13,opt,name=modificationTime,proto3" json:"modificationTime,omitempty"`
+	Permissions              uint32               `protobuf:"varint,14,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Owner                    string               `protobuf:"bytes,15,opt,name=owner,proto3" json:"owner,omitempty"`
+	Group                    string               `protobuf:"bytes,16,opt,name=group,proto3" json:"group,omitempty"`
+	ExtendedAttributes       map[string][]byte    `protobuf:"bytes,17,rep,name=extendedAttributes,proto3" json:"extendedAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExtendedAttributesDigest []byte               `protobuf:"bytes,18,opt,name=extendedAttributesDigest,proto3" json:"extendedAttributesDigest,omitempty"`
+	HardLinkGroup            string               `protobuf:"bytes,19,opt,name=hardLinkGroup,proto3" json:"hardLinkGroup,omitempty"`
+	PermissionsRecorded      bool                 `protobuf:"varint,20,opt,name=permissionsRecorded,proto3" json:"permissionsRecorded,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}             `json:"-"`
+	XXX_unrecognized         []byte               `json:"-"`
+	XXX_sizecache            int32                `json:"-"`
 }
 
 func (m *Entry) Reset()         { *m = Entry{} }
 func (m *Entry) String() string { return proto.CompactTextString(m) }
 func (*Entry) ProtoMessage()    {}
 func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_entry_29deca7dbfaed434, []int{0}
+	return fileDescriptor_entry_3bbb0e612f59dfe3, []int{0}
 }
 func (m *Entry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Entry.Unmarshal(m, b)
@@ -109,31 +118,100 @@ func (m *Entry) GetTarget() string {
 	return ""
 }
 
+func (m *Entry) GetModificationTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModificationTime
+	}
+	return nil
+}
+
+func (m *Entry) GetPermissions() uint32 {
+	if m != nil {
+		return m.Permissions
+	}
+	return 0
+}
+
+func (m *Entry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Entry) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *Entry) GetExtendedAttributes() map[string][]byte {
+	if m != nil {
+		return m.ExtendedAttributes
+	}
+	return nil
+}
+
+func (m *Entry) GetExtendedAttributesDigest() []byte {
+	if m != nil {
+		return m.ExtendedAttributesDigest
+	}
+	return nil
+}
+
+func (m *Entry) GetHardLinkGroup() string {
+	if m != nil {
+		return m.HardLinkGroup
+	}
+	return ""
+}
+
+func (m *Entry) GetPermissionsRecorded() bool {
+	if m != nil {
+		return m.PermissionsRecorded
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Entry)(nil), "sync.Entry")
 	proto.RegisterMapType((map[string]*Entry)(nil), "sync.Entry.ContentsEntry")
+	proto.RegisterMapType((map[string][]byte)(nil), "sync.Entry.ExtendedAttributesEntry")
 	proto.RegisterEnum("sync.EntryKind", EntryKind_name, EntryKind_value)
 }
 
-func init() { proto.RegisterFile("sync/entry.proto", fileDescriptor_entry_29deca7dbfaed434) }
-
-var fileDescriptor_entry_29deca7dbfaed434 = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xdb, 0x4b, 0xc3, 0x30,
-	0x18, 0xc5, 0xcd, 0x6e, 0xb6, 0x5f, 0x37, 0x2d, 0x79, 0x90, 0xe8, 0x83, 0xc4, 0x0b, 0x12, 0x04,
-	0x5b, 0x9c, 0x08, 0xe2, 0xa3, 0x37, 0x04, 0xdf, 0xe2, 0x9b, 0x6f, 0x5d, 0xf6, 0xd1, 0x85, 0x76,
-	0xc9, 0xe8, 0xd2, 0x61, 0xff, 0x78, 0x41, 0x52, 0xc7, 0xa8, 0x6f, 0x39, 0xe7, 0x77, 0xc2, 0x39,
-	0x09, 0xc4, 0xeb, 0xc6, 0xa8, 0x14, 0x8d, 0xab, 0x9a, 0x64, 0x55, 0x59, 0x67, 0xe9, 0xc0, 0x3b,
-	0xe7, 0x3f, 0x04, 0x86, 0xaf, 0xde, 0xa5, 0x17, 0x30, 0x28, 0xb4, 0x99, 0x33, 0xc2, 0x89, 0x38,
-	0x98, 0x1e, 0x26, 0x1e, 0x27, 0x2d, 0xfa, 0xd0, 0x66, 0x2e, 0x5b, 0x48, 0xef, 0x21, 0x50, 0xd6,
-	0x38, 0x34, 0x6e, 0xcd, 0x86, 0xbc, 0x2f, 0xa2, 0xe9, 0x71, 0x27, 0x98, 0x3c, 0x6f, 0x59, 0xab,
-	0xe4, 0x2e, 0x4a, 0x8f, 0x60, 0x34, 0xd7, 0x39, 0xae, 0x1d, 0x0b, 0x38, 0x11, 0x63, 0xb9, 0x55,
-	0xf4, 0x14, 0x00, 0xbf, 0x51, 0xd5, 0x2e, 0x9b, 0x95, 0xc8, 0x42, 0x4e, 0x44, 0x20, 0x3b, 0x8e,
-	0xbf, 0xe7, 0xb2, 0x2a, 0x47, 0xc7, 0xc6, 0x9c, 0x88, 0x50, 0x6e, 0xd5, 0xc9, 0x3b, 0x4c, 0xfe,
-	0x55, 0xd1, 0x18, 0xfa, 0x05, 0x36, 0xed, 0xf6, 0x50, 0xfa, 0x23, 0x3d, 0x83, 0xe1, 0x26, 0x2b,
-	0x6b, 0x64, 0x3d, 0x4e, 0x44, 0x34, 0x8d, 0x3a, 0x33, 0xe5, 0x1f, 0x79, 0xec, 0x3d, 0x90, 0xeb,
-	0x5b, 0x08, 0x77, 0x6f, 0xa4, 0x13, 0x08, 0x5f, 0x74, 0x85, 0xca, 0xd9, 0xaa, 0x89, 0xf7, 0x68,
-	0x00, 0x83, 0x37, 0x5d, 0x62, 0x4c, 0x68, 0x04, 0xfb, 0x9f, 0xcd, 0xb2, 0xd4, 0xa6, 0x88, 0x7b,
-	0x4f, 0x57, 0x5f, 0x97, 0xb9, 0x76, 0x8b, 0x7a, 0x96, 0x28, 0xbb, 0x4c, 0x17, 0xd9, 0xc6, 0xaa,
-	0x1b, 0x6d, 0xd3, 0x65, 0xed, 0xb2, 0x1c, 0x4d, 0xba, 0x2a, 0xf2, 0xd4, 0x77, 0xcd, 0x46, 0xed,
-	0x3f, 0xdf, 0xfd, 0x06, 0x00, 0x00, 0xff, 0xff, 0x12, 0x4a, 0xdb, 0x26, 0x7b, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("sync/entry.proto", fileDescriptor_entry_3bbb0e612f59dfe3) }
+
+var fileDescriptor_entry_3bbb0e612f59dfe3 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x6d, 0x52, 0x92, 0x49, 0xdc, 0x9a, 0x6d, 0x04, 0x4b, 0x0e, 0x60, 0x28, 0x07, 0x0b,
+	0x09, 0x1b, 0x82, 0x90, 0x50, 0x6f, 0x40, 0x53, 0x90, 0xe0, 0xb4, 0xed, 0x89, 0x9b, 0xed, 0x9d,
+	0xba, 0x2b, 0xdb, 0xbb, 0xd6, 0x7a, 0x0d, 0xf5, 0xf7, 0xf1, 0x63, 0xc8, 0xeb, 0xb4, 0x72, 0x95,
+	0xf4, 0xb6, 0xf3, 0xde, 0xbc, 0xd5, 0xbc, 0x37, 0x03, 0x5e, 0xdd, 0xca, 0x34, 0x42, 0x69, 0x74,
+	0x1b, 0x56, 0x5a, 0x19, 0x45, 0x46, 0x1d, 0xb2, 0x7c, 0x99, 0x29, 0x95, 0x15, 0x18, 0x59, 0x2c,
+	0x69, 0xae, 0x22, 0x23, 0x4a, 0xac, 0x4d, 0x5c, 0x56, 0x7d, 0xdb, 0xeb, 0x7f, 0x63, 0x18, 0xaf,
+	0x3b, 0x19, 0x39, 0x81, 0x51, 0x2e, 0x24, 0xa7, 0x8e, 0xef, 0x04, 0x87, 0xab, 0xa3, 0xb0, 0xd3,
+	0x87, 0x96, 0xfa, 0x29, 0x24, 0x67, 0x96, 0x24, 0x9f, 0x60, 0x92, 0x2a, 0x69, 0x50, 0x9a, 0x9a,
+	0x8e, 0xfd, 0xfd, 0x60, 0xb6, 0x7a, 0x3e, 0x68, 0x0c, 0xbf, 0x6d, 0x38, 0x5b, 0xb1, 0xbb, 0x56,
+	0xf2, 0x14, 0x0e, 0xb8, 0xc8, 0xb0, 0x36, 0x74, 0xe2, 0x3b, 0xc1, 0x9c, 0x6d, 0x2a, 0xf2, 0x02,
+	0x00, 0x6f, 0x30, 0x6d, 0x4c, 0x9c, 0x14, 0x48, 0xa7, 0xbe, 0x13, 0x4c, 0xd8, 0x00, 0xe9, 0x74,
+	0x26, 0xd6, 0x19, 0x1a, 0x3a, 0xf7, 0x9d, 0x60, 0xca, 0x36, 0x15, 0x39, 0x07, 0xaf, 0x54, 0x5c,
+	0x5c, 0x89, 0x34, 0x36, 0x42, 0xc9, 0x4b, 0x51, 0x22, 0x75, 0x7d, 0x27, 0x98, 0xad, 0x96, 0x61,
+	0xef, 0x38, 0xbc, 0x75, 0x1c, 0x5e, 0xde, 0x3a, 0x66, 0x5b, 0x1a, 0xe2, 0xc3, 0xac, 0x42, 0x5d,
+	0x8a, 0xba, 0x16, 0x4a, 0xd6, 0xf4, 0xd0, 0x77, 0x02, 0x97, 0x0d, 0x21, 0xb2, 0x80, 0xb1, 0xfa,
+	0x2b, 0x51, 0xd3, 0x23, 0x3b, 0x40, 0x5f, 0x74, 0x68, 0xa6, 0x55, 0x53, 0x51, 0xaf, 0x47, 0x6d,
+	0x41, 0x2e, 0x80, 0xe0, 0x8d, 0x41, 0xc9, 0x91, 0x7f, 0x31, 0x46, 0x8b, 0xa4, 0x31, 0x58, 0xd3,
+	0x27, 0x36, 0xa6, 0x93, 0x61, 0x4c, 0xeb, 0xad, 0xae, 0x3e, 0xb0, 0x1d, 0x72, 0x72, 0x0a, 0x74,
+	0x1b, 0x3d, 0xeb, 0xc3, 0x24, 0x36, 0xcc, 0x07, 0x79, 0xf2, 0x06, 0xdc, 0xeb, 0x58, 0xf3, 0x5f,
+	0x42, 0xe6, 0xdf, 0xed, 0xb8, 0xc7, 0x76, 0xdc, 0xfb, 0x20, 0x79, 0x0f, 0xc7, 0x03, 0xc7, 0x0c,
+	0x53, 0xa5, 0x39, 0x72, 0xba, 0xb0, 0xdb, 0xd8, 0x45, 0x2d, 0x7f, 0x80, 0x7b, 0x6f, 0xd3, 0xc4,
+	0x83, 0xfd, 0x1c, 0x5b, 0x7b, 0x3a, 0x53, 0xd6, 0x3d, 0xc9, 0x2b, 0x18, 0xff, 0x89, 0x8b, 0x06,
+	0xe9, 0x9e, 0x5d, 0xcb, 0x6c, 0x60, 0x9f, 0xf5, 0xcc, 0xe9, 0xde, 0x67, 0x67, 0xb9, 0x86, 0x67,
+	0x0f, 0x84, 0xb1, 0xe3, 0xcf, 0xc5, 0xf0, 0xcf, 0xf9, 0xe0, 0x9b, 0xb7, 0x1f, 0x60, 0x7a, 0x77,
+	0xa9, 0xc4, 0x85, 0xe9, 0x99, 0xd0, 0x98, 0x1a, 0xa5, 0x5b, 0xef, 0x11, 0x99, 0xc0, 0xe8, 0x5c,
+	0x14, 0xe8, 0x39, 0x64, 0x06, 0x8f, 0x2f, 0xda, 0xb2, 0x10, 0x32, 0xf7, 0xf6, 0xbe, 0x46, 0xbf,
+	0xdf, 0x65, 0xc2, 0x5c, 0x37, 0x49, 0x98, 0xaa, 0x32, 0x62, 0x2a, 0x6f, 0xd7, 0x5a, 0xa4, 0x79,
+	0xad, 0x64, 0xc4, 0x55, 0x55, 0x61, 0x91, 0xc5, 0x32, 0x43, 0x1d, 0x55, 0x79, 0x16, 0x75, 0xb3,
+	0x27, 0x07, 0xf6, 0xa2, 0x3e, 0xfe, 0x1f, 0x00, 0xc4, 0x34, 0xdc, 0x89, 0x6b, 0x03, 0x00, 0x00,
 }
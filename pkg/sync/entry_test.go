@@ -2,6 +2,8 @@ package sync
 
 import (
 	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 func TestEntryNilValid(t *testing.T) {
@@ -218,6 +220,30 @@ func TestEntryCountHierarchy(t *testing.T) {
 	}
 }
 
+func TestEntryLookupRoot(t *testing.T) {
+	if entry := testDirectory1Entry.lookup(""); entry != testDirectory1Entry {
+		t.Error("root lookup did not return root entry")
+	}
+}
+
+func TestEntryLookupNested(t *testing.T) {
+	if entry := testDirectory1Entry.lookup("directory/subfile"); entry != testFile3Entry {
+		t.Error("nested lookup did not return expected entry")
+	}
+}
+
+func TestEntryLookupNonExistent(t *testing.T) {
+	if entry := testDirectory1Entry.lookup("directory/missing"); entry != nil {
+		t.Error("lookup of non-existent path returned non-nil entry")
+	}
+}
+
+func TestEntryLookupThroughFile(t *testing.T) {
+	if entry := testDirectory1Entry.lookup("file/child"); entry != nil {
+		t.Error("lookup through file returned non-nil entry")
+	}
+}
+
 func TestEntryNilNilEqualShallow(t *testing.T) {
 	if !testNilEntry.equalShallow(testNilEntry) {
 		t.Error("two nil entries not considered shallow equal")
@@ -412,3 +438,157 @@ func TestEntrySymlinkCopy(t *testing.T) {
 		t.Error("copy of symlink not considered equal to original")
 	}
 }
+
+func TestEntryDirectoryModificationTimeInvalid(t *testing.T) {
+	directory := &Entry{
+		Kind:             EntryKind_Directory,
+		ModificationTime: &timestamp.Timestamp{Seconds: 1},
+	}
+	if directory.EnsureValid() == nil {
+		t.Fatal("directory with modification time set considered valid")
+	}
+}
+
+func TestEntryEqualModificationTime(t *testing.T) {
+	first := testFile1Entry.Copy()
+	first.ModificationTime = &timestamp.Timestamp{Seconds: 1}
+	second := testFile1Entry.Copy()
+	second.ModificationTime = &timestamp.Timestamp{Seconds: 2}
+	if first.Equal(second) {
+		t.Error("entries differing in modification time considered equal")
+	}
+	if !first.Equal(testFile1Entry) {
+		t.Error("entry without recorded modification time considered unequal")
+	}
+	if copied := first.Copy(); copied.ModificationTime != first.ModificationTime {
+		t.Error("modification time not copied")
+	}
+}
+
+func TestEntrySymlinkPermissionsInvalid(t *testing.T) {
+	symlink := &Entry{
+		Kind:        EntryKind_Symlink,
+		Target:      "file",
+		Permissions: 0644,
+	}
+	if symlink.EnsureValid() == nil {
+		t.Fatal("symlink with permissions set considered valid")
+	}
+}
+
+func TestEntryFilePermissionsNonPermissionBitsInvalid(t *testing.T) {
+	file := testFile1Entry.Copy()
+	file.Permissions = 04755
+	if file.EnsureValid() == nil {
+		t.Fatal("file with non-permission bits in permissions considered valid")
+	}
+}
+
+func TestEntryEqualPermissions(t *testing.T) {
+	first := testFile1Entry.Copy()
+	first.Permissions = 0644
+	first.PermissionsRecorded = true
+	first.Owner = "id:1000"
+	second := testFile1Entry.Copy()
+	second.Permissions = 0600
+	second.PermissionsRecorded = true
+	second.Owner = "id:1000"
+	if first.Equal(second) {
+		t.Error("files differing in permissions considered equal")
+	}
+
+	second.Permissions = 0644
+	second.Owner = "id:1001"
+	if first.Equal(second) {
+		t.Error("files differing in owner considered equal")
+	}
+
+	if !first.Equal(testFile1Entry) {
+		t.Error("file without recorded permissions considered unequal")
+	}
+
+	if copied := first.Copy(); copied.Permissions != first.Permissions ||
+		copied.PermissionsRecorded != first.PermissionsRecorded ||
+		copied.Owner != first.Owner {
+		t.Error("permissions not copied")
+	}
+}
+
+func TestEntryEqualDirectoryPermissions(t *testing.T) {
+	first := &Entry{Permissions: 0755, PermissionsRecorded: true}
+	second := &Entry{Permissions: 0700, PermissionsRecorded: true}
+	if first.Equal(second) {
+		t.Error("directories differing in permissions considered equal")
+	}
+
+	second.Permissions = 0
+	if first.Equal(second) {
+		t.Error("directory with zero permissions considered equal")
+	}
+
+	if !first.Equal(&Entry{}) {
+		t.Error("directory without recorded permissions considered unequal")
+	}
+}
+
+func TestEntryUnrecordedPermissionsInvalid(t *testing.T) {
+	file := testFile1Entry.Copy()
+	file.Permissions = 0644
+	if file.EnsureValid() == nil {
+		t.Error("file with unrecorded permissions considered valid")
+	}
+}
+
+func TestEntrySymlinkExtendedAttributesInvalid(t *testing.T) {
+	symlink := &Entry{
+		Kind:                     EntryKind_Symlink,
+		Target:                   "file",
+		ExtendedAttributesDigest: extendedAttributesDigest(nil),
+	}
+	if symlink.EnsureValid() == nil {
+		t.Fatal("symlink with extended attributes considered valid")
+	}
+}
+
+func TestEntryEqualExtendedAttributes(t *testing.T) {
+	first := testFile1Entry.Copy()
+	first.ExtendedAttributes = map[string][]byte{"user.comment": []byte("first")}
+	first.ExtendedAttributesDigest = extendedAttributesDigest(first.ExtendedAttributes)
+	second := testFile1Entry.Copy()
+	second.ExtendedAttributesDigest = extendedAttributesDigest(nil)
+	if first.Equal(second) {
+		t.Error("files differing in extended attributes considered equal")
+	}
+
+	if !first.Equal(testFile1Entry) {
+		t.Error("file without recorded extended attributes considered unequal")
+	}
+
+	second.ExtendedAttributes = map[string][]byte{"user.comment": []byte("first")}
+	second.ExtendedAttributesDigest = extendedAttributesDigest(second.ExtendedAttributes)
+	if !first.Equal(second) {
+		t.Error("files with identical extended attributes considered unequal")
+	}
+}
+
+func TestEntryEqualDirectoryExtendedAttributes(t *testing.T) {
+	first := &Entry{
+		Kind:               EntryKind_Directory,
+		ExtendedAttributes: map[string][]byte{"user.comment": []byte("first")},
+	}
+	first.ExtendedAttributesDigest = extendedAttributesDigest(first.ExtendedAttributes)
+	second := &Entry{
+		Kind:                     EntryKind_Directory,
+		ExtendedAttributesDigest: extendedAttributesDigest(nil),
+	}
+
+	if first.equalShallow(second) {
+		t.Error("directories with differing extended attributes considered equal")
+	}
+
+	second.ExtendedAttributes = map[string][]byte{"user.comment": []byte("first")}
+	second.ExtendedAttributesDigest = extendedAttributesDigest(second.ExtendedAttributes)
+	if !first.equalShallow(second) {
+		t.Error("directories with matching extended attributes considered unequal")
+	}
+}
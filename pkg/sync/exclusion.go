@@ -0,0 +1,39 @@
+package sync
+
+import (
+	pathpkg "path"
+)
+
+func PreserveExcluded(ancestor, snapshot *Entry, excluded []string) *Entry {
+	if ancestor == nil || snapshot == nil || len(excluded) == 0 {
+		return snapshot
+	}
+
+	result := snapshot.Copy()
+
+	for _, path := range excluded {
+		previous := ancestor.lookup(path)
+		if previous == nil {
+			continue
+		}
+
+		parentPath, name := pathpkg.Dir(path), pathpkg.Base(path)
+		if parentPath == "." {
+			parentPath = ""
+		}
+
+		parent := result.lookup(parentPath)
+		if parent == nil || parent.Kind != EntryKind_Directory {
+			continue
+		} else if _, ok := parent.Contents[name]; ok {
+			continue
+		}
+
+		if parent.Contents == nil {
+			parent.Contents = make(map[string]*Entry)
+		}
+		parent.Contents[name] = previous.Copy()
+	}
+
+	return result
+}
@@ -0,0 +1,44 @@
+package sync
+
+import (
+	"testing"
+)
+
+func TestPreserveExcludedNoExclusions(t *testing.T) {
+	if result := PreserveExcluded(testDirectory1Entry, testDirectory2Entry, nil); result != testDirectory2Entry {
+		t.Error("snapshot modified without exclusions")
+	}
+}
+
+func TestPreserveExcludedRestoresAncestorEntries(t *testing.T) {
+	ancestor := &Entry{
+		Kind: EntryKind_Directory,
+		Contents: map[string]*Entry{
+			"build": testDirectory1Entry,
+			"kept":  testFile1Entry,
+			"notes": testFile2Entry,
+		},
+	}
+	snapshot := &Entry{
+		Kind: EntryKind_Directory,
+		Contents: map[string]*Entry{
+			"kept": testFile3Entry,
+		},
+	}
+
+	result := PreserveExcluded(ancestor, snapshot, []string{"build", "kept", "new", "build/missing"})
+
+	if !result.Contents["build"].Equal(testDirectory1Entry) {
+		t.Error("excluded ancestor directory not preserved")
+	} else if !result.Contents["kept"].Equal(testFile3Entry) {
+		t.Error("scanned entry replaced by ancestor entry")
+	} else if _, ok := result.Contents["notes"]; ok {
+		t.Error("removed entry restored without exclusion")
+	} else if _, ok := result.Contents["new"]; ok {
+		t.Error("entry created for path without ancestor")
+	}
+
+	if len(snapshot.Contents) != 1 {
+		t.Error("original snapshot modified")
+	}
+}
@@ -0,0 +1,45 @@
+package sync
+
+import (
+	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
+)
+
+type hardLinkKey struct {
+	deviceID uint64
+	fileID   uint64
+}
+
+type hardLinkMember struct {
+	path  string
+	entry *Entry
+}
+
+type hardLinkTracker map[hardLinkKey][]hardLinkMember
+
+func (t hardLinkTracker) track(path string, entry *Entry, metadata *fs.Metadata) {
+	if metadata.LinkCount < 2 || metadata.FileID == 0 {
+		return
+	}
+
+	key := hardLinkKey{metadata.DeviceID, metadata.FileID}
+	t[key] = append(t[key], hardLinkMember{path, entry})
+}
+
+func (t hardLinkTracker) assign() {
+	for _, members := range t {
+		if len(members) < 2 {
+			continue
+		}
+
+		group := members[0].path
+		for _, m := range members[1:] {
+			if m.path < group {
+				group = m.path
+			}
+		}
+
+		for _, m := range members {
+			m.entry.HardLinkGroup = group
+		}
+	}
+}
@@ -53,6 +53,71 @@ func (m IgnoreVCSMode) Description() string {
 	}
 }
 
+func (m IgnoreFilesMode) IsDefault() bool {
+	return m == IgnoreFilesMode_IgnoreFilesDefault
+}
+
+func (m *IgnoreFilesMode) UnmarshalText(textBytes []byte) error {
+	text := string(textBytes)
+
+	switch text {
+	case "disabled":
+		*m = IgnoreFilesMode_IgnoreFilesDisabled
+	case "doppelganger":
+		*m = IgnoreFilesMode_IgnoreFilesDoppelganger
+	case "git":
+		*m = IgnoreFilesMode_IgnoreFilesDoppelgangerAndGit
+	default:
+		return errors.Errorf("unknown ignore files specification: %s", text)
+	}
+
+	return nil
+}
+
+func (m IgnoreFilesMode) Supported() bool {
+	switch m {
+	case IgnoreFilesMode_IgnoreFilesDisabled:
+		return true
+	case IgnoreFilesMode_IgnoreFilesDoppelganger:
+		return true
+	case IgnoreFilesMode_IgnoreFilesDoppelgangerAndGit:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m IgnoreFilesMode) Description() string {
+	switch m {
+	case IgnoreFilesMode_IgnoreFilesDefault:
+		return "Default"
+	case IgnoreFilesMode_IgnoreFilesDisabled:
+		return "Disabled"
+	case IgnoreFilesMode_IgnoreFilesDoppelganger:
+		return "Doppelganger"
+	case IgnoreFilesMode_IgnoreFilesDoppelgangerAndGit:
+		return "Doppelganger and Git"
+	default:
+		return "Unknown"
+	}
+}
+
+func (m IgnoreFilesMode) FileNames() []string {
+	switch m {
+	case IgnoreFilesMode_IgnoreFilesDoppelganger:
+		return []string{DoppelgangerIgnoreFileName}
+	case IgnoreFilesMode_IgnoreFilesDoppelgangerAndGit:
+		return []string{GitIgnoreFileName, DoppelgangerIgnoreFileName}
+	default:
+		return nil
+	}
+}
+
+const (
+	DoppelgangerIgnoreFileName = ".doppelgangerignore"
+	GitIgnoreFileName          = ".gitignore"
+)
+
 var DefaultVCSIgnores = []string{
 	".git/",
 	".svn/",
@@ -62,6 +127,7 @@ var DefaultVCSIgnores = []string{
 }
 
 type ignorePattern struct {
+	text          string
 	negated       bool
 	directoryOnly bool
 	matchLeaf     bool
@@ -69,6 +135,7 @@ type ignorePattern struct {
 }
 
 func newIgnorePattern(pattern string) (*ignorePattern, error) {
+	text := pattern
 	if pattern == "" || pattern == "!" {
 		return nil, errors.New("empty pattern")
 	} else if pattern == "/" || pattern == "!/" {
@@ -102,6 +169,7 @@ func newIgnorePattern(pattern string) (*ignorePattern, error) {
 	}
 
 	return &ignorePattern{
+		text:          text,
 		negated:       negated,
 		directoryOnly: directoryOnly,
 		matchLeaf:     (!absolute && !containsSlash),
@@ -150,23 +218,88 @@ func newIgnorer(patterns []string) (*ignorer, error) {
 }
 
 func (i *ignorer) ignored(path string, directory bool) bool {
+	return i.apply(path, directory, false)
+}
+
+func (i *ignorer) apply(path string, directory, ignored bool) bool {
+	if index := i.decidingPattern(path, directory); index >= 0 {
+		ignored = !i.patterns[index].negated
+	}
 
-	ignored := false
+	return ignored
+}
+
+func (i *ignorer) decidingPattern(path string, directory bool) int {
+	result := -1
+
+	for index, p := range i.patterns {
+		if match, _ := p.matches(path, directory); match {
+			result = index
+		}
+	}
+
+	return result
+}
 
-	for _, p := range i.patterns {
-		if match, negated := p.matches(path, directory); !match {
+type IgnoreExplanation struct {
+	Path         string
+	Ignored      bool
+	DecidingPath string
+	Source       string
+	Pattern      string
+	NotIncluded  bool
+	Predicate    string
+}
+
+func parseIgnoreFile(contents []byte) (*ignorer, []error) {
+	var patterns []*ignorePattern
+	var invalid []error
+	for l, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, "\\ ") {
+			line = line[:len(line)-1]
+		}
+
+		if line == "" || line[0] == '#' {
 			continue
+		}
+
+		if p, err := newIgnorePattern(line); err != nil {
+			invalid = append(invalid, errors.Wrapf(err, "invalid ignore pattern on line %d", l+1))
 		} else {
-			ignored = !negated
+			patterns = append(patterns, p)
 		}
 	}
 
+	return &ignorer{patterns}, invalid
+}
+
+type ignoreFile struct {
+	path    string
+	base    string
+	ignorer *ignorer
+}
+
+func (f *ignoreFile) relative(path string) (string, bool) {
+	if f.base == "" {
+		return path, true
+	} else if !strings.HasPrefix(path, f.base+"/") {
+		return "", false
+	}
+	return path[len(f.base)+1:], true
+}
+
+func (f *ignoreFile) apply(path string, directory, ignored bool) bool {
+	if relative, ok := f.relative(path); ok {
+		return f.ignorer.apply(relative, directory, ignored)
+	}
 	return ignored
 }
 
 type IgnoreCacheKey struct {
 	path      string
 	directory bool
+	context   string
 }
 
 type IgnoreCache map[IgnoreCacheKey]bool
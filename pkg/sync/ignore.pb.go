@@ -36,23 +36,57 @@ func (x IgnoreVCSMode) String() string {
 	return proto.EnumName(IgnoreVCSMode_name, int32(x))
 }
 func (IgnoreVCSMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ignore_c0f021883f75df26, []int{0}
+	return fileDescriptor_ignore_731060de3b6b29c1, []int{0}
+}
+
+type IgnoreFilesMode int32
+
+const (
+	IgnoreFilesMode_IgnoreFilesDefault            IgnoreFilesMode = 0
+	IgnoreFilesMode_IgnoreFilesDisabled           IgnoreFilesMode = 1
+	IgnoreFilesMode_IgnoreFilesDoppelganger       IgnoreFilesMode = 2
+	IgnoreFilesMode_IgnoreFilesDoppelgangerAndGit IgnoreFilesMode = 3
+)
+
+var IgnoreFilesMode_name = map[int32]string{
+	0: "IgnoreFilesDefault",
+	1: "IgnoreFilesDisabled",
+	2: "IgnoreFilesDoppelganger",
+	3: "IgnoreFilesDoppelgangerAndGit",
+}
+var IgnoreFilesMode_value = map[string]int32{
+	"IgnoreFilesDefault":            0,
+	"IgnoreFilesDisabled":           1,
+	"IgnoreFilesDoppelganger":       2,
+	"IgnoreFilesDoppelgangerAndGit": 3,
+}
+
+func (x IgnoreFilesMode) String() string {
+	return proto.EnumName(IgnoreFilesMode_name, int32(x))
+}
+func (IgnoreFilesMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ignore_731060de3b6b29c1, []int{1}
 }
 
 func init() {
 	proto.RegisterEnum("sync.IgnoreVCSMode", IgnoreVCSMode_name, IgnoreVCSMode_value)
+	proto.RegisterEnum("sync.IgnoreFilesMode", IgnoreFilesMode_name, IgnoreFilesMode_value)
 }
 
-func init() { proto.RegisterFile("sync/ignore.proto", fileDescriptor_ignore_c0f021883f75df26) }
+func init() { proto.RegisterFile("sync/ignore.proto", fileDescriptor_ignore_731060de3b6b29c1) }
 
-var fileDescriptor_ignore_c0f021883f75df26 = []byte{
+var fileDescriptor_ignore_731060de3b6b29c1 = []byte{
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2c, 0xae, 0xcc, 0x4b,
 	0xd6, 0xcf, 0x4c, 0xcf, 0xcb, 0x2f, 0x4a, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x01,
 	0x09, 0x69, 0xb9, 0x71, 0xf1, 0x7a, 0x82, 0x45, 0xc3, 0x9c, 0x83, 0x7d, 0xf3, 0x53, 0x52, 0x85,
 	0x44, 0xb8, 0x04, 0xe0, 0x02, 0x2e, 0xa9, 0x69, 0x89, 0xa5, 0x39, 0x25, 0x02, 0x0c, 0x42, 0xbc,
 	0x5c, 0x9c, 0x70, 0x51, 0x01, 0x46, 0x21, 0x01, 0x2e, 0x9e, 0x80, 0xa2, 0xfc, 0x82, 0xc4, 0xf4,
-	0xc4, 0x12, 0xb0, 0x08, 0x93, 0x93, 0x5a, 0x94, 0x4a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x46, 0x62, 0x59, 0x7e, 0xb2, 0x6e, 0x66, 0xbe, 0x7e, 0x6e, 0x69, 0x49,
-	0x62, 0x7a, 0x6a, 0x9e, 0x7e, 0x41, 0x76, 0xba, 0x3e, 0xc8, 0xbe, 0x24, 0x36, 0xb0, 0xe5, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0xb9, 0x44, 0x50, 0x91, 0x00, 0x00, 0x00,
+	0xc4, 0x12, 0xb0, 0x08, 0x93, 0x56, 0x13, 0x23, 0x17, 0x3f, 0x44, 0x85, 0x5b, 0x66, 0x4e, 0x6a,
+	0x31, 0xd8, 0x28, 0x31, 0x2e, 0x21, 0x24, 0x21, 0x84, 0x61, 0xe2, 0x5c, 0xc2, 0xc8, 0xe2, 0x99,
+	0xc5, 0x89, 0x49, 0x39, 0xa9, 0x29, 0x02, 0x8c, 0x42, 0xd2, 0x5c, 0xe2, 0xc8, 0x12, 0xf9, 0x05,
+	0x05, 0xa9, 0x39, 0xe9, 0x89, 0x79, 0xe9, 0xa9, 0x45, 0x02, 0x4c, 0x42, 0x8a, 0x5c, 0xb2, 0x38,
+	0x24, 0x1d, 0xf3, 0x52, 0xdc, 0x33, 0x4b, 0x04, 0x98, 0x9d, 0xf4, 0xa3, 0x74, 0xd3, 0x33, 0x4b,
+	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x83, 0xf2, 0xb3, 0x2b, 0x5d, 0x8b, 0x32, 0x93,
+	0xb3, 0x8b, 0xf3, 0xf3, 0xf4, 0x53, 0x90, 0xd4, 0xeb, 0x17, 0x64, 0xa7, 0xeb, 0x83, 0x7c, 0x9f,
+	0xc4, 0x06, 0x0e, 0x0a, 0x63, 0xc0, 0x00, 0x23, 0xfb, 0x42, 0x40, 0x1f, 0x01, 0x00, 0x00,
 }
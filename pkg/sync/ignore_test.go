@@ -1,6 +1,10 @@
 package sync
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -310,3 +314,125 @@ func TestIgnoreInvalidPatternOnIgnorerConstruction(t *testing.T) {
 		t.Error("ignorer should be nil on failed creation")
 	}
 }
+
+func TestParseIgnoreFile(t *testing.T) {
+	ignorer, invalid := parseIgnoreFile([]byte("# comment\r\n\n*.log  \n\\\n!keep.log\nbuild/\n"))
+	if len(ignorer.patterns) != 3 {
+		t.Fatal("unexpected number of patterns parsed:", len(ignorer.patterns))
+	}
+	if len(invalid) != 1 {
+		t.Fatal("unexpected number of invalid lines reported:", len(invalid))
+	} else if !strings.Contains(invalid[0].Error(), "line 4") {
+		t.Error("invalid line reported with incorrect line number:", invalid[0])
+	}
+	if !ignorer.ignored("file.log", false) {
+		t.Error("pattern with trailing whitespace not applied")
+	}
+	if ignorer.ignored("keep.log", false) {
+		t.Error("negated pattern not applied")
+	}
+	if !ignorer.ignored("build", true) {
+		t.Error("directory pattern not applied")
+	}
+}
+
+func TestIgnoreFileAnchoring(t *testing.T) {
+	ignorer, _ := parseIgnoreFile([]byte("/build/\nlog\nnested/path\n"))
+	file := &ignoreFile{
+		base:    "sub",
+		ignorer: ignorer,
+	}
+
+	tests := []ignoreTestValue{
+		{"build", true, false},
+		{"sub/build", true, true},
+		{"sub/other/build", true, false},
+		{"log", false, false},
+		{"sub/log", false, true},
+		{"sub/other/log", false, true},
+		{"sub/nested/path", false, true},
+		{"sub/other/nested/path", false, false},
+		{"subdirectory/log", false, false},
+	}
+	for _, test := range tests {
+		if ignored := file.apply(test.path, test.directory, false); ignored != test.expected {
+			t.Error("unexpected ignore result for", test.path, ":", ignored, "!=", test.expected)
+		}
+	}
+
+	if file.apply("sub/file", false, true) != true {
+		t.Error("unmatched path did not retain prior ignore status")
+	}
+}
+
+func TestExplainIgnored(t *testing.T) {
+	options := ScanOptions{
+		Ignores:  append(append([]string{}, DefaultVCSIgnores...), "*.log", "build/", "!important.log"),
+		Includes: []string{"src/**", "/important.log"},
+	}
+
+	tests := []struct {
+		path      string
+		directory bool
+		expected  IgnoreExplanation
+	}{
+		{"", false, IgnoreExplanation{}},
+		{"src/main.go", false, IgnoreExplanation{Path: "src/main.go", DecidingPath: "src/main.go"}},
+		{"src/debug.log", false, IgnoreExplanation{"src/debug.log", true, "src/debug.log", "configuration", "*.log", false, ""}},
+		{"important.log", false, IgnoreExplanation{"important.log", false, "important.log", "configuration", "!important.log", false, ""}},
+		{"/src/.git/config", false, IgnoreExplanation{"src/.git/config", true, "src/.git", "configuration", ".git/", false, ""}},
+		{"src/build", false, IgnoreExplanation{Path: "src/build", DecidingPath: "src/build"}},
+		{"src/build", true, IgnoreExplanation{"src/build", true, "src/build", "configuration", "build/", false, ""}},
+		{"docs/index.md", false, IgnoreExplanation{"docs/index.md", true, "docs", "", "", true, ""}},
+	}
+	for _, test := range tests {
+		if explanation, _, err := ExplainIgnored("", test.path, test.directory, options); err != nil {
+			t.Error("unable to explain ignore status for", test.path, ":", err)
+		} else if *explanation != test.expected {
+			t.Error("unexpected explanation for", test.path, ":", *explanation, "!=", test.expected)
+		}
+	}
+}
+
+func TestExplainIgnoredInTree(t *testing.T) {
+	root, err := ioutil.TempDir("", "doppelganger_explain")
+	if err != nil {
+		t.Fatal("unable to create temporary root:", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := os.MkdirAll(filepath.Join(root, "sub"), 0700); err != nil {
+		t.Fatal("unable to create subdirectory:", err)
+	} else if err = ioutil.WriteFile(filepath.Join(root, "sub", ".gitignore"), []byte("*.tmp\n"), 0600); err != nil {
+		t.Fatal("unable to create ignore file:", err)
+	} else if err = ioutil.WriteFile(filepath.Join(root, "sub", "large"), make([]byte, 2048), 0600); err != nil {
+		t.Fatal("unable to create large file:", err)
+	}
+
+	options := ScanOptions{
+		IgnoreFileNames: []string{".gitignore"},
+		Predicates:      &IgnorePredicates{MaximumFileSize: 1024},
+	}
+
+	tests := []struct {
+		path     string
+		expected IgnoreExplanation
+	}{
+		{"sub/file.tmp", IgnoreExplanation{"sub/file.tmp", true, "sub/file.tmp", "sub/.gitignore", "*.tmp", false, ""}},
+		{"file.tmp", IgnoreExplanation{Path: "file.tmp", DecidingPath: "file.tmp"}},
+		{"sub/large", IgnoreExplanation{"sub/large", true, "sub/large", "", "", false, "larger than maximum file size"}},
+	}
+	for _, test := range tests {
+		if explanation, _, err := ExplainIgnored(root, test.path, false, options); err != nil {
+			t.Error("unable to explain ignore status for", test.path, ":", err)
+		} else if *explanation != test.expected {
+			t.Error("unexpected explanation for", test.path, ":", *explanation, "!=", test.expected)
+		}
+	}
+}
+
+func TestExplainIgnoredInvalidPattern(t *testing.T) {
+	if _, _, err := ExplainIgnored("", "path", false, ScanOptions{Ignores: []string{"\\"}}); err == nil {
+		t.Error("explanation succeeded with invalid pattern")
+	}
+}
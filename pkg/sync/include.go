@@ -0,0 +1,122 @@
+package sync
+
+import (
+	pathpkg "path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bmatcuk/doublestar"
+)
+
+type includePattern struct {
+	matchLeaf bool
+	pattern   string
+	segments  []string
+}
+
+func newIncludePattern(pattern string) (*includePattern, error) {
+	if pattern == "" {
+		return nil, errors.New("empty pattern")
+	} else if pattern[0] == '!' {
+		return nil, errors.New("negated include patterns are not supported")
+	}
+
+	absolute := false
+	if pattern[0] == '/' {
+		absolute = true
+		pattern = pattern[1:]
+	}
+
+	pattern = strings.TrimSuffix(pattern, "/")
+	if pattern == "" {
+		return nil, errors.New("root pattern")
+	}
+
+	containsSlash := strings.IndexByte(pattern, '/') >= 0
+
+	if _, err := doublestar.Match(pattern, "a"); err != nil {
+		return nil, errors.Wrap(err, "unable to validate pattern")
+	}
+
+	return &includePattern{
+		matchLeaf: (!absolute && !containsSlash),
+		pattern:   pattern,
+		segments:  strings.Split(pattern, "/"),
+	}, nil
+}
+
+func (i *includePattern) matches(path string) bool {
+	if match, _ := doublestar.Match(i.pattern, path); match {
+		return true
+	}
+
+	if i.matchLeaf {
+		if match, _ := doublestar.Match(i.pattern, pathpkg.Base(path)); match {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i *includePattern) traversable(path string) bool {
+	if i.matchLeaf {
+		return true
+	}
+
+	components := strings.Split(path, "/")
+	for c, component := range components {
+		if c >= len(i.segments) {
+			return false
+		} else if i.segments[c] == "**" {
+			return true
+		} else if match, _ := doublestar.Match(i.segments[c], component); !match {
+			return false
+		}
+	}
+
+	return len(components) < len(i.segments)
+}
+
+func ValidIncludePattern(pattern string) bool {
+	_, err := newIncludePattern(pattern)
+	return err == nil
+}
+
+type includer struct {
+	patterns []*includePattern
+}
+
+func newIncluder(patterns []string) (*includer, error) {
+	includePatterns := make([]*includePattern, len(patterns))
+	for i, p := range patterns {
+		if ip, err := newIncludePattern(p); err != nil {
+			return nil, errors.Wrap(err, "unable to parse pattern")
+		} else {
+			includePatterns[i] = ip
+		}
+	}
+
+	return &includer{includePatterns}, nil
+}
+
+func (i *includer) included(path string, directory bool) bool {
+	if len(i.patterns) == 0 {
+		return true
+	}
+
+	for _, p := range i.patterns {
+		if directory && p.traversable(path) {
+			return true
+		}
+
+		for parent := path; parent != "" && parent != "."; parent = pathpkg.Dir(parent) {
+			if p.matches(parent) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"testing"
+)
+
+func TestIncludePatternValidity(t *testing.T) {
+	valid := []string{"services/api/**", "/libs/common", "*.go", "docs/"}
+	for _, pattern := range valid {
+		if !ValidIncludePattern(pattern) {
+			t.Error("valid include pattern rejected:", pattern)
+		}
+	}
+
+	invalid := []string{"", "/", "!services/**", "["}
+	for _, pattern := range invalid {
+		if ValidIncludePattern(pattern) {
+			t.Error("invalid include pattern accepted:", pattern)
+		}
+	}
+}
+
+func TestIncluder(t *testing.T) {
+	testCases := []struct {
+		patterns  []string
+		path      string
+		directory bool
+		expected  bool
+	}{
+		{nil, "anything", false, true},
+		{[]string{"services/api/**"}, "services", true, true},
+		{[]string{"services/api/**"}, "services/api", true, true},
+		{[]string{"services/api/**"}, "services/api/main.go", false, true},
+		{[]string{"services/api/**"}, "services/api/internal/handler.go", false, true},
+		{[]string{"services/api/**"}, "services/web", true, false},
+		{[]string{"services/api/**"}, "services/README", false, false},
+		{[]string{"services/api/**"}, "vendor", true, false},
+		{[]string{"libs/common"}, "libs/common/util/strings.go", false, true},
+		{[]string{"libs/common"}, "libs/other", true, false},
+		{[]string{"services/*/main.go"}, "services/web", true, true},
+		{[]string{"services/*/main.go"}, "services/web/internal", true, false},
+		{[]string{"services/*/main.go"}, "services/web/main.go", false, true},
+		{[]string{"*.go"}, "deep/nested/directory", true, true},
+		{[]string{"*.go"}, "deep/nested/file.go", false, true},
+		{[]string{"*.go"}, "deep/nested/file.txt", false, false},
+		{[]string{"services/api/**", "libs/common/**"}, "libs/common/a.go", false, true},
+	}
+
+	for _, testCase := range testCases {
+		includer, err := newIncluder(testCase.patterns)
+		if err != nil {
+			t.Fatal("unable to create includer:", err)
+		}
+		if included := includer.included(testCase.path, testCase.directory); included != testCase.expected {
+			t.Error("unexpected inclusion result for", testCase.patterns, testCase.path, ":", included)
+		}
+	}
+}
@@ -16,6 +16,8 @@ func (m *SynchronizationMode) UnmarshalText(textBytes []byte) error {
 		*m = SynchronizationMode_SynchronizationModeTwoWaySafe
 	case "two-way-resolved":
 		*m = SynchronizationMode_SynchronizationModeTwoWayResolved
+	case "two-way-preserve":
+		*m = SynchronizationMode_SynchronizationModeTwoWayPreserve
 	case "one-way-safe":
 		*m = SynchronizationMode_SynchronizationModeOneWaySafe
 	case "one-way-replica":
@@ -33,6 +35,8 @@ func (m SynchronizationMode) Supported() bool {
 		return true
 	case SynchronizationMode_SynchronizationModeTwoWayResolved:
 		return true
+	case SynchronizationMode_SynchronizationModeTwoWayPreserve:
+		return true
 	case SynchronizationMode_SynchronizationModeOneWaySafe:
 		return true
 	case SynchronizationMode_SynchronizationModeOneWayReplica:
@@ -42,6 +46,11 @@ func (m SynchronizationMode) Supported() bool {
 	}
 }
 
+func (m SynchronizationMode) Unidirectional() bool {
+	return m == SynchronizationMode_SynchronizationModeOneWaySafe ||
+		m == SynchronizationMode_SynchronizationModeOneWayReplica
+}
+
 func (m SynchronizationMode) Description() string {
 	switch m {
 	case SynchronizationMode_SynchronizationModeDefault:
@@ -50,6 +59,8 @@ func (m SynchronizationMode) Description() string {
 		return "Two Way Safe"
 	case SynchronizationMode_SynchronizationModeTwoWayResolved:
 		return "Two Way Resolved"
+	case SynchronizationMode_SynchronizationModeTwoWayPreserve:
+		return "Two Way Preserve"
 	case SynchronizationMode_SynchronizationModeOneWaySafe:
 		return "One Way Safe"
 	case SynchronizationMode_SynchronizationModeOneWayReplica:
@@ -21,6 +21,7 @@ const (
 	SynchronizationMode_SynchronizationModeTwoWayResolved SynchronizationMode = 2
 	SynchronizationMode_SynchronizationModeOneWaySafe     SynchronizationMode = 3
 	SynchronizationMode_SynchronizationModeOneWayReplica  SynchronizationMode = 4
+	SynchronizationMode_SynchronizationModeTwoWayPreserve SynchronizationMode = 5
 )
 
 var SynchronizationMode_name = map[int32]string{
@@ -29,6 +30,7 @@ var SynchronizationMode_name = map[int32]string{
 	2: "SynchronizationModeTwoWayResolved",
 	3: "SynchronizationModeOneWaySafe",
 	4: "SynchronizationModeOneWayReplica",
+	5: "SynchronizationModeTwoWayPreserve",
 }
 var SynchronizationMode_value = map[string]int32{
 	"SynchronizationModeDefault":        0,
@@ -36,32 +38,34 @@ var SynchronizationMode_value = map[string]int32{
 	"SynchronizationModeTwoWayResolved": 2,
 	"SynchronizationModeOneWaySafe":     3,
 	"SynchronizationModeOneWayReplica":  4,
+	"SynchronizationModeTwoWayPreserve": 5,
 }
 
 func (x SynchronizationMode) String() string {
 	return proto.EnumName(SynchronizationMode_name, int32(x))
 }
 func (SynchronizationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_mode_a8cfd4437324afd0, []int{0}
+	return fileDescriptor_mode_18a7303679a04073, []int{0}
 }
 
 func init() {
 	proto.RegisterEnum("sync.SynchronizationMode", SynchronizationMode_name, SynchronizationMode_value)
 }
 
-func init() { proto.RegisterFile("sync/mode.proto", fileDescriptor_mode_a8cfd4437324afd0) }
+func init() { proto.RegisterFile("sync/mode.proto", fileDescriptor_mode_18a7303679a04073) }
 
-var fileDescriptor_mode_a8cfd4437324afd0 = []byte{
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2f, 0xae, 0xcc, 0x4b,
-	0xd6, 0xcf, 0xcd, 0x4f, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x01, 0x09, 0x68,
-	0x9d, 0x60, 0xe4, 0x12, 0x0e, 0xae, 0xcc, 0x4b, 0xce, 0x28, 0xca, 0xcf, 0xcb, 0xac, 0x4a, 0x2c,
-	0xc9, 0xcc, 0xcf, 0xf3, 0xcd, 0x4f, 0x49, 0x15, 0x92, 0xe3, 0x92, 0xc2, 0x22, 0xec, 0x92, 0x9a,
-	0x96, 0x58, 0x9a, 0x53, 0x22, 0xc0, 0x20, 0xa4, 0xc8, 0x25, 0x8b, 0x45, 0x3e, 0xa4, 0x3c, 0x3f,
-	0x3c, 0xb1, 0x32, 0x38, 0x31, 0x2d, 0x55, 0x80, 0x51, 0x48, 0x95, 0x4b, 0x11, 0xa7, 0x92, 0xa0,
-	0xd4, 0xe2, 0xfc, 0x9c, 0xb2, 0xd4, 0x14, 0x01, 0x26, 0x1c, 0x26, 0xf9, 0xe7, 0xa5, 0xc2, 0x4c,
-	0x62, 0x16, 0x52, 0xe1, 0x52, 0xc0, 0xa9, 0x24, 0x28, 0xb5, 0x20, 0x27, 0x33, 0x39, 0x51, 0x80,
-	0xc5, 0x49, 0x2d, 0x4a, 0x25, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f,
-	0x23, 0xb1, 0x2c, 0x3f, 0x59, 0x37, 0x33, 0x5f, 0x3f, 0xb7, 0xb4, 0x24, 0x31, 0x3d, 0x35, 0x4f,
-	0xbf, 0x20, 0x3b, 0x5d, 0x1f, 0xe4, 0xe5, 0x24, 0x36, 0xb0, 0xff, 0x8d, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x94, 0x79, 0x67, 0x2b, 0x12, 0x01, 0x00, 0x00,
+var fileDescriptor_mode_18a7303679a04073 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4a, 0xc0, 0x30,
+	0x10, 0x86, 0xad, 0x56, 0x87, 0x2c, 0x86, 0xb8, 0x09, 0x8a, 0x05, 0x5d, 0x04, 0x9b, 0xc1, 0x37,
+	0x10, 0x1d, 0x45, 0x69, 0x05, 0xc1, 0x2d, 0x4d, 0xae, 0x69, 0x68, 0x9a, 0x0b, 0x49, 0x5a, 0x89,
+	0x2f, 0xed, 0x2b, 0x48, 0x05, 0xb7, 0xd6, 0xf5, 0xbf, 0x8f, 0xef, 0xe0, 0x23, 0xa7, 0x31, 0x3b,
+	0xc9, 0x27, 0x54, 0x50, 0xfb, 0x80, 0x09, 0x59, 0xb9, 0x0e, 0xb7, 0xdf, 0x05, 0x39, 0x6b, 0xb3,
+	0x93, 0x43, 0x40, 0x67, 0xbe, 0x44, 0x32, 0xe8, 0x9e, 0x51, 0x01, 0xbb, 0x24, 0xe7, 0x1b, 0xf3,
+	0x23, 0xf4, 0x62, 0xb6, 0x89, 0x1e, 0xb0, 0x8a, 0x5c, 0x6c, 0xdc, 0xdf, 0x3e, 0xf1, 0x5d, 0xe4,
+	0x56, 0xf4, 0x40, 0x0b, 0x76, 0x43, 0xaa, 0x5d, 0xa4, 0x81, 0x88, 0x76, 0x01, 0x45, 0x0f, 0x77,
+	0x4c, 0x2f, 0x0e, 0xfe, 0x4c, 0x47, 0xec, 0x9a, 0x5c, 0xed, 0x22, 0x0d, 0x78, 0x6b, 0xa4, 0xa0,
+	0xe5, 0xbf, 0xff, 0x5e, 0x03, 0x44, 0x08, 0x0b, 0xd0, 0xe3, 0x07, 0xfe, 0x71, 0xa7, 0x4d, 0x1a,
+	0xe6, 0xae, 0x96, 0x38, 0xf1, 0x06, 0xc7, 0xfc, 0x14, 0x8c, 0x1c, 0x23, 0x3a, 0xae, 0xd0, 0x7b,
+	0xb0, 0x5a, 0x38, 0x0d, 0x81, 0xfb, 0x51, 0xf3, 0x35, 0x51, 0x77, 0xf2, 0xdb, 0xeb, 0xfe, 0x67,
+	0x00, 0xcf, 0xd8, 0xb4, 0x44, 0x42, 0x01, 0x00, 0x00,
 }
@@ -16,6 +16,7 @@ func TestSynchronizationModeUnmarshal(t *testing.T) {
 		{"two-way-resolved", SynchronizationMode_SynchronizationModeTwoWayResolved, false},
 		{"one-way-safe", SynchronizationMode_SynchronizationModeOneWaySafe, false},
 		{"one-way-replica", SynchronizationMode_SynchronizationModeOneWayReplica, false},
+		{"two-way-preserve", SynchronizationMode_SynchronizationModeTwoWayPreserve, false},
 	}
 
 	for _, testCase := range testCases {
@@ -46,7 +47,8 @@ func TestSynchronizationModeSupported(t *testing.T) {
 		{SynchronizationMode_SynchronizationModeTwoWayResolved, true},
 		{SynchronizationMode_SynchronizationModeOneWaySafe, true},
 		{SynchronizationMode_SynchronizationModeOneWayReplica, true},
-		{(SynchronizationMode_SynchronizationModeOneWayReplica + 1), false},
+		{SynchronizationMode_SynchronizationModeTwoWayPreserve, true},
+		{(SynchronizationMode_SynchronizationModeTwoWayPreserve + 1), false},
 	}
 
 	for _, testCase := range testCases {
@@ -70,7 +72,8 @@ func TestSynchronizationModeDescription(t *testing.T) {
 		{SynchronizationMode_SynchronizationModeTwoWayResolved, "Two Way Resolved"},
 		{SynchronizationMode_SynchronizationModeOneWaySafe, "One Way Safe"},
 		{SynchronizationMode_SynchronizationModeOneWayReplica, "One Way Replica"},
-		{(SynchronizationMode_SynchronizationModeOneWayReplica + 1), "Unknown"},
+		{SynchronizationMode_SynchronizationModeTwoWayPreserve, "Two Way Preserve"},
+		{(SynchronizationMode_SynchronizationModeTwoWayPreserve + 1), "Unknown"},
 	}
 
 	for _, testCase := range testCases {
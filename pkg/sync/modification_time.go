@@ -0,0 +1,66 @@
+package sync
+
+import (
+	"github.com/pkg/errors"
+)
+
+func (m ModificationTimesMode) IsDefault() bool {
+	return m == ModificationTimesMode_ModificationTimesDefault
+}
+
+func (m *ModificationTimesMode) UnmarshalText(textBytes []byte) error {
+	text := string(textBytes)
+
+	switch text {
+	case "true":
+		*m = ModificationTimesMode_PreserveModificationTimes
+	case "false":
+		*m = ModificationTimesMode_DiscardModificationTimes
+	default:
+		return errors.Errorf("unknown modification time preservation specification: %s", text)
+	}
+
+	return nil
+}
+
+func (m ModificationTimesMode) Supported() bool {
+	switch m {
+	case ModificationTimesMode_PreserveModificationTimes:
+		return true
+	case ModificationTimesMode_DiscardModificationTimes:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m ModificationTimesMode) Description() string {
+	switch m {
+	case ModificationTimesMode_ModificationTimesDefault:
+		return "Default"
+	case ModificationTimesMode_PreserveModificationTimes:
+		return "Preserved"
+	case ModificationTimesMode_DiscardModificationTimes:
+		return "Not preserved"
+	default:
+		return "Unknown"
+	}
+}
+
+func StripModificationTimes(entry *Entry) *Entry {
+	if entry == nil {
+		return nil
+	}
+
+	result := entry.copySlim()
+	result.ModificationTime = nil
+
+	if len(entry.Contents) > 0 {
+		result.Contents = make(map[string]*Entry, len(entry.Contents))
+		for name, child := range entry.Contents {
+			result.Contents[name] = StripModificationTimes(child)
+		}
+	}
+
+	return result
+}
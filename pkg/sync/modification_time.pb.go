@@ -0,0 +1,62 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sync/modification_time.proto
+
+package sync // import "github.com/RokyErickson/doppelganger/pkg/sync"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+const _ = proto.ProtoPackageIsVersion2
+
+type ModificationTimesMode int32
+
+const (
+	ModificationTimesMode_ModificationTimesDefault  ModificationTimesMode = 0
+	ModificationTimesMode_PreserveModificationTimes ModificationTimesMode = 1
+	ModificationTimesMode_DiscardModificationTimes  ModificationTimesMode = 2
+)
+
+var ModificationTimesMode_name = map[int32]string{
+	0: "ModificationTimesDefault",
+	1: "PreserveModificationTimes",
+	2: "DiscardModificationTimes",
+}
+var ModificationTimesMode_value = map[string]int32{
+	"ModificationTimesDefault":  0,
+	"PreserveModificationTimes": 1,
+	"DiscardModificationTimes":  2,
+}
+
+func (x ModificationTimesMode) String() string {
+	return proto.EnumName(ModificationTimesMode_name, int32(x))
+}
+func (ModificationTimesMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_modification_time_57a153f2ec6e2988, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("sync.ModificationTimesMode", ModificationTimesMode_name, ModificationTimesMode_value)
+}
+
+func init() {
+	proto.RegisterFile("sync/modification_time.proto", fileDescriptor_modification_time_57a153f2ec6e2988)
+}
+
+var fileDescriptor_modification_time_57a153f2ec6e2988 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xce, 0xb1, 0x0a, 0xc2, 0x30,
+	0x10, 0xc6, 0x71, 0x15, 0x71, 0xc8, 0x54, 0x0a, 0x82, 0x42, 0x7d, 0x01, 0xc1, 0x66, 0xf0, 0x0d,
+	0xa4, 0x8e, 0x05, 0x11, 0x27, 0x17, 0x49, 0x93, 0x6b, 0x3c, 0xda, 0xe4, 0xc2, 0x25, 0x15, 0xfa,
+	0xf6, 0x52, 0x27, 0xa1, 0xf3, 0xef, 0xfb, 0xe0, 0x2f, 0x8a, 0x38, 0x7a, 0x2d, 0x1d, 0x19, 0x6c,
+	0x51, 0xab, 0x84, 0xe4, 0x5f, 0x09, 0x1d, 0x94, 0x81, 0x29, 0x51, 0xbe, 0x9e, 0xf4, 0xc8, 0x62,
+	0x5b, 0xff, 0x0d, 0x1e, 0xe8, 0x20, 0xd6, 0x64, 0x20, 0x2f, 0xc4, 0x6e, 0x06, 0x15, 0xb4, 0x6a,
+	0xe8, 0x53, 0xb6, 0xc8, 0x0f, 0x62, 0x7f, 0x63, 0x88, 0xc0, 0x1f, 0x98, 0xad, 0xb2, 0xe5, 0x74,
+	0xae, 0x30, 0x6a, 0xc5, 0x66, 0xae, 0xab, 0x8b, 0x7c, 0x9e, 0x2c, 0xa6, 0xf7, 0xd0, 0x94, 0x9a,
+	0x9c, 0xbc, 0x53, 0x37, 0x5e, 0x19, 0x75, 0x17, 0xc9, 0x4b, 0x43, 0x21, 0x40, 0x6f, 0x95, 0xb7,
+	0xc0, 0x32, 0x74, 0x56, 0x4e, 0x91, 0xcd, 0xe6, 0x57, 0x7c, 0xfe, 0x0e, 0x00, 0x1c, 0x21, 0x92,
+	0xbb, 0xd1, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,64 @@
+package sync
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func TestModificationTimesModeUnmarshalTrue(t *testing.T) {
+	var mode ModificationTimesMode
+	if err := mode.UnmarshalText([]byte("true")); err != nil {
+		t.Fatal("unable to unmarshal text:", err)
+	} else if mode != ModificationTimesMode_PreserveModificationTimes {
+		t.Error("unmarshalled mode does not match expected")
+	}
+}
+
+func TestModificationTimesModeUnmarshalFalse(t *testing.T) {
+	var mode ModificationTimesMode
+	if err := mode.UnmarshalText([]byte("false")); err != nil {
+		t.Fatal("unable to unmarshal text:", err)
+	} else if mode != ModificationTimesMode_DiscardModificationTimes {
+		t.Error("unmarshalled mode does not match expected")
+	}
+}
+
+func TestModificationTimesModeUnmarshalInvalid(t *testing.T) {
+	var mode ModificationTimesMode
+	if mode.UnmarshalText([]byte("invalid")) == nil {
+		t.Error("invalid modification time mode successfully unmarshalled")
+	}
+}
+
+func TestModificationTimesModeSupported(t *testing.T) {
+	if ModificationTimesMode_ModificationTimesDefault.Supported() {
+		t.Error("default modification time mode considered supported")
+	}
+	if !ModificationTimesMode_PreserveModificationTimes.Supported() {
+		t.Error("preserve modification time mode considered unsupported")
+	}
+	if !ModificationTimesMode_DiscardModificationTimes.Supported() {
+		t.Error("discard modification time mode considered unsupported")
+	}
+}
+
+func TestStripModificationTimes(t *testing.T) {
+	file := testFile1Entry.Copy()
+	file.ModificationTime = &timestamp.Timestamp{Seconds: 1}
+	root := &Entry{
+		Kind:             EntryKind_Directory,
+		ModificationTime: &timestamp.Timestamp{Seconds: 2},
+		Contents:         map[string]*Entry{"file": file},
+	}
+
+	stripped := StripModificationTimes(root)
+	if stripped.ModificationTime != nil || stripped.Contents["file"].ModificationTime != nil {
+		t.Error("modification times not stripped")
+	} else if root.ModificationTime == nil || file.ModificationTime == nil {
+		t.Error("original entries modified")
+	} else if !bytes.Equal(stripped.Contents["file"].Digest, file.Digest) {
+		t.Error("file content not retained")
+	}
+}
@@ -1,6 +1,9 @@
 package sync
 
 import (
+	userpkg "os/user"
+	"strconv"
+
 	"github.com/pkg/errors"
 
 	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
@@ -59,3 +62,124 @@ func markExecutableForReaders(mode fs.Mode) fs.Mode {
 
 	return mode
 }
+
+func (m PermissionsMode) IsDefault() bool {
+	return m == PermissionsMode_PermissionsDefault
+}
+
+func (m *PermissionsMode) UnmarshalText(textBytes []byte) error {
+	text := string(textBytes)
+
+	switch text {
+	case "portable":
+		*m = PermissionsMode_PermissionsPortable
+	case "preserve":
+		*m = PermissionsMode_PermissionsPreserve
+	case "preserve-numeric":
+		*m = PermissionsMode_PermissionsPreserveNumeric
+	case "preserve-named":
+		*m = PermissionsMode_PermissionsPreserveNamed
+	default:
+		return errors.Errorf("unknown permissions mode specification: %s", text)
+	}
+
+	return nil
+}
+
+func (m PermissionsMode) Supported() bool {
+	switch m {
+	case PermissionsMode_PermissionsPortable:
+		return true
+	case PermissionsMode_PermissionsPreserve:
+		return true
+	case PermissionsMode_PermissionsPreserveNumeric:
+		return true
+	case PermissionsMode_PermissionsPreserveNamed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m PermissionsMode) Description() string {
+	switch m {
+	case PermissionsMode_PermissionsDefault:
+		return "Default"
+	case PermissionsMode_PermissionsPortable:
+		return "Portable"
+	case PermissionsMode_PermissionsPreserve:
+		return "Preserve"
+	case PermissionsMode_PermissionsPreserveNumeric:
+		return "Preserve (Numeric Ownership)"
+	case PermissionsMode_PermissionsPreserveNamed:
+		return "Preserve (Named Ownership)"
+	default:
+		return "Unknown"
+	}
+}
+
+func (m PermissionsMode) Preserves() bool {
+	switch m {
+	case PermissionsMode_PermissionsPreserve:
+		return true
+	case PermissionsMode_PermissionsPreserveNumeric:
+		return true
+	case PermissionsMode_PermissionsPreserveNamed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m PermissionsMode) PreservesOwnership() bool {
+	return m == PermissionsMode_PermissionsPreserveNumeric ||
+		m == PermissionsMode_PermissionsPreserveNamed
+}
+
+type ownershipNamer struct {
+	named  bool
+	owners map[uint32]string
+	groups map[uint32]string
+}
+
+func newOwnershipNamer(named bool) *ownershipNamer {
+	return &ownershipNamer{
+		named:  named,
+		owners: make(map[uint32]string),
+		groups: make(map[uint32]string),
+	}
+}
+
+func (n *ownershipNamer) owner(id uint32) string {
+	if result, ok := n.owners[id]; ok {
+		return result
+	}
+
+	identifier := strconv.FormatUint(uint64(id), 10)
+	result := "id:" + identifier
+	if n.named {
+		if userObject, err := userpkg.LookupId(identifier); err == nil {
+			result = userObject.Username
+		}
+	}
+
+	n.owners[id] = result
+	return result
+}
+
+func (n *ownershipNamer) group(id uint32) string {
+	if result, ok := n.groups[id]; ok {
+		return result
+	}
+
+	identifier := strconv.FormatUint(uint64(id), 10)
+	result := "id:" + identifier
+	if n.named {
+		if groupObject, err := userpkg.LookupGroupId(identifier); err == nil {
+			result = groupObject.Name
+		}
+	}
+
+	n.groups[id] = result
+	return result
+}
@@ -0,0 +1,69 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sync/permissions.proto
+
+package sync // import "github.com/RokyErickson/doppelganger/pkg/sync"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+const _ = proto.ProtoPackageIsVersion2
+
+type PermissionsMode int32
+
+const (
+	PermissionsMode_PermissionsDefault         PermissionsMode = 0
+	PermissionsMode_PermissionsPortable        PermissionsMode = 1
+	PermissionsMode_PermissionsPreserve        PermissionsMode = 2
+	PermissionsMode_PermissionsPreserveNumeric PermissionsMode = 3
+	PermissionsMode_PermissionsPreserveNamed   PermissionsMode = 4
+)
+
+var PermissionsMode_name = map[int32]string{
+	0: "PermissionsDefault",
+	1: "PermissionsPortable",
+	2: "PermissionsPreserve",
+	3: "PermissionsPreserveNumeric",
+	4: "PermissionsPreserveNamed",
+}
+var PermissionsMode_value = map[string]int32{
+	"PermissionsDefault":         0,
+	"PermissionsPortable":        1,
+	"PermissionsPreserve":        2,
+	"PermissionsPreserveNumeric": 3,
+	"PermissionsPreserveNamed":   4,
+}
+
+func (x PermissionsMode) String() string {
+	return proto.EnumName(PermissionsMode_name, int32(x))
+}
+func (PermissionsMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_permissions_d5b1483a1c2323c4, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("sync.PermissionsMode", PermissionsMode_name, PermissionsMode_value)
+}
+
+func init() {
+	proto.RegisterFile("sync/permissions.proto", fileDescriptor_permissions_d5b1483a1c2323c4)
+}
+
+var fileDescriptor_permissions_d5b1483a1c2323c4 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xcf, 0xc1, 0x6a, 0xc2, 0x30,
+	0x18, 0xc0, 0xf1, 0x75, 0x2b, 0x3b, 0xe4, 0xb2, 0x90, 0x41, 0x37, 0xc6, 0xf0, 0x01, 0x04, 0x9b,
+	0x83, 0x6f, 0x20, 0x7a, 0x54, 0x8a, 0x47, 0x6f, 0x69, 0xfa, 0x19, 0x43, 0x9b, 0x7c, 0xe1, 0x4b,
+	0x2a, 0xf4, 0x51, 0x7c, 0x5b, 0xa9, 0x17, 0x8b, 0xf4, 0xfa, 0xff, 0x9d, 0xfe, 0xac, 0x88, 0x83,
+	0xd7, 0x32, 0x00, 0x39, 0x1b, 0xa3, 0x45, 0x1f, 0xcb, 0x40, 0x98, 0x50, 0xe4, 0x63, 0x5f, 0xde,
+	0x32, 0xf6, 0x55, 0x3d, 0x6d, 0x8f, 0x0d, 0x88, 0x82, 0x89, 0x49, 0xda, 0xc2, 0x59, 0xf5, 0x5d,
+	0xe2, 0x6f, 0xe2, 0x87, 0x7d, 0x4f, 0x7a, 0x85, 0x94, 0x54, 0xdd, 0x01, 0xcf, 0x5e, 0x81, 0x20,
+	0x02, 0x5d, 0x81, 0xbf, 0x8b, 0x05, 0xfb, 0x9b, 0x81, 0x43, 0xef, 0x80, 0xac, 0xe6, 0x1f, 0xe2,
+	0x9f, 0xfd, 0xce, 0xb9, 0x72, 0xd0, 0xf0, 0x7c, 0x23, 0x4f, 0x2b, 0x63, 0xd3, 0xa5, 0xaf, 0x4b,
+	0x8d, 0x4e, 0x1e, 0xb1, 0x1d, 0x76, 0x64, 0x75, 0x1b, 0xd1, 0xcb, 0x06, 0x43, 0x80, 0xce, 0x28,
+	0x6f, 0x80, 0x64, 0x68, 0x8d, 0x1c, 0x67, 0xea, 0xcf, 0xc7, 0xd9, 0xfa, 0x3e, 0x00, 0x30, 0xa5,
+	0xf6, 0x81, 0xf3, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,41 @@
+package sync
+
+import (
+	"time"
+
+	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
+)
+
+type IgnorePredicates struct {
+	MaximumFileSize uint64
+	MaximumFileAge  time.Duration
+}
+
+func excludedKind(mode fs.Mode) bool {
+	switch mode & fs.ModeTypeMask {
+	case fs.ModeTypeDirectory, fs.ModeTypeFile, fs.ModeTypeSymbolicLink:
+		return false
+	default:
+		return true
+	}
+}
+
+func (p *IgnorePredicates) exclusionReason(metadata *fs.Metadata, now time.Time) string {
+	if p == nil {
+		return ""
+	}
+
+	if p.MaximumFileSize != 0 && metadata.Size > p.MaximumFileSize {
+		return "larger than maximum file size"
+	}
+
+	if p.MaximumFileAge != 0 && now.Sub(metadata.ModificationTime) > p.MaximumFileAge {
+		return "older than maximum file age"
+	}
+
+	return ""
+}
+
+func (p *IgnorePredicates) excludes(metadata *fs.Metadata, now time.Time) bool {
+	return p.exclusionReason(metadata, now) != ""
+}
@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	fs "github.com/RokyErickson/doppelganger/pkg/filesystem"
+)
+
+func TestIgnorePredicatesNil(t *testing.T) {
+	var predicates *IgnorePredicates
+	if predicates.excludes(&fs.Metadata{Size: 1 << 40}, time.Now()) {
+		t.Error("nil predicates excluded file")
+	}
+}
+
+func TestIgnorePredicates(t *testing.T) {
+	now := time.Now()
+	predicates := &IgnorePredicates{
+		MaximumFileSize: 1024,
+		MaximumFileAge:  time.Hour,
+	}
+
+	tests := []struct {
+		metadata *fs.Metadata
+		expected bool
+	}{
+		{&fs.Metadata{Size: 1024, ModificationTime: now}, false},
+		{&fs.Metadata{Size: 1025, ModificationTime: now}, true},
+		{&fs.Metadata{Size: 0, ModificationTime: now.Add(-30 * time.Minute)}, false},
+		{&fs.Metadata{Size: 0, ModificationTime: now.Add(-2 * time.Hour)}, true},
+	}
+	for _, test := range tests {
+		if excluded := predicates.excludes(test.metadata, now); excluded != test.expected {
+			t.Error("unexpected predicate result:", excluded, "!=", test.expected)
+		}
+	}
+}
+
+func TestExcludedKind(t *testing.T) {
+	tests := []struct {
+		mode     fs.Mode
+		expected bool
+	}{
+		{fs.ModeTypeFile, false},
+		{fs.ModeTypeDirectory, false},
+		{fs.ModeTypeSymbolicLink, false},
+		{fs.ModeTypeMask, true},
+	}
+	for _, test := range tests {
+		if excluded := excludedKind(test.mode); excluded != test.expected {
+			t.Error("unexpected kind exclusion result for mode", test.mode, ":", excluded, "!=", test.expected)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package sync
 
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
 func nonDeletionChangesOnly(changes []*Change) []*Change {
 	var result []*Change
 
@@ -14,10 +20,13 @@ func nonDeletionChangesOnly(changes []*Change) []*Change {
 
 type reconciler struct {
 	synchronizationMode SynchronizationMode
+	alpha               *Entry
+	beta                *Entry
 	ancestorChanges     []*Change
 	alphaChanges        []*Change
 	betaChanges         []*Change
 	conflicts           []*Conflict
+	conflictTime        time.Time
 }
 
 func (r *reconciler) reconcile(path string, ancestor, alpha, beta *Entry) {
@@ -46,11 +55,19 @@ func (r *reconciler) reconcile(path string, ancestor, alpha, beta *Entry) {
 		return
 	}
 
+	if alpha != nil && beta != nil &&
+		alpha.Kind == EntryKind_Directory && beta.Kind == EntryKind_Directory {
+		r.reconcileDirectoryMetadata(path, ancestor, alpha, beta)
+		return
+	}
+
 	switch r.synchronizationMode {
 	case SynchronizationMode_SynchronizationModeTwoWaySafe:
 		r.handleDisagreementBidirectional(path, ancestor, alpha, beta)
 	case SynchronizationMode_SynchronizationModeTwoWayResolved:
 		r.handleDisagreementBidirectional(path, ancestor, alpha, beta)
+	case SynchronizationMode_SynchronizationModeTwoWayPreserve:
+		r.handleDisagreementBidirectional(path, ancestor, alpha, beta)
 	case SynchronizationMode_SynchronizationModeOneWaySafe:
 		r.handleDisagreementUnidirectional(path, ancestor, alpha, beta)
 	case SynchronizationMode_SynchronizationModeOneWayReplica:
@@ -60,6 +77,52 @@ func (r *reconciler) reconcile(path string, ancestor, alpha, beta *Entry) {
 	}
 }
 
+func (r *reconciler) directoryMetadataConflicts(ancestor, alpha, beta *Entry) bool {
+	if r.synchronizationMode.Unidirectional() ||
+		r.synchronizationMode == SynchronizationMode_SynchronizationModeTwoWayResolved {
+		return false
+	} else if ancestor == nil || ancestor.Kind != EntryKind_Directory {
+		return false
+	}
+
+	return !ancestor.equalShallow(alpha) && !ancestor.equalShallow(beta)
+}
+
+func (r *reconciler) reconcileDirectoryMetadata(path string, ancestor, alpha, beta *Entry) {
+	change := &Change{Path: path, Old: beta.copySlim(), New: alpha.copySlim()}
+	if r.directoryMetadataConflicts(ancestor, alpha, beta) {
+		r.conflicts = append(r.conflicts, &Conflict{
+			AlphaChanges: []*Change{{Path: path, Old: ancestor.copySlim(), New: alpha.copySlim()}},
+			BetaChanges:  []*Change{{Path: path, Old: ancestor.copySlim(), New: beta.copySlim()}},
+		})
+	} else if !r.synchronizationMode.Unidirectional() && ancestor.equalShallow(alpha) {
+		change = &Change{Path: path, Old: alpha.copySlim(), New: beta.copySlim()}
+		r.alphaChanges = append(r.alphaChanges, change)
+	} else {
+		r.betaChanges = append(r.betaChanges, change)
+	}
+
+	ancestorContents := ancestor.GetContents()
+	if ancestor == nil || ancestor.Kind != EntryKind_Directory {
+		r.ancestorChanges = append(r.ancestorChanges, &Change{
+			Path: path,
+			New:  change.Old.copySlim(),
+		})
+		ancestorContents = nil
+	}
+
+	alphaContents := alpha.GetContents()
+	betaContents := beta.GetContents()
+	for name := range nameUnion(ancestorContents, alphaContents, betaContents) {
+		r.reconcile(
+			pathJoin(path, name),
+			ancestorContents[name],
+			alphaContents[name],
+			betaContents[name],
+		)
+	}
+}
+
 func (r *reconciler) handleDisagreementBidirectional(path string, ancestor, alpha, beta *Entry) {
 	alphaDelta := diff(path, ancestor, alpha)
 	if len(alphaDelta) == 0 {
@@ -80,6 +143,23 @@ func (r *reconciler) handleDisagreementBidirectional(path string, ancestor, alph
 		return
 	}
 
+	if alpha != nil && alpha.Kind == EntryKind_File && alpha.equalShallowExceptModificationTime(beta) {
+		if beta.newerThan(alpha) {
+			r.alphaChanges = append(r.alphaChanges, &Change{
+				Path: path,
+				Old:  alpha,
+				New:  beta,
+			})
+		} else {
+			r.betaChanges = append(r.betaChanges, &Change{
+				Path: path,
+				Old:  beta,
+				New:  alpha,
+			})
+		}
+		return
+	}
+
 	if r.synchronizationMode == SynchronizationMode_SynchronizationModeTwoWayResolved {
 		r.betaChanges = append(r.betaChanges, &Change{
 			Path: path,
@@ -107,12 +187,110 @@ func (r *reconciler) handleDisagreementBidirectional(path string, ancestor, alph
 		return
 	}
 
+	if r.synchronizationMode == SynchronizationMode_SynchronizationModeTwoWayPreserve {
+		if r.preserve(path, alpha, beta) {
+			return
+		}
+	}
+
 	r.conflicts = append(r.conflicts, &Conflict{
 		AlphaChanges: alphaDeltaNonDeletion,
 		BetaChanges:  betaDeltaNonDeletion,
 	})
 }
 
+const conflictCopyTimeFormat = "20060102-150405"
+
+func conflictCopyName(path string) (string, string, string) {
+	parent, name := "", path
+	if index := strings.LastIndexByte(path, '/'); index >= 0 {
+		parent, name = path[:index], path[index+1:]
+	}
+
+	extension := ""
+	if index := strings.LastIndexByte(name, '.'); index > 0 {
+		name, extension = name[:index], name[index:]
+	}
+
+	return parent, name + ".conflict-beta-", extension
+}
+
+func conflictCopyPath(path string, timestamp time.Time) string {
+	parent, prefix, extension := conflictCopyName(path)
+	return pathJoin(parent, prefix+timestamp.UTC().Format(conflictCopyTimeFormat)+extension)
+}
+
+func (r *reconciler) existingConflictCopies(path string) []string {
+	parent, prefix, extension := conflictCopyName(path)
+
+	names := make(map[string]bool)
+	for _, root := range []*Entry{r.alpha, r.beta} {
+		for name := range root.lookup(parent).GetContents() {
+			if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, extension) {
+				continue
+			}
+			timestamp := name[len(prefix) : len(name)-len(extension)]
+			if _, err := time.Parse(conflictCopyTimeFormat, timestamp); err == nil {
+				names[name] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, pathJoin(parent, name))
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+func (r *reconciler) preserveCopy(copyPath string, beta *Entry) bool {
+	alphaCopy := r.alpha.lookup(copyPath)
+	betaCopy := r.beta.lookup(copyPath)
+	if alphaCopy == nil && betaCopy == nil {
+		r.alphaChanges = append(r.alphaChanges, &Change{
+			Path: copyPath,
+			New:  beta,
+		})
+		r.betaChanges = append(r.betaChanges, &Change{
+			Path: copyPath,
+			New:  beta,
+		})
+		return true
+	}
+
+	return (alphaCopy == nil || alphaCopy.equalShallow(beta)) &&
+		(betaCopy == nil || betaCopy.equalShallow(beta))
+}
+
+func (r *reconciler) preserve(path string, alpha, beta *Entry) bool {
+	if path == "" || alpha == nil || beta == nil {
+		return false
+	} else if alpha.Kind != EntryKind_File || beta.Kind != EntryKind_File {
+		return false
+	}
+
+	preserved := false
+	for _, copyPath := range r.existingConflictCopies(path) {
+		if r.preserveCopy(copyPath, beta) {
+			preserved = true
+			break
+		}
+	}
+	if !preserved && !r.preserveCopy(conflictCopyPath(path, r.conflictTime), beta) {
+		return false
+	}
+
+	r.betaChanges = append(r.betaChanges, &Change{
+		Path: path,
+		Old:  beta,
+		New:  alpha,
+	})
+
+	return true
+}
+
 func (r *reconciler) handleDisagreementUnidirectional(path string, ancestor, alpha, beta *Entry) {
 	if r.synchronizationMode == SynchronizationMode_SynchronizationModeOneWayReplica {
 		r.betaChanges = append(r.betaChanges, &Change{
@@ -157,9 +335,13 @@ func (r *reconciler) handleDisagreementUnidirectional(path string, ancestor, alp
 func Reconcile(
 	ancestor, alpha, beta *Entry,
 	synchronizationMode SynchronizationMode,
+	conflictTime time.Time,
 ) ([]*Change, []*Change, []*Change, []*Conflict) {
 	r := &reconciler{
 		synchronizationMode: synchronizationMode,
+		alpha:               alpha,
+		beta:                beta,
+		conflictTime:        conflictTime,
 	}
 
 	r.reconcile("", ancestor, alpha, beta)
@@ -2,6 +2,9 @@ package sync
 
 import (
 	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 func changeListsEqual(actualChanges, expectedChanges []*Change) bool {
@@ -61,6 +64,8 @@ func conflictListsEqual(actualConflicts, expectedConflicts []*Conflict) bool {
 	return true
 }
 
+var testConflictTime = time.Date(2019, time.March, 12, 15, 30, 5, 0, time.UTC)
+
 type reconcileTestCase struct {
 	ancestor                *Entry
 	alpha                   *Entry
@@ -79,6 +84,7 @@ func (c *reconcileTestCase) run(t *testing.T) {
 		ancestorChanges, alphaChanges, betaChanges, conflicts := Reconcile(
 			c.ancestor, c.alpha, c.beta,
 			synchronizationMode,
+			testConflictTime,
 		)
 
 		if !changeListsEqual(ancestorChanges, c.expectedAncestorChanges) {
@@ -1035,3 +1041,469 @@ func TestReconcileAlphaPartiallyDeletedDirectoryBetaReplacedDirectoryOneWaySafe(
 
 	testCase.run(t)
 }
+
+func TestConflictCopyPath(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{"file", "file.conflict-beta-20190312-153005"},
+		{"file.txt", "file.conflict-beta-20190312-153005.txt"},
+		{"directory/file.tar.gz", "directory/file.tar.conflict-beta-20190312-153005.gz"},
+		{"directory/.hidden", "directory/.hidden.conflict-beta-20190312-153005"},
+	}
+
+	for _, testCase := range testCases {
+		if result := conflictCopyPath(testCase.path, testConflictTime); result != testCase.expected {
+			t.Errorf("conflict copy path (%s) does not match expected (%s)", result, testCase.expected)
+		}
+	}
+}
+
+func TestReconcileBothModifiedFileTwoWayPreserve(t *testing.T) {
+	copyPath := conflictCopyPath("file", testConflictTime)
+	testCase := reconcileTestCase{
+		ancestor: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": testFile3Entry},
+		},
+		alpha: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": testFile1Entry},
+		},
+		beta: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": testFile2Entry},
+		},
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges: []*Change{
+			{Path: copyPath, New: testFile2Entry},
+		},
+		expectedBetaChanges: []*Change{
+			{Path: copyPath, New: testFile2Entry},
+			{Path: "file", Old: testFile2Entry, New: testFile1Entry},
+		},
+		expectedConflicts: nil,
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileBothModifiedFileExistingCopyTwoWayPreserve(t *testing.T) {
+	copyPath := conflictCopyPath("file", testConflictTime)
+	testCase := reconcileTestCase{
+		ancestor: &Entry{
+			Kind: EntryKind_Directory,
+			Contents: map[string]*Entry{
+				"file":   testFile3Entry,
+				copyPath: testFile2Entry,
+			},
+		},
+		alpha: &Entry{
+			Kind: EntryKind_Directory,
+			Contents: map[string]*Entry{
+				"file":   testFile1Entry,
+				copyPath: testFile2Entry,
+			},
+		},
+		beta: &Entry{
+			Kind: EntryKind_Directory,
+			Contents: map[string]*Entry{
+				"file":   testFile2Entry,
+				copyPath: testFile2Entry,
+			},
+		},
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges: []*Change{
+			{Path: "file", Old: testFile2Entry, New: testFile1Entry},
+		},
+		expectedConflicts: nil,
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileBothModifiedFileEarlierCopyTwoWayPreserve(t *testing.T) {
+	copyPath := conflictCopyPath("file", testConflictTime.Add(-time.Hour))
+	testCase := reconcileTestCase{
+		ancestor: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": testFile3Entry},
+		},
+		alpha: &Entry{
+			Kind: EntryKind_Directory,
+			Contents: map[string]*Entry{
+				"file":   testFile1Entry,
+				copyPath: testFile2Entry,
+			},
+		},
+		beta: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": testFile2Entry},
+		},
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges: []*Change{
+			{Path: "file", Old: testFile2Entry, New: testFile1Entry},
+			{Path: copyPath, New: testFile2Entry},
+		},
+		expectedConflicts: nil,
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileBothModifiedFileCopyCollisionTwoWayPreserve(t *testing.T) {
+	copyPath := conflictCopyPath("file", testConflictTime)
+	testCase := reconcileTestCase{
+		ancestor: &Entry{
+			Kind: EntryKind_Directory,
+			Contents: map[string]*Entry{
+				"file":   testFile3Entry,
+				copyPath: testFile3Entry,
+			},
+		},
+		alpha: &Entry{
+			Kind: EntryKind_Directory,
+			Contents: map[string]*Entry{
+				"file":   testFile1Entry,
+				copyPath: testFile3Entry,
+			},
+		},
+		beta: &Entry{
+			Kind: EntryKind_Directory,
+			Contents: map[string]*Entry{
+				"file":   testFile2Entry,
+				copyPath: testFile3Entry,
+			},
+		},
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges:     nil,
+		expectedConflicts: []*Conflict{
+			{
+				AlphaChanges: []*Change{{Path: "file", Old: testFile3Entry, New: testFile1Entry}},
+				BetaChanges:  []*Change{{Path: "file", Old: testFile3Entry, New: testFile2Entry}},
+			},
+		},
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileBothModifiedRootTwoWayPreserve(t *testing.T) {
+	testCase := reconcileTestCase{
+		ancestor: testFile3Entry,
+		alpha:    testFile1Entry,
+		beta:     testFile2Entry,
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges:     nil,
+		expectedConflicts: []*Conflict{
+			{
+				AlphaChanges: []*Change{{Path: "", Old: testFile3Entry, New: testFile1Entry}},
+				BetaChanges:  []*Change{{Path: "", Old: testFile3Entry, New: testFile2Entry}},
+			},
+		},
+	}
+
+	testCase.run(t)
+}
+
+func testDirectoryWithPermissions(permissions uint32, contents map[string]*Entry) *Entry {
+	return &Entry{
+		Contents:            contents,
+		Permissions:         permissions,
+		PermissionsRecorded: true,
+	}
+}
+
+func TestReconcileDirectoryPermissionsAlphaModified(t *testing.T) {
+	testCase := reconcileTestCase{
+		ancestor: testDirectoryWithPermissions(0755, map[string]*Entry{"file": testFile1Entry}),
+		alpha:    testDirectoryWithPermissions(0700, map[string]*Entry{"file": testFile2Entry}),
+		beta:     testDirectoryWithPermissions(0755, map[string]*Entry{"file": testFile1Entry}),
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWaySafe,
+			SynchronizationMode_SynchronizationModeTwoWayResolved,
+			SynchronizationMode_SynchronizationModeOneWaySafe,
+			SynchronizationMode_SynchronizationModeOneWayReplica,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges: []*Change{
+			{
+				Old: testDirectoryWithPermissions(0755, nil),
+				New: testDirectoryWithPermissions(0700, nil),
+			},
+			{
+				Path: "file",
+				Old:  testFile1Entry,
+				New:  testFile2Entry,
+			},
+		},
+		expectedConflicts: nil,
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileDirectoryPermissionsBetaModified(t *testing.T) {
+	testCase := reconcileTestCase{
+		ancestor: testDirectoryWithPermissions(0755, map[string]*Entry{"file": testFile1Entry}),
+		alpha:    testDirectoryWithPermissions(0755, map[string]*Entry{"file": testFile1Entry}),
+		beta:     testDirectoryWithPermissions(0700, map[string]*Entry{"file": testFile1Entry}),
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWaySafe,
+			SynchronizationMode_SynchronizationModeTwoWayResolved,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges: []*Change{
+			{
+				Old: testDirectoryWithPermissions(0755, nil),
+				New: testDirectoryWithPermissions(0700, nil),
+			},
+		},
+		expectedBetaChanges: nil,
+		expectedConflicts:   nil,
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileDirectoryPermissionsBothCreated(t *testing.T) {
+	testCase := reconcileTestCase{
+		ancestor: nil,
+		alpha:    testDirectoryWithPermissions(0700, map[string]*Entry{"file": testFile1Entry}),
+		beta:     testDirectoryWithPermissions(0755, map[string]*Entry{"file": testFile1Entry}),
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWaySafe,
+			SynchronizationMode_SynchronizationModeOneWayReplica,
+		},
+		expectedAncestorChanges: []*Change{
+			{New: testDirectoryWithPermissions(0755, nil)},
+			{Path: "file", New: testFile1Entry},
+		},
+		expectedAlphaChanges: nil,
+		expectedBetaChanges: []*Change{
+			{
+				Old: testDirectoryWithPermissions(0755, nil),
+				New: testDirectoryWithPermissions(0700, nil),
+			},
+		},
+		expectedConflicts: nil,
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileDirectoryPermissionsBothModified(t *testing.T) {
+	testCase := reconcileTestCase{
+		ancestor: testDirectoryWithPermissions(0755, map[string]*Entry{"file": testFile1Entry}),
+		alpha:    testDirectoryWithPermissions(0700, map[string]*Entry{"file": testFile1Entry}),
+		beta:     testDirectoryWithPermissions(0750, map[string]*Entry{"file": testFile1Entry}),
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWaySafe,
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges:     nil,
+		expectedConflicts: []*Conflict{
+			{
+				AlphaChanges: []*Change{
+					{
+						Old: testDirectoryWithPermissions(0755, nil),
+						New: testDirectoryWithPermissions(0700, nil),
+					},
+				},
+				BetaChanges: []*Change{
+					{
+						Old: testDirectoryWithPermissions(0755, nil),
+						New: testDirectoryWithPermissions(0750, nil),
+					},
+				},
+			},
+		},
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileDirectoryPermissionsBothModifiedTwoWayResolved(t *testing.T) {
+	testCase := reconcileTestCase{
+		ancestor: testDirectoryWithPermissions(0755, map[string]*Entry{"file": testFile1Entry}),
+		alpha:    testDirectoryWithPermissions(0700, map[string]*Entry{"file": testFile1Entry}),
+		beta:     testDirectoryWithPermissions(0750, map[string]*Entry{"file": testFile1Entry}),
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWayResolved,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges: []*Change{
+			{
+				Old: testDirectoryWithPermissions(0750, nil),
+				New: testDirectoryWithPermissions(0700, nil),
+			},
+		},
+		expectedConflicts: nil,
+	}
+
+	testCase.run(t)
+}
+
+func testDirectoryWithExtendedAttributes(permissions uint32, digest string, contents map[string]*Entry) *Entry {
+	result := testDirectoryWithPermissions(permissions, contents)
+	result.ExtendedAttributes = map[string][]byte{"user.comment": []byte(digest)}
+	result.ExtendedAttributesDigest = []byte(digest)
+	return result
+}
+
+func TestReconcileDirectoryExtendedAttributesBothModified(t *testing.T) {
+	testCase := reconcileTestCase{
+		ancestor: testDirectoryWithExtendedAttributes(0755, "ancestor", map[string]*Entry{"file": testFile1Entry}),
+		alpha:    testDirectoryWithExtendedAttributes(0755, "alpha", map[string]*Entry{"file": testFile1Entry}),
+		beta:     testDirectoryWithExtendedAttributes(0755, "beta", map[string]*Entry{"file": testFile1Entry}),
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWaySafe,
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges:     nil,
+		expectedConflicts: []*Conflict{
+			{
+				AlphaChanges: []*Change{
+					{
+						Old: testDirectoryWithExtendedAttributes(0755, "ancestor", nil),
+						New: testDirectoryWithExtendedAttributes(0755, "alpha", nil),
+					},
+				},
+				BetaChanges: []*Change{
+					{
+						Old: testDirectoryWithExtendedAttributes(0755, "ancestor", nil),
+						New: testDirectoryWithExtendedAttributes(0755, "beta", nil),
+					},
+				},
+			},
+		},
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileDirectoryPermissionsAndExtendedAttributesModified(t *testing.T) {
+	testCase := reconcileTestCase{
+		ancestor: testDirectoryWithExtendedAttributes(0755, "ancestor", map[string]*Entry{"file": testFile1Entry}),
+		alpha:    testDirectoryWithExtendedAttributes(0700, "ancestor", map[string]*Entry{"file": testFile1Entry}),
+		beta:     testDirectoryWithExtendedAttributes(0755, "beta", map[string]*Entry{"file": testFile1Entry}),
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWaySafe,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges:     nil,
+		expectedConflicts: []*Conflict{
+			{
+				AlphaChanges: []*Change{
+					{
+						Old: testDirectoryWithExtendedAttributes(0755, "ancestor", nil),
+						New: testDirectoryWithExtendedAttributes(0700, "ancestor", nil),
+					},
+				},
+				BetaChanges: []*Change{
+					{
+						Old: testDirectoryWithExtendedAttributes(0755, "ancestor", nil),
+						New: testDirectoryWithExtendedAttributes(0755, "beta", nil),
+					},
+				},
+			},
+		},
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileSameContentsDifferentModificationTimes(t *testing.T) {
+	older := testFile1Entry.Copy()
+	older.ModificationTime = &timestamp.Timestamp{Seconds: 1}
+	newer := testFile1Entry.Copy()
+	newer.ModificationTime = &timestamp.Timestamp{Seconds: 2}
+
+	testCase := reconcileTestCase{
+		ancestor: nil,
+		alpha: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": older},
+		},
+		beta: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": newer},
+		},
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWaySafe,
+			SynchronizationMode_SynchronizationModeTwoWayResolved,
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: []*Change{
+			{New: &Entry{Kind: EntryKind_Directory}},
+		},
+		expectedAlphaChanges: []*Change{
+			{Path: "file", Old: older, New: newer},
+		},
+		expectedBetaChanges: nil,
+		expectedConflicts:   nil,
+	}
+
+	testCase.run(t)
+}
+
+func TestReconcileSameEditDifferentModificationTimes(t *testing.T) {
+	older := testFile1Entry.Copy()
+	older.ModificationTime = &timestamp.Timestamp{Seconds: 1}
+	newer := testFile1Entry.Copy()
+	newer.ModificationTime = &timestamp.Timestamp{Seconds: 2}
+
+	testCase := reconcileTestCase{
+		ancestor: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": testFile2Entry},
+		},
+		alpha: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": newer},
+		},
+		beta: &Entry{
+			Kind:     EntryKind_Directory,
+			Contents: map[string]*Entry{"file": older},
+		},
+		synchronizationModes: []SynchronizationMode{
+			SynchronizationMode_SynchronizationModeTwoWaySafe,
+			SynchronizationMode_SynchronizationModeTwoWayPreserve,
+		},
+		expectedAncestorChanges: nil,
+		expectedAlphaChanges:    nil,
+		expectedBetaChanges: []*Change{
+			{Path: "file", Old: older, New: newer},
+		},
+		expectedConflicts: nil,
+	}
+
+	testCase.run(t)
+}
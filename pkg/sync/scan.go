@@ -1,12 +1,18 @@
 package sync
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"hash"
 	"io"
+	"io/ioutil"
 	"os"
+	pathpkg "path"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -23,18 +29,33 @@ const (
 )
 
 type scanner struct {
-	root                   string
-	hasher                 hash.Hash
-	cache                  *Cache
-	ignorer                *ignorer
-	ignoreCache            IgnoreCache
-	symlinkMode            SymlinkMode
-	newCache               *Cache
-	newIgnoreCache         IgnoreCache
-	buffer                 []byte
-	deviceID               uint64
-	recomposeUnicode       bool
-	preservesExecutability bool
+	root                    string
+	hasher                  hash.Hash
+	cache                   *Cache
+	ignorer                 *ignorer
+	includer                *includer
+	ignoreFileNames         []string
+	ignoreFiles             []*ignoreFile
+	ignoreContext           string
+	predicates              *IgnorePredicates
+	realRoot                string
+	ancestors               map[string]bool
+	now                     time.Time
+	ignoreCache             IgnoreCache
+	symlinkMode             SymlinkMode
+	recordModificationTimes bool
+	permissionsMode         PermissionsMode
+	ownershipNamer          *ownershipNamer
+	extendedAttributes      []string
+	hardLinks               hardLinkTracker
+	excluded                []string
+	problems                []*Problem
+	newCache                *Cache
+	newIgnoreCache          IgnoreCache
+	buffer                  []byte
+	deviceID                uint64
+	recomposeUnicode        bool
+	preservesExecutability  bool
 }
 
 func (s *scanner) file(path string, file fs.ReadableFile, metadata *fs.Metadata, parent *fs.Directory) (*Entry, error) {
@@ -49,7 +70,8 @@ func (s *scanner) file(path string, file fs.ReadableFile, metadata *fs.Metadata,
 		return nil, errors.Wrap(err, "unable to convert modification time format")
 	}
 
-	var digest []byte
+	var digest, attributesDigest []byte
+	var attributes map[string][]byte
 	cached, hit := s.cache.Entries[path]
 	match := hit &&
 		(metadata.Mode&fs.ModeTypeMask) == (fs.Mode(cached.Mode)&fs.ModeTypeMask) &&
@@ -59,6 +81,7 @@ func (s *scanner) file(path string, file fs.ReadableFile, metadata *fs.Metadata,
 		metadata.FileID == cached.FileID
 	if match {
 		digest = cached.Digest
+		attributes, attributesDigest = cached.ExtendedAttributes, cached.ExtendedAttributesDigest
 	}
 
 	if digest == nil {
@@ -82,19 +105,70 @@ func (s *scanner) file(path string, file fs.ReadableFile, metadata *fs.Metadata,
 		digest = s.hasher.Sum(nil)
 	}
 
+	if len(s.extendedAttributes) > 0 && attributesDigest == nil {
+		attributes, attributesDigest, err = s.readExtendedAttributes(func(namespaces []string) (map[string][]byte, error) {
+			if file != nil {
+				return fs.ReadFileExtendedAttributes(file, namespaces)
+			}
+			return parent.ReadContentExtendedAttributes(metadata.Name, namespaces)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s.newCache.Entries[path] = &CacheEntry{
-		Mode:             uint32(metadata.Mode),
-		ModificationTime: modificationTimeProto,
-		Size:             metadata.Size,
-		FileID:           metadata.FileID,
-		Digest:           digest,
+		Mode:                     uint32(metadata.Mode),
+		ModificationTime:         modificationTimeProto,
+		Size:                     metadata.Size,
+		FileID:                   metadata.FileID,
+		Digest:                   digest,
+		ExtendedAttributes:       attributes,
+		ExtendedAttributesDigest: attributesDigest,
 	}
 
-	return &Entry{
-		Kind:       EntryKind_File,
-		Executable: executable,
-		Digest:     digest,
-	}, nil
+	result := &Entry{
+		Kind:                     EntryKind_File,
+		Executable:               executable,
+		Digest:                   digest,
+		ExtendedAttributes:       attributes,
+		ExtendedAttributesDigest: attributesDigest,
+	}
+	if s.recordModificationTimes {
+		result.ModificationTime = modificationTimeProto
+	}
+	s.recordPermissions(result, metadata)
+
+	return result, nil
+}
+
+func (s *scanner) readExtendedAttributes(read func([]string) (map[string][]byte, error)) (map[string][]byte, []byte, error) {
+	attributes, err := read(s.extendedAttributes)
+	if err == fs.ErrExtendedAttributesUnsupported {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to read extended attributes")
+	}
+
+	if len(attributes) == 0 {
+		attributes = nil
+	}
+
+	return attributes, extendedAttributesDigest(attributes), nil
+}
+
+func (s *scanner) recordPermissions(entry *Entry, metadata *fs.Metadata) {
+	if !s.permissionsMode.Preserves() || !s.preservesExecutability {
+		return
+	}
+
+	entry.Permissions = uint32(metadata.Mode & fs.ModePermissionsMask)
+	entry.PermissionsRecorded = true
+
+	if s.ownershipNamer != nil {
+		entry.Owner = s.ownershipNamer.owner(metadata.OwnerID)
+		entry.Group = s.ownershipNamer.group(metadata.GroupID)
+	}
 }
 
 func (s *scanner) symbolicLink(path, name string, parent *fs.Directory, enforcePortable bool) (*Entry, error) {
@@ -119,7 +193,72 @@ func (s *scanner) symbolicLink(path, name string, parent *fs.Directory, enforceP
 	}, nil
 }
 
-func (s *scanner) directory(path string, directory *fs.Directory, metadata *fs.Metadata, parent *fs.Directory) (*Entry, error) {
+func (s *scanner) followSymbolicLink(path, linkPath string) (*Entry, error) {
+
+	target, err := filepath.EvalSymlinks(linkPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "unable to resolve symbolic link")
+	}
+
+	if s.symlinkMode == SymlinkMode_SymlinkFollowWithinRoot {
+		if relative, err := filepath.Rel(s.realRoot, target); err != nil ||
+			relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+			return nil, nil
+		}
+	}
+
+	if s.ancestors[target] {
+		s.problems = append(s.problems, &Problem{
+			Path:  path,
+			Error: "symbolic link cycle detected",
+		})
+		return nil, nil
+	}
+
+	if info, err := os.Stat(target); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "unable to query symbolic link target")
+	} else if !info.IsDir() && !info.Mode().IsRegular() {
+		return nil, nil
+	}
+
+	object, metadata, err := fs.Open(target, false)
+	if err != nil {
+		if os.IsNotExist(err) || err == fs.ErrUnsupportedOpenType {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "unable to open symbolic link target")
+	}
+
+	switch o := object.(type) {
+	case *fs.Directory:
+		if metadata.DeviceID != s.deviceID {
+			o.Close()
+			s.problems = append(s.problems, &Problem{
+				Path:  path,
+				Error: "symbolic link target is on a different filesystem",
+			})
+			return nil, nil
+		}
+		return s.directory(path, target, o, metadata, nil)
+	case fs.ReadableFile:
+		if s.predicates.excludes(metadata, s.now) {
+			o.Close()
+			s.excluded = append(s.excluded, path)
+			return nil, nil
+		}
+		return s.file(path, o, metadata, nil)
+	default:
+		panic("invalid object returned from symbolic link target open operation")
+	}
+}
+
+func (s *scanner) directory(path, realPath string, directory *fs.Directory, metadata *fs.Metadata, parent *fs.Directory) (*Entry, error) {
 
 	if directory != nil {
 		defer directory.Close()
@@ -138,11 +277,26 @@ func (s *scanner) directory(path string, directory *fs.Directory, metadata *fs.M
 		defer directory.Close()
 	}
 
+	if s.symlinkMode.Follows() {
+		s.ancestors[realPath] = true
+		defer delete(s.ancestors, realPath)
+	}
+
 	directoryContents, err := directory.ReadContents()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to read directory contents")
 	}
 
+	if len(s.ignoreFileNames) > 0 {
+		ignoreFiles, ignoreContext := s.ignoreFiles, s.ignoreContext
+		if err := s.loadIgnoreFiles(path, directory, directoryContents); err != nil {
+			return nil, err
+		}
+		defer func() {
+			s.ignoreFiles, s.ignoreContext = ignoreFiles, ignoreContext
+		}()
+	}
+
 	contents := make(map[string]*Entry, len(directoryContents))
 	for _, c := range directoryContents {
 		name := c.Name
@@ -170,19 +324,34 @@ func (s *scanner) directory(path string, directory *fs.Directory, metadata *fs.M
 		}
 
 		isDirectory := kind == EntryKind_Directory
-		ignoreCacheKey := IgnoreCacheKey{contentPath, isDirectory}
+		ignoreCacheKey := IgnoreCacheKey{contentPath, isDirectory, s.ignoreContext}
 		ignored, ok := s.ignoreCache[ignoreCacheKey]
 		if !ok {
 			ignored = s.ignorer.ignored(contentPath, isDirectory)
+			for _, f := range s.ignoreFiles {
+				ignored = f.apply(contentPath, isDirectory, ignored)
+			}
+			ignored = ignored || !s.includer.included(contentPath, isDirectory)
 		}
 		s.newIgnoreCache[ignoreCacheKey] = ignored
 		if ignored {
+			if len(s.ignoreFiles) > 0 && !s.ignoredByConfiguration(contentPath, isDirectory) {
+				s.excluded = append(s.excluded, contentPath)
+			}
+			continue
+		}
+
+		if kind == EntryKind_File && s.predicates.excludes(c, s.now) {
+			s.excluded = append(s.excluded, contentPath)
 			continue
 		}
 
 		var entry *Entry
 		if kind == EntryKind_File {
 			entry, err = s.file(contentPath, nil, c, directory)
+			if err == nil {
+				s.hardLinks.track(contentPath, entry, c)
+			}
 		} else if kind == EntryKind_Symlink {
 			if s.symlinkMode == SymlinkMode_SymlinkPortable {
 				entry, err = s.symbolicLink(contentPath, name, directory, true)
@@ -190,11 +359,20 @@ func (s *scanner) directory(path string, directory *fs.Directory, metadata *fs.M
 				continue
 			} else if s.symlinkMode == SymlinkMode_SymlinkPOSIXRaw {
 				entry, err = s.symbolicLink(contentPath, name, directory, false)
+			} else if s.symlinkMode.Follows() {
+				entry, err = s.followSymbolicLink(contentPath, filepath.Join(realPath, c.Name))
+				if entry == nil && err == nil {
+					continue
+				}
 			} else {
 				panic("unsupported symlink mode")
 			}
 		} else if kind == EntryKind_Directory {
-			entry, err = s.directory(contentPath, nil, c, directory)
+			var childRealPath string
+			if s.symlinkMode.Follows() {
+				childRealPath = filepath.Join(realPath, c.Name)
+			}
+			entry, err = s.directory(contentPath, childRealPath, nil, c, directory)
 		} else {
 			panic("unhandled entry kind")
 		}
@@ -206,24 +384,106 @@ func (s *scanner) directory(path string, directory *fs.Directory, metadata *fs.M
 		contents[name] = entry
 	}
 
-	return &Entry{
+	result := &Entry{
 		Kind:     EntryKind_Directory,
 		Contents: contents,
-	}, nil
+	}
+	s.recordPermissions(result, metadata)
+	if len(s.extendedAttributes) > 0 {
+		result.ExtendedAttributes, result.ExtendedAttributesDigest, err = s.readExtendedAttributes(directory.ReadExtendedAttributes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (s *scanner) ignoredByConfiguration(path string, directory bool) bool {
+	return s.ignorer.ignored(path, directory) || !s.includer.included(path, directory)
 }
 
-func Scan(root string, hasher hash.Hash, cache *Cache, ignores []string, ignoreCache IgnoreCache, symlinkMode SymlinkMode) (*Entry, bool, bool, *Cache, IgnoreCache, error) {
+func (s *scanner) loadIgnoreFiles(path string, directory *fs.Directory, directoryContents []*fs.Metadata) error {
+	for _, name := range s.ignoreFileNames {
+		for _, c := range directoryContents {
+			if c.Name != name || (c.Mode&fs.ModeTypeMask) != fs.ModeTypeFile {
+				continue
+			}
+
+			file, err := directory.OpenFile(name)
+			if err != nil {
+				return errors.Wrap(err, "unable to open ignore file")
+			}
+			data, err := ioutil.ReadAll(file)
+			file.Close()
+			if err != nil {
+				return errors.Wrap(err, "unable to read ignore file")
+			}
+
+			ignorer, invalid := parseIgnoreFile(data)
+			for _, err := range invalid {
+				s.problems = append(s.problems, &Problem{
+					Path:  pathJoin(path, name),
+					Error: err.Error(),
+				})
+			}
+
+			s.ignoreFiles = append(s.ignoreFiles, &ignoreFile{
+				path:    pathJoin(path, name),
+				base:    path,
+				ignorer: ignorer,
+			})
+
+			context := sha1.New()
+			fmt.Fprintf(context, "%s\x00%s\x00", s.ignoreContext, pathJoin(path, name))
+			context.Write(data)
+			s.ignoreContext = hex.EncodeToString(context.Sum(nil))
+
+			break
+		}
+	}
+
+	return nil
+}
+
+type ScanOptions struct {
+	Ignores                 []string
+	Includes                []string
+	IgnoreFileNames         []string
+	Predicates              *IgnorePredicates
+	SymlinkMode             SymlinkMode
+	RecordModificationTimes bool
+	PermissionsMode         PermissionsMode
+	ExtendedAttributes      []string
+}
+
+type ScanResult struct {
+	Root                   *Entry
+	PreservesExecutability bool
+	RecomposeUnicode       bool
+	Cache                  *Cache
+	IgnoreCache            IgnoreCache
+	Excluded               []string
+	Problems               []*Problem
+}
+
+func Scan(root string, hasher hash.Hash, cache *Cache, ignoreCache IgnoreCache, options ScanOptions) (*ScanResult, error) {
 	if cache == nil {
 		cache = &Cache{}
 	}
 
-	ignorer, err := newIgnorer(ignores)
+	ignorer, err := newIgnorer(options.Ignores)
 	if err != nil {
-		return nil, false, false, nil, nil, errors.Wrap(err, "unable to create ignorer")
+		return nil, errors.Wrap(err, "unable to create ignorer")
 	}
 
-	if symlinkMode == SymlinkMode_SymlinkPOSIXRaw && runtime.GOOS == "windows" {
-		return nil, false, false, nil, nil, errors.New("raw POSIX symlinks not supported on Windows")
+	includer, err := newIncluder(options.Includes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create includer")
+	}
+
+	if options.SymlinkMode == SymlinkMode_SymlinkPOSIXRaw && runtime.GOOS == "windows" {
+		return nil, errors.New("raw POSIX symlinks not supported on Windows")
 	}
 
 	initialCacheCapacity := defaultInitialCacheCapacity
@@ -241,23 +501,34 @@ func Scan(root string, hasher hash.Hash, cache *Cache, ignores []string, ignoreC
 	newIgnoreCache := make(IgnoreCache, initialIgnoreCacheCapacity)
 
 	s := &scanner{
-		root:           root,
-		hasher:         hasher,
-		cache:          cache,
-		ignorer:        ignorer,
-		ignoreCache:    ignoreCache,
-		symlinkMode:    symlinkMode,
-		newCache:       newCache,
-		newIgnoreCache: newIgnoreCache,
-		buffer:         make([]byte, scannerCopyBufferSize),
+		root:                    root,
+		hasher:                  hasher,
+		cache:                   cache,
+		ignorer:                 ignorer,
+		includer:                includer,
+		ignoreFileNames:         options.IgnoreFileNames,
+		predicates:              options.Predicates,
+		now:                     time.Now(),
+		ignoreCache:             ignoreCache,
+		symlinkMode:             options.SymlinkMode,
+		recordModificationTimes: options.RecordModificationTimes,
+		permissionsMode:         options.PermissionsMode,
+		extendedAttributes:      options.ExtendedAttributes,
+		newCache:                newCache,
+		newIgnoreCache:          newIgnoreCache,
+		buffer:                  make([]byte, scannerCopyBufferSize),
+		hardLinks:               make(hardLinkTracker),
+	}
+	if options.PermissionsMode.PreservesOwnership() {
+		s.ownershipNamer = newOwnershipNamer(options.PermissionsMode == PermissionsMode_PermissionsPreserveNamed)
 	}
 
 	rootObject, metadata, err := fs.Open(root, false)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, false, false, newCache, newIgnoreCache, nil
+			return &ScanResult{Cache: newCache, IgnoreCache: newIgnoreCache}, nil
 		} else {
-			return nil, false, false, nil, nil, errors.Wrap(err, "unable to probe scan root")
+			return nil, errors.Wrap(err, "unable to probe scan root")
 		}
 	}
 
@@ -271,22 +542,41 @@ func Scan(root string, hasher hash.Hash, cache *Cache, ignores []string, ignoreC
 
 		if decomposes, err := fs.DecomposesUnicode(rootDirectory); err != nil {
 			rootDirectory.Close()
-			return nil, false, false, nil, nil, errors.Wrap(err, "unable to probe root Unicode decomposition behavior")
+			return nil, errors.Wrap(err, "unable to probe root Unicode decomposition behavior")
 		} else {
 			s.recomposeUnicode = decomposes
 		}
 
 		if preserves, err := fs.PreservesExecutability(rootDirectory); err != nil {
 			rootDirectory.Close()
-			return nil, false, false, nil, nil, errors.Wrap(err, "unable to probe root executability preservation behavior")
+			return nil, errors.Wrap(err, "unable to probe root executability preservation behavior")
 		} else {
 			s.preservesExecutability = preserves
 		}
 
-		if rootEntry, err := s.directory("", rootDirectory, metadata, nil); err != nil {
-			return nil, false, false, nil, nil, err
+		if options.SymlinkMode.Follows() {
+			if realRoot, err := filepath.EvalSymlinks(root); err != nil {
+				rootDirectory.Close()
+				return nil, errors.Wrap(err, "unable to resolve root path")
+			} else {
+				s.realRoot = realRoot
+				s.ancestors = make(map[string]bool)
+			}
+		}
+
+		if rootEntry, err := s.directory("", s.realRoot, rootDirectory, metadata, nil); err != nil {
+			return nil, err
 		} else {
-			return rootEntry, s.preservesExecutability, s.recomposeUnicode, newCache, newIgnoreCache, nil
+			s.hardLinks.assign()
+			return &ScanResult{
+				Root:                   rootEntry,
+				PreservesExecutability: s.preservesExecutability,
+				RecomposeUnicode:       s.recomposeUnicode,
+				Cache:                  newCache,
+				IgnoreCache:            newIgnoreCache,
+				Excluded:               s.excluded,
+				Problems:               s.problems,
+			}, nil
 		}
 	} else if rootType == fs.ModeTypeFile {
 		rootFile, ok := rootObject.(fs.ReadableFile)
@@ -296,17 +586,148 @@ func Scan(root string, hasher hash.Hash, cache *Cache, ignores []string, ignoreC
 
 		if preserves, err := fs.PreservesExecutabilityByPath(filepath.Dir(root)); err != nil {
 			rootFile.Close()
-			return nil, false, false, nil, nil, errors.Wrap(err, "unable to probe root parent executability preservation behavior")
+			return nil, errors.Wrap(err, "unable to probe root parent executability preservation behavior")
 		} else {
 			s.preservesExecutability = preserves
 		}
 
 		if rootEntry, err := s.file("", rootFile, metadata, nil); err != nil {
-			return nil, false, false, nil, nil, err
+			return nil, err
 		} else {
-			return rootEntry, s.preservesExecutability, false, newCache, newIgnoreCache, nil
+			return &ScanResult{
+				Root:                   rootEntry,
+				PreservesExecutability: s.preservesExecutability,
+				Cache:                  newCache,
+				IgnoreCache:            newIgnoreCache,
+			}, nil
 		}
 	} else {
 		panic("invalid type returned from root open operation")
 	}
 }
+
+func (s *scanner) explain(path, current string, directory bool, metadata *fs.Metadata) *IgnoreExplanation {
+	explanation := &IgnoreExplanation{Path: path, DecidingPath: current}
+
+	if metadata != nil && excludedKind(metadata.Mode) {
+		explanation.Ignored = true
+		explanation.Predicate = "special file"
+		return explanation
+	}
+
+	if index := s.ignorer.decidingPattern(current, directory); index >= 0 {
+		explanation.Ignored = !s.ignorer.patterns[index].negated
+		explanation.Source = "configuration"
+		explanation.Pattern = s.ignorer.patterns[index].text
+	}
+	for _, f := range s.ignoreFiles {
+		if relative, ok := f.relative(current); ok {
+			if index := f.ignorer.decidingPattern(relative, directory); index >= 0 {
+				explanation.Ignored = !f.ignorer.patterns[index].negated
+				explanation.Source = f.path
+				explanation.Pattern = f.ignorer.patterns[index].text
+			}
+		}
+	}
+
+	if !explanation.Ignored && !s.includer.included(current, directory) {
+		return &IgnoreExplanation{
+			Path:         path,
+			Ignored:      true,
+			DecidingPath: current,
+			NotIncluded:  true,
+		}
+	}
+
+	if !explanation.Ignored && metadata != nil && !directory {
+		if reason := s.predicates.exclusionReason(metadata, s.now); reason != "" {
+			explanation.Ignored = true
+			explanation.Predicate = reason
+		}
+	}
+
+	return explanation
+}
+
+func ExplainIgnored(root, path string, directory bool, options ScanOptions) (*IgnoreExplanation, []*Problem, error) {
+	ignorer, err := newIgnorer(options.Ignores)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to create ignorer")
+	}
+
+	includer, err := newIncluder(options.Includes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to create includer")
+	}
+
+	s := &scanner{
+		root:            root,
+		ignorer:         ignorer,
+		includer:        includer,
+		ignoreFileNames: options.IgnoreFileNames,
+		predicates:      options.Predicates,
+		now:             time.Now(),
+	}
+
+	path = strings.Trim(pathpkg.Clean("/"+path), "/")
+	if path == "" {
+		return &IgnoreExplanation{}, nil, nil
+	}
+
+	var parent *fs.Directory
+	if root != "" {
+		if parent, _, err = fs.OpenDirectory(root, false); err != nil && !os.IsNotExist(err) {
+			return nil, nil, errors.Wrap(err, "unable to open synchronization root")
+		}
+	}
+	defer func() {
+		if parent != nil {
+			parent.Close()
+		}
+	}()
+
+	var explanation *IgnoreExplanation
+	components := strings.Split(path, "/")
+	for c, name := range components {
+		current := strings.Join(components[:c+1], "/")
+		currentDirectory := directory || c < len(components)-1
+
+		var metadata *fs.Metadata
+		if parent != nil {
+			contents, err := parent.ReadContents()
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "unable to read directory contents")
+			}
+			if len(s.ignoreFileNames) > 0 {
+				if err := s.loadIgnoreFiles(strings.Join(components[:c], "/"), parent, contents); err != nil {
+					return nil, nil, err
+				}
+			}
+			for _, m := range contents {
+				if m.Name == name {
+					metadata = m
+					currentDirectory = m.Mode&fs.ModeTypeMask == fs.ModeTypeDirectory
+					break
+				}
+			}
+		}
+
+		explanation = s.explain(path, current, currentDirectory, metadata)
+		if explanation.Ignored || c == len(components)-1 {
+			break
+		}
+
+		if parent != nil {
+			var next *fs.Directory
+			if metadata != nil && currentDirectory {
+				if next, err = parent.OpenDirectory(name); err != nil {
+					return nil, nil, errors.Wrap(err, "unable to open directory")
+				}
+			}
+			parent.Close()
+			parent = next
+		}
+	}
+
+	return explanation, s.problems, nil
+}
@@ -0,0 +1,42 @@
+// +build !windows
+
+package sync
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+func TestScanExcludesSpecialFiles(t *testing.T) {
+
+	root, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := ioutil.WriteFile(filepath.Join(root, "file"), []byte("file"), 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	} else if err := syscall.Mkfifo(filepath.Join(root, "fifo"), 0600); err != nil {
+		t.Fatal("unable to create FIFO:", err)
+	} else if err := os.Symlink("fifo", filepath.Join(root, "link")); err != nil {
+		t.Fatal("unable to create symbolic link:", err)
+	}
+
+	for _, mode := range []SymlinkMode{SymlinkMode_SymlinkPortable, SymlinkMode_SymlinkFollow} {
+		result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: mode})
+		if err != nil {
+			t.Fatal("unable to perform scan:", err)
+		} else if result.Root.Contents["fifo"] != nil {
+			t.Error("FIFO included in scan")
+		} else if result.Root.Contents["file"] == nil {
+			t.Error("regular file excluded from scan")
+		}
+		if mode.Follows() && result.Root.Contents["link"] != nil {
+			t.Error("symbolic link to FIFO followed")
+		}
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -23,15 +25,17 @@ func testCreateScanCycle(temporaryDirectory string, entry *Entry, contentMap map
 
 	hasher := newTestHasher()
 
-	snapshot, preservesExecutability, _, cache, ignoreCache, err := Scan(root, hasher, nil, ignores, nil, symlinkMode)
-	if !preservesExecutability {
-		snapshot = PropagateExecutability(nil, entry, snapshot)
-	}
+	result, err := Scan(root, hasher, nil, nil, ScanOptions{Ignores: ignores, SymlinkMode: symlinkMode})
 	if err != nil {
 		return errors.Wrap(err, "unable to perform scan")
-	} else if cache == nil {
+	}
+	snapshot := result.Root
+	if !result.PreservesExecutability {
+		snapshot = PropagateExecutability(nil, entry, snapshot)
+	}
+	if result.Cache == nil {
 		return errors.New("nil cache returned")
-	} else if ignoreCache == nil {
+	} else if result.IgnoreCache == nil {
 		return errors.New("nil ignore cache returned")
 	} else if expectEqual && !snapshot.Equal(entry) {
 		return errors.New("snapshot not equal to expected")
@@ -229,6 +233,242 @@ func TestScanSubfileNotIgnoredOnRootSpecification(t *testing.T) {
 	}
 }
 
+func TestScanInclude(t *testing.T) {
+	root, parent, err := testTransitionCreate("", testDirectory1Entry, testDirectory1ContentMap, false)
+	if err != nil {
+		t.Fatal("unable to create test content:", err)
+	}
+	defer os.RemoveAll(parent)
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{Includes: []string{"second directory/**"}, SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	} else if result.Root == nil || len(result.Root.Contents) != 1 {
+		t.Fatal("scan did not restrict contents to included paths")
+	} else if !result.Root.Contents["second directory"].Equal(testDirectory1Entry.Contents["second directory"]) {
+		t.Error("included directory contents not scanned")
+	}
+}
+
+func TestScanIgnoreFiles(t *testing.T) {
+
+	root, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(root)
+
+	files := map[string]string{
+		DoppelgangerIgnoreFileName:              "*.log\n",
+		"root.log":                              "",
+		"build/output":                          "",
+		filepath.Join("sub", GitIgnoreFileName): "/build/\n!keep.log\n\\\n",
+		"sub/other.log":                         "",
+		"sub/keep.log":                          "",
+		"sub/build/output":                      "",
+		"sub/nested/build/output":               "",
+	}
+	for path, contents := range files {
+		path = filepath.Join(root, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal("unable to create directory:", err)
+		} else if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+			t.Fatal("unable to create file:", err)
+		}
+	}
+
+	ignoreFileNames := IgnoreFilesMode_IgnoreFilesDoppelgangerAndGit.FileNames()
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{IgnoreFileNames: ignoreFileNames, SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	}
+	expected := map[string]bool{
+		"root.log":                false,
+		"build/output":            true,
+		"sub/other.log":           false,
+		"sub/keep.log":            true,
+		"sub/build":               false,
+		"sub/nested/build/output": true,
+	}
+	for path, present := range expected {
+		if (testScanEntryAtPath(result.Root, path) != nil) != present {
+			t.Error("unexpected presence for", path, ":", !present)
+		}
+	}
+
+	excluded := make(map[string]bool, len(result.Excluded))
+	for _, path := range result.Excluded {
+		excluded[path] = true
+	}
+	if len(excluded) != 3 || !excluded["root.log"] || !excluded["sub/other.log"] || !excluded["sub/build"] {
+		t.Error("unexpected paths reported as excluded by ignore files:", result.Excluded)
+	}
+
+	if len(result.Problems) != 1 {
+		t.Error("unexpected number of problems reported:", len(result.Problems))
+	} else if result.Problems[0].Path != "sub/"+GitIgnoreFileName {
+		t.Error("invalid ignore file line reported at incorrect path:", result.Problems[0].Path)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(root, DoppelgangerIgnoreFileName), nil, 0600); err != nil {
+		t.Fatal("unable to update ignore file:", err)
+	}
+
+	result, err = Scan(root, newTestHasher(), nil, result.IgnoreCache, ScanOptions{IgnoreFileNames: ignoreFileNames, SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform rescan:", err)
+	} else if testScanEntryAtPath(result.Root, "root.log") == nil {
+		t.Error("ignore file change did not invalidate ignore cache")
+	} else if testScanEntryAtPath(result.Root, "sub/other.log") == nil {
+		t.Error("ignore file change did not invalidate ignore cache for subdirectory")
+	}
+
+	result, err = Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan without ignore files:", err)
+	} else if testScanEntryAtPath(result.Root, "sub/build") == nil {
+		t.Error("ignore files applied when disabled")
+	}
+}
+
+func TestScanIgnorePredicates(t *testing.T) {
+
+	root, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := ioutil.WriteFile(filepath.Join(root, "small"), []byte("small"), 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	} else if err := ioutil.WriteFile(filepath.Join(root, "large"), make([]byte, 1024), 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	} else if err := ioutil.WriteFile(filepath.Join(root, "old"), nil, 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	}
+	old := time.Now().Add(-48 * time.Hour)
+	if err := os.Chtimes(filepath.Join(root, "old"), old, old); err != nil {
+		t.Fatal("unable to set modification time:", err)
+	}
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	} else if len(result.Root.Contents) != 3 {
+		t.Fatal("unexpected number of entries without predicates")
+	}
+
+	predicates := &IgnorePredicates{
+		MaximumFileSize: 512,
+		MaximumFileAge:  24 * time.Hour,
+	}
+
+	result, err = Scan(root, newTestHasher(), result.Cache, nil, ScanOptions{Predicates: predicates, SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	} else if len(result.Root.Contents) != 1 || result.Root.Contents["small"] == nil {
+		t.Error("predicates did not exclude previously tracked files")
+	} else if _, cached := result.Cache.Entries["large"]; cached {
+		t.Error("excluded file was hashed")
+	}
+
+	excluded := make(map[string]bool, len(result.Excluded))
+	for _, path := range result.Excluded {
+		excluded[path] = true
+	}
+	if len(excluded) != 2 || !excluded["large"] || !excluded["old"] {
+		t.Error("unexpected paths reported as excluded by predicates:", result.Excluded)
+	}
+}
+
+func TestScanSymlinkFollow(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip()
+	}
+
+	parent, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(parent)
+
+	root := filepath.Join(parent, "root")
+	shared := filepath.Join(parent, "shared")
+	for _, directory := range []string{filepath.Join(root, "package"), shared} {
+		if err := os.MkdirAll(directory, 0700); err != nil {
+			t.Fatal("unable to create directory:", err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(shared, "settings"), []byte("shared"), 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	} else if err := ioutil.WriteFile(filepath.Join(root, "file"), []byte("file"), 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	}
+	links := map[string]string{
+		filepath.Join(root, "package", "config"): filepath.Join("..", "..", "shared"),
+		filepath.Join(root, "link"):              "file",
+		filepath.Join(root, "dangling"):          "missing",
+	}
+	for link, target := range links {
+		if err := os.Symlink(target, link); err != nil {
+			t.Fatal("unable to create symlink:", err)
+		}
+	}
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkFollow})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	}
+	if entry := testScanEntryAtPath(result.Root, "package/config/settings"); entry == nil || entry.Kind != EntryKind_File {
+		t.Error("directory symbolic link target not synchronized as content")
+	}
+	if entry := testScanEntryAtPath(result.Root, "link"); entry == nil || entry.Kind != EntryKind_File {
+		t.Error("file symbolic link target not synchronized as content")
+	}
+	if testScanEntryAtPath(result.Root, "dangling") != nil {
+		t.Error("dangling symbolic link included in scan")
+	}
+
+	result, err = Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkFollowWithinRoot})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	}
+	if testScanEntryAtPath(result.Root, "package/config") != nil {
+		t.Error("symbolic link leaving root followed")
+	}
+	if testScanEntryAtPath(result.Root, "link") == nil {
+		t.Error("symbolic link within root not followed")
+	}
+
+	if err := os.Symlink("..", filepath.Join(root, "package", "cycle")); err != nil {
+		t.Fatal("unable to create symlink:", err)
+	}
+	result, err = Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkFollow})
+	if err != nil {
+		t.Fatal("symbolic link cycle failed scan:", err)
+	}
+	if testScanEntryAtPath(result.Root, "package/cycle") != nil {
+		t.Error("symbolic link cycle included in scan")
+	}
+	if len(result.Problems) != 1 || result.Problems[0].Path != "package/cycle" {
+		t.Error("symbolic link cycle not reported as problem:", result.Problems)
+	}
+	if testScanEntryAtPath(result.Root, "package/config/settings") == nil {
+		t.Error("symbolic link outside cycle not followed")
+	}
+}
+
+func testScanEntryAtPath(entry *Entry, path string) *Entry {
+	for _, component := range strings.Split(path, "/") {
+		if entry == nil {
+			return nil
+		}
+		entry = entry.Contents[component]
+	}
+	return entry
+}
+
 func TestScanSymlinkRoot(t *testing.T) {
 
 	parent, err := ioutil.TempDir("", "doppelganger_simulated")
@@ -243,7 +483,7 @@ func TestScanSymlinkRoot(t *testing.T) {
 		t.Fatal("unable to create symlink:", err)
 	}
 
-	if _, _, _, _, _, err := Scan(root, sha1.New(), nil, nil, nil, SymlinkMode_SymlinkPortable); err == nil {
+	if _, err := Scan(root, sha1.New(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable}); err == nil {
 		t.Error("scan of symlink root allowed")
 	}
 }
@@ -268,32 +508,36 @@ func TestEfficientRescan(t *testing.T) {
 
 	hasher := newTestHasher()
 
-	snapshot, preservesExecutability, _, cache, ignoreCache, err := Scan(root, hasher, nil, nil, nil, SymlinkMode_SymlinkPortable)
-	if !preservesExecutability {
-		snapshot = PropagateExecutability(nil, testDirectory1Entry, snapshot)
-	}
+	result, err := Scan(root, hasher, nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
 	if err != nil {
 		t.Fatal("unable to create snapshot:", err)
-	} else if cache == nil {
+	} else if result.Cache == nil {
 		t.Fatal("nil cache returned")
-	} else if ignoreCache == nil {
+	} else if result.IgnoreCache == nil {
 		t.Fatal("nil ignore cache returned")
-	} else if !snapshot.Equal(testDirectory1Entry) {
+	}
+	snapshot := result.Root
+	if !result.PreservesExecutability {
+		snapshot = PropagateExecutability(nil, testDirectory1Entry, snapshot)
+	}
+	if !snapshot.Equal(testDirectory1Entry) {
 		t.Error("snapshot did not match expected")
 	}
 
 	hasher = &rescanHashProxy{hasher, t}
-	snapshot, preservesExecutability, _, cache, ignoreCache, err = Scan(root, hasher, cache, nil, nil, SymlinkMode_SymlinkPortable)
-	if !preservesExecutability {
-		snapshot = PropagateExecutability(nil, testDirectory1Entry, snapshot)
-	}
+	result, err = Scan(root, hasher, result.Cache, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
 	if err != nil {
 		t.Fatal("unable to rescan:", err)
-	} else if cache == nil {
+	} else if result.Cache == nil {
 		t.Fatal("nil second cache returned")
-	} else if ignoreCache == nil {
+	} else if result.IgnoreCache == nil {
 		t.Fatal("nil second ignore cache returned")
-	} else if !snapshot.Equal(testDirectory1Entry) {
+	}
+	snapshot = result.Root
+	if !result.PreservesExecutability {
+		snapshot = PropagateExecutability(nil, testDirectory1Entry, snapshot)
+	}
+	if !snapshot.Equal(testDirectory1Entry) {
 		t.Error("second snapshot did not match expected")
 	}
 }
@@ -309,7 +553,115 @@ func TestScanCrossDeviceFail(t *testing.T) {
 
 	hasher := newTestHasher()
 
-	if _, _, _, _, _, err := Scan(parent, hasher, nil, nil, nil, SymlinkMode_SymlinkPortable); err == nil {
+	if _, err := Scan(parent, hasher, nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable}); err == nil {
 		t.Error("scan across device boundary did not fail")
 	}
 }
+
+func TestScanPreservePermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip()
+	}
+
+	root, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary root:", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := ioutil.WriteFile(filepath.Join(root, "file"), []byte("contents"), 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	} else if err := os.Chmod(filepath.Join(root, "file"), 0640); err != nil {
+		t.Fatal("unable to set file permissions:", err)
+	}
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	} else if file := result.Root.Contents["file"]; file.Permissions != 0 || file.Owner != "" {
+		t.Error("permissions recorded in portable mode")
+	}
+
+	result, err = Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable, PermissionsMode: PermissionsMode_PermissionsPreserve})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	} else if file := result.Root.Contents["file"]; file.Permissions != 0640 {
+		t.Errorf("incorrect permissions recorded: %#o", file.Permissions)
+	} else if file.Owner != "" || file.Group != "" {
+		t.Error("ownership recorded without ownership preservation")
+	}
+
+	result, err = Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable, PermissionsMode: PermissionsMode_PermissionsPreserveNumeric})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	} else if file := result.Root.Contents["file"]; file.Owner != fmt.Sprintf("id:%d", os.Getuid()) {
+		t.Error("incorrect owner recorded:", file.Owner)
+	} else if file.Group != fmt.Sprintf("id:%d", os.Getgid()) {
+		t.Error("incorrect group recorded:", file.Group)
+	}
+}
+
+func TestScanHardLinks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip()
+	}
+
+	root, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary root:", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := os.Mkdir(filepath.Join(root, "directory"), 0700); err != nil {
+		t.Fatal("unable to create directory:", err)
+	} else if err := ioutil.WriteFile(filepath.Join(root, "file"), []byte("linked"), 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	} else if err := os.Link(filepath.Join(root, "file"), filepath.Join(root, "directory", "link")); err != nil {
+		t.Fatal("unable to create hard link:", err)
+	} else if err := ioutil.WriteFile(filepath.Join(root, "other"), []byte("linked"), 0600); err != nil {
+		t.Fatal("unable to create unlinked file:", err)
+	}
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	}
+
+	if group := result.Root.Contents["directory"].Contents["link"].HardLinkGroup; group != "directory/link" {
+		t.Error("incorrect hard link group for link:", group)
+	} else if group = result.Root.Contents["file"].HardLinkGroup; group != "directory/link" {
+		t.Error("incorrect hard link group for file:", group)
+	} else if group = result.Root.Contents["other"].HardLinkGroup; group != "" {
+		t.Error("unlinked file assigned hard link group:", group)
+	}
+}
+
+func TestScanCachesExtendedAttributes(t *testing.T) {
+	root := createExtendedAttributesTestParent(t)
+	defer os.RemoveAll(root)
+
+	options := ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable, ExtendedAttributes: []string{"user"}}
+
+	result, err := Scan(root, sha1.New(), nil, nil, options)
+	if err != nil {
+		t.Fatal("unable to perform initial scan:", err)
+	}
+	if string(result.Root.Contents["probe"].ExtendedAttributes["user.probe"]) != "probe" {
+		t.Fatal("extended attribute not recorded by initial scan")
+	}
+
+	cached := result.Cache.Entries["probe"]
+	if cached == nil || len(cached.ExtendedAttributesDigest) == 0 {
+		t.Fatal("extended attributes not recorded in cache")
+	}
+	cached.ExtendedAttributes = map[string][]byte{"user.probe": []byte("cached")}
+	cached.ExtendedAttributesDigest = extendedAttributesDigest(cached.ExtendedAttributes)
+
+	result, err = Scan(root, sha1.New(), result.Cache, nil, options)
+	if err != nil {
+		t.Fatal("unable to perform cached scan:", err)
+	}
+	if string(result.Root.Contents["probe"].ExtendedAttributes["user.probe"]) != "cached" {
+		t.Error("extended attributes re-read despite cache hit")
+	}
+}
@@ -10,6 +10,8 @@ type stagingPathFinder struct {
 	paths []string
 
 	digests [][]byte
+
+	hardLinkGroups []string
 }
 
 func (f *stagingPathFinder) find(path string, entry *Entry) error {
@@ -27,6 +29,7 @@ func (f *stagingPathFinder) find(path string, entry *Entry) error {
 	} else if entry.Kind == EntryKind_File {
 		f.paths = append(f.paths, path)
 		f.digests = append(f.digests, entry.Digest)
+		f.hardLinkGroups = append(f.hardLinkGroups, entry.HardLinkGroup)
 	} else if entry.Kind == EntryKind_Symlink {
 		return nil
 	} else {
@@ -50,5 +53,79 @@ func TransitionDependencies(transitions []*Change) ([]string, [][]byte, error) {
 			return nil, nil, errors.Wrap(err, "unable to find staging paths")
 		}
 	}
-	return finder.paths, finder.digests, nil
+	paths, digests := finder.linkFiltered()
+	return paths, digests, nil
+}
+
+func (f *stagingPathFinder) linkFiltered() ([]string, [][]byte) {
+	staged := make(map[string][]byte, len(f.paths))
+	for p, path := range f.paths {
+		staged[path] = f.digests[p]
+	}
+
+	paths := f.paths[:0]
+	digests := f.digests[:0]
+	for p, path := range f.paths {
+		group := f.hardLinkGroups[p]
+		if group != "" && group != path && bytes.Equal(staged[group], f.digests[p]) {
+			continue
+		}
+		paths = append(paths, path)
+		digests = append(digests, f.digests[p])
+	}
+
+	return paths, digests
+}
+
+type supplyPathFinder struct {
+	missing map[string]bool
+	paths   map[string]string
+}
+
+func (f *supplyPathFinder) find(path string, entry *Entry) {
+	if entry == nil || len(f.missing) == 0 {
+		return
+	}
+
+	if entry.Kind == EntryKind_Directory {
+		for name, entry := range entry.Contents {
+			f.find(pathJoin(path, name), entry)
+		}
+	} else if entry.Kind == EntryKind_File && f.missing[string(entry.Digest)] {
+		f.paths[string(entry.Digest)] = path
+		delete(f.missing, string(entry.Digest))
+	}
+}
+
+func SupplyPaths(source *Entry, paths []string, digests map[string][]byte) []string {
+	finder := &supplyPathFinder{
+		missing: make(map[string]bool),
+		paths:   make(map[string]string),
+	}
+
+	result := make([]string, len(paths))
+	var unmatched []int
+	for p, path := range paths {
+		result[p] = path
+
+		digest := digests[path]
+		if entry := source.lookup(path); entry != nil && entry.Kind == EntryKind_File && bytes.Equal(entry.Digest, digest) {
+			continue
+		}
+		finder.missing[string(digest)] = true
+		unmatched = append(unmatched, p)
+	}
+
+	if len(unmatched) == 0 {
+		return result
+	}
+	finder.find("", source)
+
+	for _, p := range unmatched {
+		if sourcePath, ok := finder.paths[string(digests[paths[p]])]; ok {
+			result[p] = sourcePath
+		}
+	}
+
+	return result
 }
@@ -78,3 +78,72 @@ func TestTransitionDependenciesOnlyExecutableBitChange(t *testing.T) {
 		t.Error("digest count does not match path count")
 	}
 }
+
+func TestTransitionDependenciesHardLinks(t *testing.T) {
+	first := testFile1Entry.Copy()
+	first.HardLinkGroup = "first"
+	second := testFile1Entry.Copy()
+	second.HardLinkGroup = "first"
+	orphan := testFile1Entry.Copy()
+	orphan.HardLinkGroup = "missing"
+	transitions := []*Change{
+		{
+			Path: "",
+			New: &Entry{
+				Contents: map[string]*Entry{
+					"first":  first,
+					"second": second,
+					"orphan": orphan,
+				},
+			},
+		},
+	}
+	if paths, digests, err := TransitionDependencies(transitions); err != nil {
+		t.Error("transition dependency finding failed:", err)
+	} else if len(paths) != 2 {
+		t.Error("unexpected number of entries:", paths)
+	} else if len(digests) != len(paths) {
+		t.Error("digest count does not match path count")
+	} else {
+		for _, path := range paths {
+			if path == "second" {
+				t.Error("hard link with staged group source requested for staging")
+			}
+		}
+	}
+}
+
+func TestSupplyPathsMatching(t *testing.T) {
+	paths := []string{"file", "directory/subfile"}
+	digests := map[string][]byte{
+		"file":              testFile1Entry.Digest,
+		"directory/subfile": testFile3Entry.Digest,
+	}
+	supplyPaths := SupplyPaths(testDirectory1Entry, paths, digests)
+	if len(supplyPaths) != len(paths) {
+		t.Fatal("supply path count does not match path count")
+	}
+	for p, path := range paths {
+		if supplyPaths[p] != path {
+			t.Errorf("supply path (%s) does not match expected (%s)", supplyPaths[p], path)
+		}
+	}
+}
+
+func TestSupplyPathsRelocated(t *testing.T) {
+	paths := []string{"file.copy", "missing"}
+	digests := map[string][]byte{
+		"file.copy": testFile2Entry.Digest,
+		"missing":   {0x01, 0x02},
+	}
+	supplyPaths := SupplyPaths(testDirectory1Entry, paths, digests)
+	if len(supplyPaths) != len(paths) {
+		t.Fatal("supply path count does not match path count")
+	}
+	if supplyPaths[0] != "executable file" {
+		t.Error("relocated supply path incorrect:", supplyPaths[0])
+	}
+	if supplyPaths[1] != "missing" {
+		t.Error("unlocatable supply path modified:", supplyPaths[1])
+	}
+}
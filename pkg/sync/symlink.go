@@ -21,6 +21,10 @@ func (m *SymlinkMode) UnmarshalText(textBytes []byte) error {
 		*m = SymlinkMode_SymlinkPortable
 	case "posix-raw":
 		*m = SymlinkMode_SymlinkPOSIXRaw
+	case "follow":
+		*m = SymlinkMode_SymlinkFollow
+	case "follow-within-root":
+		*m = SymlinkMode_SymlinkFollowWithinRoot
 	default:
 		return errors.Errorf("unknown symlink mode specification: %s", text)
 	}
@@ -36,6 +40,10 @@ func (m SymlinkMode) Supported() bool {
 		return true
 	case SymlinkMode_SymlinkPOSIXRaw:
 		return true
+	case SymlinkMode_SymlinkFollow:
+		return true
+	case SymlinkMode_SymlinkFollowWithinRoot:
+		return true
 	default:
 		return false
 	}
@@ -51,11 +59,19 @@ func (m SymlinkMode) Description() string {
 		return "Portable"
 	case SymlinkMode_SymlinkPOSIXRaw:
 		return "POSIX Raw"
+	case SymlinkMode_SymlinkFollow:
+		return "Follow"
+	case SymlinkMode_SymlinkFollowWithinRoot:
+		return "Follow (Within Root)"
 	default:
 		return "Unknown"
 	}
 }
 
+func (m SymlinkMode) Follows() bool {
+	return m == SymlinkMode_SymlinkFollow || m == SymlinkMode_SymlinkFollowWithinRoot
+}
+
 const (
 	maximumPortableSymlinkTargetLength = 247
 )
@@ -16,10 +16,12 @@ const _ = proto.ProtoPackageIsVersion2
 type SymlinkMode int32
 
 const (
-	SymlinkMode_SymlinkDefault  SymlinkMode = 0
-	SymlinkMode_SymlinkIgnore   SymlinkMode = 1
-	SymlinkMode_SymlinkPortable SymlinkMode = 2
-	SymlinkMode_SymlinkPOSIXRaw SymlinkMode = 3
+	SymlinkMode_SymlinkDefault          SymlinkMode = 0
+	SymlinkMode_SymlinkIgnore           SymlinkMode = 1
+	SymlinkMode_SymlinkPortable         SymlinkMode = 2
+	SymlinkMode_SymlinkPOSIXRaw         SymlinkMode = 3
+	SymlinkMode_SymlinkFollow           SymlinkMode = 4
+	SymlinkMode_SymlinkFollowWithinRoot SymlinkMode = 5
 )
 
 var SymlinkMode_name = map[int32]string{
@@ -27,36 +29,43 @@ var SymlinkMode_name = map[int32]string{
 	1: "SymlinkIgnore",
 	2: "SymlinkPortable",
 	3: "SymlinkPOSIXRaw",
+	4: "SymlinkFollow",
+	5: "SymlinkFollowWithinRoot",
 }
 var SymlinkMode_value = map[string]int32{
-	"SymlinkDefault":  0,
-	"SymlinkIgnore":   1,
-	"SymlinkPortable": 2,
-	"SymlinkPOSIXRaw": 3,
+	"SymlinkDefault":          0,
+	"SymlinkIgnore":           1,
+	"SymlinkPortable":         2,
+	"SymlinkPOSIXRaw":         3,
+	"SymlinkFollow":           4,
+	"SymlinkFollowWithinRoot": 5,
 }
 
 func (x SymlinkMode) String() string {
 	return proto.EnumName(SymlinkMode_name, int32(x))
 }
 func (SymlinkMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_symlink_4cd18dbde9e3800f, []int{0}
+	return fileDescriptor_symlink_6b064ec54dd5d784, []int{0}
 }
 
 func init() {
 	proto.RegisterEnum("sync.SymlinkMode", SymlinkMode_name, SymlinkMode_value)
 }
 
-func init() { proto.RegisterFile("sync/symlink.proto", fileDescriptor_symlink_4cd18dbde9e3800f) }
+func init() { proto.RegisterFile("sync/symlink.proto", fileDescriptor_symlink_6b064ec54dd5d784) }
 
-var fileDescriptor_symlink_4cd18dbde9e3800f = []byte{
+var fileDescriptor_symlink_6b064ec54dd5d784 = []byte{
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0xae, 0xcc, 0x4b,
 	0xd6, 0x2f, 0xae, 0xcc, 0xcd, 0xc9, 0xcc, 0xcb, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
-	0x01, 0x89, 0x69, 0xc5, 0x71, 0x71, 0x07, 0x43, 0x84, 0x7d, 0xf3, 0x53, 0x52, 0x85, 0x84, 0xb8,
-	0xf8, 0xa0, 0x5c, 0x97, 0xd4, 0xb4, 0xc4, 0xd2, 0x9c, 0x12, 0x01, 0x06, 0x21, 0x41, 0x2e, 0x5e,
-	0xa8, 0x98, 0x67, 0x7a, 0x5e, 0x7e, 0x51, 0xaa, 0x00, 0xa3, 0x90, 0x30, 0x17, 0x3f, 0x54, 0x28,
-	0x20, 0xbf, 0xa8, 0x24, 0x31, 0x29, 0x27, 0x55, 0x80, 0x09, 0x59, 0xd0, 0x3f, 0xd8, 0x33, 0x22,
-	0x28, 0xb1, 0x5c, 0x80, 0xd9, 0x49, 0x2d, 0x4a, 0x25, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0x3f, 0x23, 0xb1, 0x2c, 0x3f, 0x59, 0x37, 0x33, 0x5f, 0x3f, 0xb7, 0xb4, 0x24,
-	0x31, 0x3d, 0x35, 0x4f, 0xbf, 0x20, 0x3b, 0x5d, 0x1f, 0xe4, 0x8e, 0x24, 0x36, 0xb0, 0xa3, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x07, 0x1b, 0xc8, 0xaa, 0x00, 0x00, 0x00,
+	0x01, 0x89, 0x69, 0xf5, 0x31, 0x72, 0x71, 0x07, 0x43, 0xc4, 0x7d, 0xf3, 0x53, 0x52, 0x85, 0x84,
+	0xb8, 0xf8, 0xa0, 0x5c, 0x97, 0xd4, 0xb4, 0xc4, 0xd2, 0x9c, 0x12, 0x01, 0x06, 0x21, 0x41, 0x2e,
+	0x5e, 0xa8, 0x98, 0x67, 0x7a, 0x5e, 0x7e, 0x51, 0xaa, 0x00, 0xa3, 0x90, 0x30, 0x17, 0x3f, 0x54,
+	0x28, 0x20, 0xbf, 0xa8, 0x24, 0x31, 0x29, 0x27, 0x55, 0x80, 0x09, 0x59, 0xd0, 0x3f, 0xd8, 0x33,
+	0x22, 0x28, 0xb1, 0x5c, 0x80, 0x19, 0x49, 0xb3, 0x5b, 0x7e, 0x4e, 0x4e, 0x7e, 0xb9, 0x00, 0x8b,
+	0x90, 0x34, 0x97, 0x38, 0x8a, 0x50, 0x78, 0x66, 0x49, 0x46, 0x66, 0x5e, 0x50, 0x7e, 0x7e, 0x89,
+	0x00, 0xab, 0x93, 0x7e, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
+	0x7e, 0x50, 0x7e, 0x76, 0xa5, 0x6b, 0x51, 0x66, 0x72, 0x76, 0x71, 0x7e, 0x9e, 0x7e, 0x4a, 0x7e,
+	0x41, 0x41, 0x6a, 0x4e, 0x7a, 0x62, 0x5e, 0x7a, 0x6a, 0x91, 0x7e, 0x41, 0x76, 0xba, 0x3e, 0xc8,
+	0x07, 0x49, 0x6c, 0x60, 0xef, 0x18, 0x03, 0x06, 0x00, 0x39, 0x87, 0xf3, 0x96, 0xe4, 0x00, 0x00,
+	0x00,
 }
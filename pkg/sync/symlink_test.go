@@ -31,6 +31,24 @@ func TestSymlinkModeUnmarshalPOSIXRaw(t *testing.T) {
 	}
 }
 
+func TestSymlinkModeUnmarshalFollow(t *testing.T) {
+	var mode SymlinkMode
+	if err := mode.UnmarshalText([]byte("follow")); err != nil {
+		t.Fatal("unable to unmarshal text:", err)
+	} else if mode != SymlinkMode_SymlinkFollow {
+		t.Error("unmarshalled mode does not match expected")
+	}
+}
+
+func TestSymlinkModeUnmarshalFollowWithinRoot(t *testing.T) {
+	var mode SymlinkMode
+	if err := mode.UnmarshalText([]byte("follow-within-root")); err != nil {
+		t.Fatal("unable to unmarshal text:", err)
+	} else if mode != SymlinkMode_SymlinkFollowWithinRoot {
+		t.Error("unmarshalled mode does not match expected")
+	}
+}
+
 func TestSymlinkModeUnmarshalEmpty(t *testing.T) {
 	var mode SymlinkMode
 	if mode.UnmarshalText([]byte("")) == nil {
@@ -58,7 +76,13 @@ func TestSymlinkModeSupported(t *testing.T) {
 	if !SymlinkMode_SymlinkPOSIXRaw.Supported() {
 		t.Error("POSIX raw symlink mode considered unsupported")
 	}
-	if (SymlinkMode_SymlinkPOSIXRaw + 1).Supported() {
+	if !SymlinkMode_SymlinkFollow.Supported() {
+		t.Error("follow symlink mode considered unsupported")
+	}
+	if !SymlinkMode_SymlinkFollowWithinRoot.Supported() {
+		t.Error("follow within root symlink mode considered unsupported")
+	}
+	if (SymlinkMode_SymlinkFollowWithinRoot + 1).Supported() {
 		t.Error("invalid symlink mode considered supported")
 	}
 }
@@ -76,11 +100,29 @@ func TestSymlinkModeDescription(t *testing.T) {
 	if description := SymlinkMode_SymlinkPOSIXRaw.Description(); description != "POSIX Raw" {
 		t.Error("symlink mode POSIX raw description incorrect:", description, "!=", "POSIX Raw")
 	}
-	if description := (SymlinkMode_SymlinkPOSIXRaw + 1).Description(); description != "Unknown" {
+	if description := SymlinkMode_SymlinkFollow.Description(); description != "Follow" {
+		t.Error("symlink mode follow description incorrect:", description, "!=", "Follow")
+	}
+	if description := SymlinkMode_SymlinkFollowWithinRoot.Description(); description != "Follow (Within Root)" {
+		t.Error("symlink mode follow within root description incorrect:", description, "!=", "Follow (Within Root)")
+	}
+	if description := (SymlinkMode_SymlinkFollowWithinRoot + 1).Description(); description != "Unknown" {
 		t.Error("invalid symlink mode description incorrect:", description, "!=", "Unknown")
 	}
 }
 
+func TestSymlinkModeFollows(t *testing.T) {
+	if SymlinkMode_SymlinkPortable.Follows() {
+		t.Error("portable symlink mode considered to follow links")
+	}
+	if !SymlinkMode_SymlinkFollow.Follows() {
+		t.Error("follow symlink mode not considered to follow links")
+	}
+	if !SymlinkMode_SymlinkFollowWithinRoot.Follows() {
+		t.Error("follow within root symlink mode not considered to follow links")
+	}
+}
+
 func TestSymlinkEmptyTargetInvalid(t *testing.T) {
 	if _, err := normalizeSymlinkAndEnsurePortable("file", ""); err == nil {
 		t.Fatal("symlink with empty target treated as portable")
@@ -0,0 +1,52 @@
+package sync
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func ParseDeletionThreshold(text string) (uint64, uint32, error) {
+	if strings.HasSuffix(text, "%") {
+		percentage, err := strconv.ParseUint(strings.TrimSuffix(text, "%"), 10, 32)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "unable to parse deletion threshold percentage")
+		} else if percentage == 0 || percentage > 100 {
+			return 0, 0, errors.New("deletion threshold percentage must be between 1 and 100")
+		}
+		return 0, uint32(percentage), nil
+	}
+
+	count, err := strconv.ParseUint(text, 10, 64)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "unable to parse deletion threshold count")
+	} else if count == 0 {
+		return 0, 0, errors.New("deletion threshold count must be non-zero")
+	}
+	return count, 0, nil
+}
+
+func deletionCount(old, new *Entry) uint64 {
+	if old == nil {
+		return 0
+	} else if new == nil || old.Kind != new.Kind {
+		return old.Count()
+	} else if old.Kind != EntryKind_Directory {
+		return 0
+	}
+
+	var result uint64
+	for name, entry := range old.Contents {
+		result += deletionCount(entry, new.Contents[name])
+	}
+	return result
+}
+
+func DeletionCount(transitions []*Change) uint64 {
+	var result uint64
+	for _, t := range transitions {
+		result += deletionCount(t.Old, t.New)
+	}
+	return result
+}
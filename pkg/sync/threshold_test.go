@@ -0,0 +1,50 @@
+package sync
+
+import (
+	"testing"
+)
+
+func TestParseDeletionThreshold(t *testing.T) {
+	testCases := []struct {
+		text       string
+		count      uint64
+		percentage uint32
+		valid      bool
+	}{
+		{"100", 100, 0, true},
+		{"25%", 0, 25, true},
+		{"100%", 0, 100, true},
+		{"0", 0, 0, false},
+		{"0%", 0, 0, false},
+		{"101%", 0, 0, false},
+		{"-5", 0, 0, false},
+		{"many", 0, 0, false},
+	}
+
+	for _, testCase := range testCases {
+		count, percentage, err := ParseDeletionThreshold(testCase.text)
+		if testCase.valid && err != nil {
+			t.Error("valid threshold rejected:", testCase.text, err)
+		} else if !testCase.valid && err == nil {
+			t.Error("invalid threshold accepted:", testCase.text)
+		} else if count != testCase.count || percentage != testCase.percentage {
+			t.Error("threshold parsed incorrectly:", testCase.text, count, percentage)
+		}
+	}
+}
+
+func TestDeletionCount(t *testing.T) {
+	transitions := []*Change{
+		{Path: "directory", Old: testDirectory1Entry},
+		{Path: "file", Old: testFile1Entry, New: testFile2Entry},
+		{Path: "new", New: testFile1Entry},
+	}
+	if count := DeletionCount(transitions); count != testDirectory1Entry.Count() {
+		t.Error("unexpected deletion count:", count, "!=", testDirectory1Entry.Count())
+	}
+
+	nested := []*Change{{Old: testDirectory1Entry, New: &Entry{Kind: EntryKind_Directory}}}
+	if count := DeletionCount(nested); count != testDirectory1Entry.Count()-1 {
+		t.Error("unexpected nested deletion count:", count)
+	}
+}
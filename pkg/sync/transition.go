@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -18,12 +19,22 @@ import (
 
 const (
 	crossDeviceRenameTemporaryNamePrefix = filesystem.TemporaryNamePrefix + "cross-device-rename"
+
+	hardLinkTemporaryNamePrefix = filesystem.TemporaryNamePrefix + "hard-link"
+
+	trashBatchNameFormat = "20060102T150405.000000000Z"
+
+	trashPermissions os.FileMode = 0700
 )
 
 type Provider interface {
 	Provide(path string, digest []byte) (string, error)
 }
 
+type Versioner interface {
+	Record(path string, digest []byte, open func() (io.ReadCloser, error)) error
+}
+
 type transitioner struct {
 	root                           string
 	cache                          *Cache
@@ -33,6 +44,13 @@ type transitioner struct {
 	defaultOwnership               *filesystem.OwnershipSpecification
 	recomposeUnicode               bool
 	provider                       Provider
+	trash                          string
+	versioner                      Versioner
+	preserveModificationTimes      bool
+	permissionsMode                PermissionsMode
+	ownerships                     map[string]*filesystem.OwnershipSpecification
+	extendedAttributes             []string
+	hardLinks                      map[string]hardLinkMember
 	problems                       []*Problem
 }
 
@@ -189,11 +207,88 @@ func (t *transitioner) ensureNotExists(parent *filesystem.Directory, name string
 	return errors.New("path exists")
 }
 
+func (t *transitioner) trashPathFor(name, path string) (string, error) {
+	if path == "" {
+		path = name
+	}
+
+	result := filepath.Join(t.trash, filepath.FromSlash(path))
+
+	if err := os.MkdirAll(filepath.Dir(result), trashPermissions); err != nil {
+		return "", errors.Wrap(err, "unable to create trash directory")
+	}
+
+	return result, nil
+}
+
+func (t *transitioner) copyFileToTrash(parent *filesystem.Directory, name, path string) error {
+
+	trashPath, err := t.trashPathFor(name, path)
+	if err != nil {
+		return err
+	}
+
+	source, err := parent.OpenFile(name)
+	if err != nil {
+		return errors.Wrap(err, "unable to open file")
+	}
+	defer source.Close()
+
+	destination, err := os.OpenFile(trashPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "unable to create trash file")
+	}
+
+	_, copyErr := io.Copy(destination, source)
+	destination.Close()
+	if copyErr != nil {
+		os.Remove(trashPath)
+		return errors.Wrap(copyErr, "unable to copy file contents to trash")
+	}
+
+	return nil
+}
+
+func (t *transitioner) moveToTrash(parent *filesystem.Directory, name, path string, kind EntryKind) error {
+
+	trashPath, err := t.trashPathFor(name, path)
+	if err != nil {
+		return err
+	}
+
+	renameErr := filesystem.Rename(parent, name, nil, trashPath)
+	if renameErr == nil {
+		return nil
+	} else if !filesystem.IsCrossDeviceError(renameErr) {
+		return errors.Wrap(renameErr, "unable to move content to trash")
+	}
+
+	if kind == EntryKind_Symlink {
+		target, err := parent.ReadSymbolicLink(name)
+		if err != nil {
+			return errors.Wrap(err, "unable to read symbolic link target")
+		} else if err = os.Symlink(target, trashPath); err != nil {
+			return errors.Wrap(err, "unable to create symbolic link in trash")
+		}
+		return parent.RemoveSymbolicLink(name)
+	}
+
+	if err := t.copyFileToTrash(parent, name, path); err != nil {
+		return err
+	}
+
+	return parent.RemoveFile(name)
+}
+
 func (t *transitioner) removeFile(parent *filesystem.Directory, name, path string, expected *Entry) error {
 	if err := t.ensureExpectedFile(parent, name, path, expected); err != nil {
 		return errors.Wrap(err, "unable to validate existing file")
 	}
 
+	if t.trash != "" {
+		return t.moveToTrash(parent, name, path, EntryKind_File)
+	}
+
 	return parent.RemoveFile(name)
 }
 
@@ -207,6 +302,10 @@ func (t *transitioner) removeSymbolicLink(parent *filesystem.Directory, name, pa
 		return errors.Wrap(err, "unable to validate existing symbolic link")
 	}
 
+	if t.trash != "" {
+		return t.moveToTrash(parent, name, path, EntryKind_Symlink)
+	}
+
 	return parent.RemoveSymbolicLink(name)
 }
 
@@ -313,6 +412,168 @@ func (t *transitioner) remove(path string, entry *Entry) *Entry {
 	return nil
 }
 
+func (t *transitioner) modificationTime(target *Entry) (time.Time, bool, error) {
+	if !t.preserveModificationTimes || target.ModificationTime == nil {
+		return time.Time{}, false, nil
+	}
+
+	modificationTime, err := ptypes.Timestamp(target.ModificationTime)
+	if err != nil {
+		return time.Time{}, false, errors.Wrap(err, "unable to convert modification time format")
+	}
+
+	return modificationTime, true, nil
+}
+
+func (t *transitioner) ownership(target *Entry) *filesystem.OwnershipSpecification {
+	if !t.permissionsMode.PreservesOwnership() || (target.Owner == "" && target.Group == "") {
+		return t.defaultOwnership
+	}
+
+	key := target.Owner + ":" + target.Group
+	if ownership, ok := t.ownerships[key]; ok {
+		return ownership
+	}
+
+	ownership, err := filesystem.NewOwnershipSpecification(target.Owner, target.Group)
+	if err != nil {
+		ownership = t.defaultOwnership
+	}
+	t.ownerships[key] = ownership
+
+	return ownership
+}
+
+func (t *transitioner) fileMode(target *Entry) filesystem.Mode {
+	if t.permissionsMode.Preserves() && target.PermissionsRecorded {
+		return filesystem.Mode(target.Permissions)
+	}
+
+	mode := t.defaultFilePermissionMode
+	if target.Executable {
+		mode = markExecutableForReaders(mode)
+	}
+
+	return mode
+}
+
+func (t *transitioner) directoryMode(target *Entry) filesystem.Mode {
+	if t.permissionsMode.Preserves() && target.PermissionsRecorded {
+		return filesystem.Mode(target.Permissions)
+	}
+
+	return t.defaultDirectoryPermissionMode
+}
+
+func (t *transitioner) applyExtendedAttributes(parent *filesystem.Directory, name, path string, target *Entry) *Entry {
+	if len(t.extendedAttributes) == 0 || len(target.ExtendedAttributesDigest) == 0 {
+		return target
+	}
+
+	existing, err := parent.ReadContentExtendedAttributes(name, t.extendedAttributes)
+	if err == filesystem.ErrExtendedAttributesUnsupported {
+		return target
+	} else if err != nil {
+		t.recordProblem(path, errors.Wrap(err, "unable to read existing extended attributes"))
+		return target
+	}
+
+	failed := false
+	for attribute := range existing {
+		if _, ok := target.ExtendedAttributes[attribute]; ok {
+			continue
+		}
+		if err := parent.RemoveContentExtendedAttribute(name, attribute); err != nil {
+			t.recordProblem(path, errors.Wrapf(err, "unable to remove extended attribute (%s)", attribute))
+			failed = true
+		}
+	}
+	for attribute, value := range target.ExtendedAttributes {
+		if current, ok := existing[attribute]; ok && bytes.Equal(current, value) {
+			continue
+		}
+		if err := parent.SetContentExtendedAttribute(name, attribute, value); err != nil {
+			t.recordProblem(path, errors.Wrapf(err, "unable to set extended attribute (%s)", attribute))
+			failed = true
+		}
+	}
+
+	if !failed {
+		return target
+	}
+
+	applied, err := parent.ReadContentExtendedAttributes(name, t.extendedAttributes)
+	if err != nil {
+		return target
+	}
+
+	result := target.copySlim()
+	result.ExtendedAttributes = nil
+	if len(applied) > 0 {
+		result.ExtendedAttributes = applied
+	}
+	result.ExtendedAttributesDigest = extendedAttributesDigest(applied)
+
+	return result
+}
+
+func (t *transitioner) hardLinkSource(path string, target *Entry) string {
+	group := target.HardLinkGroup
+
+	if placed, ok := t.hardLinks[group]; ok && bytes.Equal(placed.entry.Digest, target.Digest) {
+		return placed.path
+	}
+
+	if group == path || t.cache == nil {
+		return ""
+	}
+
+	cached, ok := t.cache.Entries[group]
+	if !ok || !bytes.Equal(cached.Digest, target.Digest) {
+		return ""
+	}
+
+	metadata, err := os.Lstat(filepath.Join(t.root, filepath.FromSlash(group)))
+	if err != nil || !metadata.Mode().IsRegular() {
+		return ""
+	}
+
+	modificationTimeProto, err := ptypes.TimestampProto(metadata.ModTime())
+	if err != nil {
+		return ""
+	}
+
+	unchanged := uint64(metadata.Size()) == cached.Size &&
+		modificationTimeProto.Seconds == cached.ModificationTime.Seconds &&
+		modificationTimeProto.Nanos == cached.ModificationTime.Nanos
+	if !unchanged {
+		return ""
+	}
+
+	return group
+}
+
+func (t *transitioner) linkIntoPlace(path string, target *Entry, parent *filesystem.Directory, name string) bool {
+	source := t.hardLinkSource(path, target)
+	if source == "" {
+		return false
+	}
+
+	fullPath := filepath.Join(t.root, filepath.FromSlash(path))
+	temporaryPath := filepath.Join(filepath.Dir(fullPath), hardLinkTemporaryNamePrefix+name)
+
+	if err := os.Link(filepath.Join(t.root, filepath.FromSlash(source)), temporaryPath); err != nil {
+		return false
+	}
+
+	if err := filesystem.Rename(nil, temporaryPath, parent, name); err != nil {
+		os.Remove(temporaryPath)
+		return false
+	}
+
+	return true
+}
+
 func (t *transitioner) findAndMoveStagedFileIntoPlace(
 	path string,
 	target *Entry,
@@ -320,20 +581,54 @@ func (t *transitioner) findAndMoveStagedFileIntoPlace(
 	name string,
 ) error {
 
-	mode := t.defaultFilePermissionMode
-	if target.Executable {
-		mode = markExecutableForReaders(mode)
+	if target.HardLinkGroup == "" {
+		return t.moveStagedFileIntoPlace(path, target, parent, name)
+	}
+
+	if t.linkIntoPlace(path, target, parent, name) {
+		return nil
+	}
+
+	if err := t.moveStagedFileIntoPlace(path, target, parent, name); err != nil {
+		return err
 	}
 
+	t.hardLinks[target.HardLinkGroup] = hardLinkMember{path, target}
+
+	return nil
+}
+
+func (t *transitioner) moveStagedFileIntoPlace(
+	path string,
+	target *Entry,
+	parent *filesystem.Directory,
+	name string,
+) error {
+
+	mode := t.fileMode(target)
+	ownership := t.ownership(target)
+
 	stagedPath, err := t.provider.Provide(path, target.Digest)
+	if err != nil && target.HardLinkGroup != "" && target.HardLinkGroup != path {
+		stagedPath, err = t.provider.Provide(target.HardLinkGroup, target.Digest)
+	}
 	if err != nil {
 		return errors.Wrap(err, "unable to locate staged file")
 	}
 
-	if err := filesystem.SetPermissionsByPath(stagedPath, t.defaultOwnership, mode); err != nil {
+	if err := filesystem.SetPermissionsByPath(stagedPath, ownership, mode); err != nil {
 		return errors.Wrap(err, "unable to set staged file permissions")
 	}
 
+	modificationTime, preserveModificationTime, err := t.modificationTime(target)
+	if err != nil {
+		return err
+	} else if preserveModificationTime {
+		if err := os.Chtimes(stagedPath, modificationTime, modificationTime); err != nil {
+			return errors.Wrap(err, "unable to set staged file modification time")
+		}
+	}
+
 	renameErr := filesystem.Rename(nil, stagedPath, parent, name)
 	if renameErr == nil {
 		return nil
@@ -364,11 +659,18 @@ func (t *transitioner) findAndMoveStagedFileIntoPlace(
 		return errors.Wrap(copyErr, "unable to copy file contents")
 	}
 
-	if err := parent.SetPermissions(temporaryName, t.defaultOwnership, mode); err != nil {
+	if err := parent.SetPermissions(temporaryName, ownership, mode); err != nil {
 		parent.RemoveFile(temporaryName)
 		return errors.Wrap(err, "unable to set intermediate file permissions")
 	}
 
+	if preserveModificationTime {
+		if err := parent.SetModificationTime(temporaryName, modificationTime); err != nil {
+			parent.RemoveFile(temporaryName)
+			return errors.Wrap(err, "unable to set intermediate file modification time")
+		}
+	}
+
 	if err := filesystem.Rename(parent, temporaryName, parent, name); err != nil {
 		parent.RemoveFile(temporaryName)
 		return errors.Wrap(err, "unable to relocate intermediate file")
@@ -379,32 +681,59 @@ func (t *transitioner) findAndMoveStagedFileIntoPlace(
 	return nil
 }
 
-func (t *transitioner) swapFile(path string, oldEntry, newEntry *Entry) error {
+func (t *transitioner) swapFile(path string, oldEntry, newEntry *Entry) (*Entry, error) {
 
 	parent, name, err := t.walkToParentAndComputeLeafName(path, false, true)
 	if err != nil {
-		return errors.Wrap(err, "unable to walk to transition root")
+		return nil, errors.Wrap(err, "unable to walk to transition root")
 	}
 	defer parent.Close()
 
+	if err := t.replaceFile(parent, name, path, oldEntry, newEntry); err != nil {
+		return nil, err
+	}
+
+	return t.applyExtendedAttributes(parent, name, path, newEntry), nil
+}
+
+func (t *transitioner) replaceFile(parent *filesystem.Directory, name, path string, oldEntry, newEntry *Entry) error {
+
 	if err := t.ensureExpectedFile(parent, name, path, oldEntry); err != nil {
 		return errors.Wrap(err, "unable to validate existing file")
 	}
 
 	if bytes.Equal(oldEntry.Digest, newEntry.Digest) {
 
-		mode := t.defaultFilePermissionMode
-		if newEntry.Executable {
-			mode = markExecutableForReaders(mode)
+		if err := parent.SetPermissions(name, t.ownership(newEntry), t.fileMode(newEntry)); err != nil {
+			return errors.Wrap(err, "unable to change file permissions")
 		}
 
-		if err := parent.SetPermissions(name, t.defaultOwnership, mode); err != nil {
-			return errors.Wrap(err, "unable to change file permissions")
+		if modificationTime, preserve, err := t.modificationTime(newEntry); err != nil {
+			return err
+		} else if preserve {
+			if err := parent.SetModificationTime(name, modificationTime); err != nil {
+				return errors.Wrap(err, "unable to change file modification time")
+			}
 		}
 
 		return nil
 	}
 
+	if t.versioner != nil {
+		open := func() (io.ReadCloser, error) {
+			return parent.OpenFile(name)
+		}
+		if err := t.versioner.Record(path, oldEntry.Digest, open); err != nil {
+			return errors.Wrap(err, "unable to record existing file version")
+		}
+	}
+
+	if t.trash != "" {
+		if err := t.copyFileToTrash(parent, name, path); err != nil {
+			return errors.Wrap(err, "unable to preserve existing file in trash")
+		}
+	}
+
 	return t.findAndMoveStagedFileIntoPlace(path, newEntry, parent, name)
 }
 
@@ -434,6 +763,30 @@ func (t *transitioner) createSymbolicLink(parent *filesystem.Directory, name, pa
 	return parent.CreateSymbolicLink(name, target.Target)
 }
 
+func (t *transitioner) updateDirectory(path string, target *Entry) (*Entry, error) {
+
+	parent, name, err := t.walkToParentAndComputeLeafName(path, false, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to walk to transition root")
+	}
+	defer parent.Close()
+
+	metadata, err := parent.ReadContentMetadata(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to grab directory statistics")
+	} else if metadata.Mode&filesystem.ModeTypeMask != filesystem.ModeTypeDirectory {
+		return nil, errors.New("modification detected")
+	}
+
+	if target.PermissionsRecorded {
+		if err := parent.SetPermissions(name, t.ownership(target), t.directoryMode(target)); err != nil {
+			return nil, errors.Wrap(err, "unable to change directory permissions")
+		}
+	}
+
+	return t.applyExtendedAttributes(parent, name, path, target), nil
+}
+
 func (t *transitioner) createDirectory(parent *filesystem.Directory, name, path string, target *Entry) *Entry {
 
 	if err := t.ensureNotExists(parent, name); err != nil {
@@ -448,11 +801,13 @@ func (t *transitioner) createDirectory(parent *filesystem.Directory, name, path
 
 	created := target.copySlim()
 
-	if err := parent.SetPermissions(name, t.defaultOwnership, t.defaultDirectoryPermissionMode); err != nil {
+	if err := parent.SetPermissions(name, t.ownership(target), t.directoryMode(target)); err != nil {
 		t.recordProblem(path, errors.Wrap(err, "unable to set directory permissions"))
 		return created
 	}
 
+	created = t.applyExtendedAttributes(parent, name, path, target).copySlim()
+
 	var directory *filesystem.Directory
 	if len(target.Contents) > 0 {
 
@@ -479,7 +834,7 @@ func (t *transitioner) createDirectory(parent *filesystem.Directory, name, path
 			if err := t.createFile(directory, name, contentPath, entry); err != nil {
 				t.recordProblem(contentPath, errors.Wrap(err, "unable to create file"))
 			} else {
-				created.Contents[name] = entry
+				created.Contents[name] = t.applyExtendedAttributes(directory, name, contentPath, entry)
 			}
 		} else if entry.Kind == EntryKind_Symlink {
 			if err := t.createSymbolicLink(directory, name, contentPath, entry); err != nil {
@@ -515,7 +870,7 @@ func (t *transitioner) create(path string, target *Entry) *Entry {
 			t.recordProblem(path, errors.Wrap(err, "unable to create file"))
 			return nil
 		} else {
-			return target
+			return t.applyExtendedAttributes(parent, name, path, target)
 		}
 	} else if target.Kind == EntryKind_Symlink {
 		if err := t.createSymbolicLink(parent, name, path, target); err != nil {
@@ -530,26 +885,47 @@ func (t *transitioner) create(path string, target *Entry) *Entry {
 	}
 }
 
+type TransitionOptions struct {
+	SymlinkMode                    SymlinkMode
+	DefaultFilePermissionMode      filesystem.Mode
+	DefaultDirectoryPermissionMode filesystem.Mode
+	DefaultOwnership               *filesystem.OwnershipSpecification
+	RecomposeUnicode               bool
+	Trash                          string
+	Versioner                      Versioner
+	PreserveModificationTimes      bool
+	PermissionsMode                PermissionsMode
+	ExtendedAttributes             []string
+}
+
 func Transition(
 	root string,
 	transitions []*Change,
 	cache *Cache,
-	symlinkMode SymlinkMode,
-	defaultFilePermissionMode filesystem.Mode,
-	defaultDirectoryPermissionMode filesystem.Mode,
-	defaultOwnership *filesystem.OwnershipSpecification,
-	recomposeUnicode bool,
 	provider Provider,
+	options TransitionOptions,
 ) ([]*Entry, []*Problem) {
+	trash := options.Trash
+	if trash != "" {
+		trash = filepath.Join(trash, time.Now().UTC().Format(trashBatchNameFormat))
+	}
+
 	transitioner := &transitioner{
 		root:                           root,
 		cache:                          cache,
-		symlinkMode:                    symlinkMode,
-		defaultFilePermissionMode:      defaultFilePermissionMode,
-		defaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
-		defaultOwnership:               defaultOwnership,
-		recomposeUnicode:               recomposeUnicode,
+		symlinkMode:                    options.SymlinkMode,
+		defaultFilePermissionMode:      options.DefaultFilePermissionMode,
+		defaultDirectoryPermissionMode: options.DefaultDirectoryPermissionMode,
+		defaultOwnership:               options.DefaultOwnership,
+		recomposeUnicode:               options.RecomposeUnicode,
 		provider:                       provider,
+		trash:                          trash,
+		versioner:                      options.Versioner,
+		preserveModificationTimes:      options.PreserveModificationTimes,
+		permissionsMode:                options.PermissionsMode,
+		ownerships:                     make(map[string]*filesystem.OwnershipSpecification),
+		extendedAttributes:             options.ExtendedAttributes,
+		hardLinks:                      make(map[string]hardLinkMember),
 	}
 
 	var results []*Entry
@@ -560,11 +936,21 @@ func Transition(
 			t.Old.Kind == EntryKind_File &&
 			t.New.Kind == EntryKind_File
 		if fileToFile {
-			if err := transitioner.swapFile(t.Path, t.Old, t.New); err != nil {
+			if swapped, err := transitioner.swapFile(t.Path, t.Old, t.New); err != nil {
 				results = append(results, t.Old)
 				transitioner.recordProblem(t.Path, errors.Wrap(err, "unable to swap file"))
 			} else {
-				results = append(results, t.New)
+				results = append(results, swapped)
+			}
+			continue
+		}
+
+		if t.metadataOnly() {
+			if updated, err := transitioner.updateDirectory(t.Path, t.New); err != nil {
+				results = append(results, t.Old)
+				transitioner.recordProblem(t.Path, errors.Wrap(err, "unable to update directory"))
+			} else {
+				results = append(results, updated)
 			}
 			continue
 		}
@@ -4,14 +4,19 @@ import (
 	"bytes"
 	"fmt"
 	"hash"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
 	"github.com/RokyErickson/doppelganger/pkg/filesystem"
 )
 
@@ -150,12 +155,13 @@ func testTransitionCreate(temporaryDirectory string, entry *Entry, contentMap ma
 		root,
 		transitions,
 		nil,
-		SymlinkMode_SymlinkPOSIXRaw,
-		defaultFilePermissionMode,
-		defaultDirectoryPermissionMode,
-		nil,
-		recomposeUnicode,
 		provider,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPOSIXRaw,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+			RecomposeUnicode:               recomposeUnicode,
+		},
 	); len(problems) != 0 {
 		os.RemoveAll(parent)
 		return "", "", errors.New("problems occurred during creation transition")
@@ -196,12 +202,13 @@ func testTransitionRemove(root string, expected *Entry, cache *Cache, symlinkMod
 		root,
 		transitions,
 		cache,
-		symlinkMode,
-		defaultFilePermissionMode,
-		defaultDirectoryPermissionMode,
-		nil,
-		recomposeUnicode,
 		nil,
+		TransitionOptions{
+			SymlinkMode:                    symlinkMode,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+			RecomposeUnicode:               recomposeUnicode,
+		},
 	); len(problems) != 0 {
 		return errors.New("problems occurred during removal transition")
 	} else if len(entries) != len(transitions) {
@@ -238,21 +245,23 @@ func testTransitionCycle(temporaryDirectory string, entry *Entry, contentMap map
 		}
 	}
 
-	snapshot, preservesExecutability, _, cache, ignoreCache, err := Scan(root, newTestHasher(), nil, nil, nil, SymlinkMode_SymlinkPortable)
-	if !preservesExecutability {
-		snapshot = PropagateExecutability(nil, expected, snapshot)
-	}
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
 	if err != nil {
 		return errors.Wrap(err, "unable to perform scan")
-	} else if cache == nil {
+	} else if result.Cache == nil {
 		return errors.New("nil cache returned")
-	} else if ignoreCache == nil {
+	} else if result.IgnoreCache == nil {
 		return errors.New("nil ignore cache returned")
-	} else if modifier == nil && !snapshot.Equal(expected) {
+	}
+	snapshot := result.Root
+	if !result.PreservesExecutability {
+		snapshot = PropagateExecutability(nil, expected, snapshot)
+	}
+	if modifier == nil && !snapshot.Equal(expected) {
 		return errors.New("snapshot not equal to expected")
 	}
 
-	if err := testTransitionRemove(root, expected, cache, SymlinkMode_SymlinkPortable, decompose); err != nil {
+	if err := testTransitionRemove(root, expected, result.Cache, SymlinkMode_SymlinkPortable, decompose); err != nil {
 		return errors.Wrap(err, "unable to remove test content")
 	}
 
@@ -326,14 +335,15 @@ func TestTransitionSwapFile(t *testing.T) {
 
 	modifier := func(root string, expected *Entry) (*Entry, error) {
 
-		_, _, recomposeUnicode, cache, ignoreCache, err := Scan(root, newTestHasher(), nil, nil, nil, SymlinkMode_SymlinkPortable)
+		result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to perform scan")
-		} else if cache == nil {
+		} else if result.Cache == nil {
 			return nil, errors.New("nil cache returned")
-		} else if ignoreCache == nil {
+		} else if result.IgnoreCache == nil {
 			return nil, errors.New("nil ignore cache returned")
 		}
+		cache, recomposeUnicode := result.Cache, result.RecomposeUnicode
 
 		transitions := []*Change{{
 			Path: "file",
@@ -355,12 +365,13 @@ func TestTransitionSwapFile(t *testing.T) {
 			root,
 			transitions,
 			cache,
-			SymlinkMode_SymlinkPortable,
-			defaultFilePermissionMode,
-			defaultDirectoryPermissionMode,
-			nil,
-			recomposeUnicode,
 			provider,
+			TransitionOptions{
+				SymlinkMode:                    SymlinkMode_SymlinkPortable,
+				DefaultFilePermissionMode:      defaultFilePermissionMode,
+				DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+				RecomposeUnicode:               recomposeUnicode,
+			},
 		); len(problems) != 0 {
 			return nil, errors.New("file swap transition failed")
 		} else if len(entries) != 1 {
@@ -381,14 +392,15 @@ func TestTransitionSwapFile(t *testing.T) {
 
 func TestTransitionSwapFileOnlyExecutableChange(t *testing.T) {
 	modifier := func(root string, expected *Entry) (*Entry, error) {
-		_, _, recomposeUnicode, cache, ignoreCache, err := Scan(root, newTestHasher(), nil, nil, nil, SymlinkMode_SymlinkPortable)
+		result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to perform scan")
-		} else if cache == nil {
+		} else if result.Cache == nil {
 			return nil, errors.New("nil cache returned")
-		} else if ignoreCache == nil {
+		} else if result.IgnoreCache == nil {
 			return nil, errors.New("nil ignore cache returned")
 		}
+		cache, recomposeUnicode := result.Cache, result.RecomposeUnicode
 
 		executableEntry := testFile1Entry.Copy()
 		executableEntry.Executable = true
@@ -403,12 +415,13 @@ func TestTransitionSwapFileOnlyExecutableChange(t *testing.T) {
 			root,
 			transitions,
 			cache,
-			SymlinkMode_SymlinkPortable,
-			defaultFilePermissionMode,
-			defaultDirectoryPermissionMode,
-			nil,
-			recomposeUnicode,
 			nil,
+			TransitionOptions{
+				SymlinkMode:                    SymlinkMode_SymlinkPortable,
+				DefaultFilePermissionMode:      defaultFilePermissionMode,
+				DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+				RecomposeUnicode:               recomposeUnicode,
+			},
 		); len(problems) != 0 {
 			return nil, errors.New("file swap transition failed")
 		} else if len(entries) != 1 {
@@ -468,14 +481,15 @@ func TestTransitionFailCreateInvalidPathCase(t *testing.T) {
 
 	modifier := func(root string, expected *Entry) (*Entry, error) {
 
-		_, _, recomposeUnicode, cache, ignoreCache, err := Scan(root, newTestHasher(), nil, nil, nil, SymlinkMode_SymlinkPortable)
+		result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to perform scan")
-		} else if cache == nil {
+		} else if result.Cache == nil {
 			return nil, errors.New("nil cache returned")
-		} else if ignoreCache == nil {
+		} else if result.IgnoreCache == nil {
 			return nil, errors.New("nil ignore cache returned")
 		}
+		cache, recomposeUnicode := result.Cache, result.RecomposeUnicode
 
 		if err := os.Rename(filepath.Join(root, "directory"), filepath.Join(root, "directory-temp")); err != nil {
 			return nil, errors.Wrap(err, "unable to rename directory to temporary name")
@@ -500,12 +514,13 @@ func TestTransitionFailCreateInvalidPathCase(t *testing.T) {
 			root,
 			transitions,
 			cache,
-			SymlinkMode_SymlinkPortable,
-			defaultFilePermissionMode,
-			defaultDirectoryPermissionMode,
-			nil,
-			recomposeUnicode,
 			provider,
+			TransitionOptions{
+				SymlinkMode:                    SymlinkMode_SymlinkPortable,
+				DefaultFilePermissionMode:      defaultFilePermissionMode,
+				DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+				RecomposeUnicode:               recomposeUnicode,
+			},
 		); len(problems) == 0 {
 			return nil, errors.New("transition succeeded unexpectedly")
 		} else if len(entries) != 1 {
@@ -586,12 +601,12 @@ func TestTransitionFailOnParentPathIsFile(t *testing.T) {
 		root,
 		transitions,
 		nil,
-		SymlinkMode_SymlinkPortable,
-		defaultFilePermissionMode,
-		defaultDirectoryPermissionMode,
-		nil,
-		false,
 		provider,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPortable,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+		},
 	); len(problems) != 1 {
 		t.Error("transition succeeded unexpectedly")
 	} else if len(entries) != 1 {
@@ -600,3 +615,465 @@ func TestTransitionFailOnParentPathIsFile(t *testing.T) {
 		t.Error("failed creation transition returned non-nil entry")
 	}
 }
+
+func TestTransitionRemoveToTrash(t *testing.T) {
+
+	temporaryDirectory, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(temporaryDirectory)
+
+	root, parent, err := testTransitionCreate(temporaryDirectory, testDirectory1Entry, testDirectory1ContentMap, false)
+	if err != nil {
+		t.Fatal("unable to create test content:", err)
+	}
+	defer os.RemoveAll(parent)
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	}
+	cache, recomposeUnicode := result.Cache, result.RecomposeUnicode
+
+	trash := filepath.Join(temporaryDirectory, "trash")
+
+	transitions := []*Change{{Path: "file", Old: testFile1Entry}}
+	if entries, problems := Transition(
+		root,
+		transitions,
+		cache,
+		nil,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPortable,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+			RecomposeUnicode:               recomposeUnicode,
+			Trash:                          trash,
+		},
+	); len(problems) != 0 {
+		t.Fatal("removal transition failed:", problems[0].Error)
+	} else if len(entries) != 1 || entries[0] != nil {
+		t.Fatal("removal transition returned unexpected entries")
+	}
+
+	if _, err := os.Lstat(filepath.Join(root, "file")); !os.IsNotExist(err) {
+		t.Error("removed file still exists in root")
+	}
+
+	batches, err := ioutil.ReadDir(trash)
+	if err != nil {
+		t.Fatal("unable to read trash directory:", err)
+	} else if len(batches) != 1 {
+		t.Fatal("unexpected number of trash batches:", len(batches))
+	}
+
+	if contents, err := ioutil.ReadFile(filepath.Join(trash, batches[0].Name(), "file")); err != nil {
+		t.Error("unable to read trashed file:", err)
+	} else if !bytes.Equal(contents, testFile1Contents) {
+		t.Error("trashed file contents do not match expected")
+	}
+}
+
+type testVersioner struct {
+	paths    []string
+	contents [][]byte
+}
+
+func (v *testVersioner) Record(path string, digest []byte, open func() (io.ReadCloser, error)) error {
+	file, err := open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	contents, err := ioutil.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	v.paths = append(v.paths, path)
+	v.contents = append(v.contents, contents)
+
+	return nil
+}
+
+func TestTransitionSwapFileRecordsVersion(t *testing.T) {
+
+	temporaryDirectory, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary directory:", err)
+	}
+	defer os.RemoveAll(temporaryDirectory)
+
+	root, parent, err := testTransitionCreate(temporaryDirectory, testDirectory1Entry, testDirectory1ContentMap, false)
+	if err != nil {
+		t.Fatal("unable to create test content:", err)
+	}
+	defer os.RemoveAll(parent)
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable})
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	}
+	cache, recomposeUnicode := result.Cache, result.RecomposeUnicode
+
+	provider, err := newTestProvider(map[string][]byte{"file": testFile2Contents}, newTestHasher())
+	if err != nil {
+		t.Fatal("unable to create test provider:", err)
+	}
+	defer provider.finalize()
+
+	versioner := &testVersioner{}
+
+	transitions := []*Change{{Path: "file", Old: testFile1Entry, New: testFile2Entry}}
+	if _, problems := Transition(
+		root,
+		transitions,
+		cache,
+		provider,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPortable,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+			RecomposeUnicode:               recomposeUnicode,
+			Versioner:                      versioner,
+		},
+	); len(problems) != 0 {
+		t.Fatal("swap transition failed:", problems[0].Error)
+	}
+
+	if len(versioner.paths) != 1 {
+		t.Fatal("unexpected number of recorded versions:", len(versioner.paths))
+	} else if versioner.paths[0] != "file" {
+		t.Error("recorded version has incorrect path:", versioner.paths[0])
+	} else if !bytes.Equal(versioner.contents[0], testFile1Contents) {
+		t.Error("recorded version has incorrect contents")
+	}
+}
+
+func TestTransitionPreservesModificationTime(t *testing.T) {
+
+	parent, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary root parent:", err)
+	}
+	defer os.RemoveAll(parent)
+
+	root := filepath.Join(parent, "root")
+
+	provider, err := newTestProvider(testFile1ContentMap, newTestHasher())
+	if err != nil {
+		t.Fatal("unable to create test provider:", err)
+	}
+	defer provider.finalize()
+
+	modificationTime := time.Date(2001, time.February, 3, 4, 5, 6, 0, time.UTC)
+	modificationTimeProto, err := ptypes.TimestampProto(modificationTime)
+	if err != nil {
+		t.Fatal("unable to convert modification time:", err)
+	}
+	entry := testFile1Entry.Copy()
+	entry.ModificationTime = modificationTimeProto
+
+	if _, problems := Transition(
+		root,
+		[]*Change{{New: entry}},
+		nil,
+		provider,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPortable,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+			PreserveModificationTimes:      true,
+		},
+	); len(problems) != 0 {
+		t.Fatal("creation transition failed:", problems[0].Error)
+	}
+
+	if info, err := os.Stat(root); err != nil {
+		t.Fatal("unable to query created file:", err)
+	} else if !info.ModTime().Equal(modificationTime) {
+		t.Error("modification time not preserved:", info.ModTime(), "!=", modificationTime)
+	}
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable, RecordModificationTimes: true})
+	if err != nil {
+		t.Fatal("unable to scan created file:", err)
+	} else if !proto.Equal(result.Root.ModificationTime, modificationTimeProto) {
+		t.Error("scanned modification time does not match preserved value")
+	}
+}
+
+func TestTransitionPreservesPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip()
+	}
+
+	parent, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary root parent:", err)
+	}
+	defer os.RemoveAll(parent)
+
+	root := filepath.Join(parent, "root")
+
+	provider, err := newTestProvider(testFile1ContentMap, newTestHasher())
+	if err != nil {
+		t.Fatal("unable to create test provider:", err)
+	}
+	defer provider.finalize()
+
+	entry := testFile1Entry.Copy()
+	entry.Executable = true
+	entry.Permissions = 0751
+	entry.PermissionsRecorded = true
+	entry.Owner = fmt.Sprintf("id:%d", os.Getuid())
+	entry.Group = fmt.Sprintf("id:%d", os.Getgid())
+
+	if _, problems := Transition(
+		root,
+		[]*Change{{New: entry}},
+		nil,
+		provider,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPortable,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+			PermissionsMode:                PermissionsMode_PermissionsPreserveNumeric,
+		},
+	); len(problems) != 0 {
+		t.Fatal("creation transition failed:", problems[0].Error)
+	}
+
+	if info, err := os.Stat(root); err != nil {
+		t.Fatal("unable to query created file:", err)
+	} else if mode := info.Mode().Perm(); mode != 0751 {
+		t.Errorf("permissions not preserved: %#o", mode)
+	}
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable, PermissionsMode: PermissionsMode_PermissionsPreserveNumeric})
+	if err != nil {
+		t.Fatal("unable to scan created file:", err)
+	} else if !result.Root.Equal(entry) || result.Root.Owner != entry.Owner || result.Root.Group != entry.Group {
+		t.Error("scanned entry does not match preserved entry")
+	}
+}
+
+func TestTransitionUpdatesDirectoryPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip()
+	}
+
+	root, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary root:", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := os.Mkdir(filepath.Join(root, "directory"), 0755); err != nil {
+		t.Fatal("unable to create directory:", err)
+	} else if err := ioutil.WriteFile(filepath.Join(root, "directory", "file"), []byte("file"), 0600); err != nil {
+		t.Fatal("unable to create file:", err)
+	}
+
+	scanOptions := ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable, PermissionsMode: PermissionsMode_PermissionsPreserve}
+	result, err := Scan(root, newTestHasher(), nil, nil, scanOptions)
+	if err != nil {
+		t.Fatal("unable to perform scan:", err)
+	}
+
+	old := result.Root.Contents["directory"].copySlim()
+	updated := old.copySlim()
+	updated.Permissions = 0700
+
+	results, problems := Transition(
+		root,
+		[]*Change{{Path: "directory", Old: old, New: updated}},
+		result.Cache,
+		nil,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPortable,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+			PermissionsMode:                PermissionsMode_PermissionsPreserve,
+		},
+	)
+	if len(problems) != 0 {
+		t.Fatal("directory update failed:", problems[0].Error)
+	} else if len(results) != 1 || results[0].Permissions != 0700 {
+		t.Error("unexpected directory update result")
+	}
+
+	if info, err := os.Stat(filepath.Join(root, "directory")); err != nil {
+		t.Fatal("unable to query directory:", err)
+	} else if mode := info.Mode().Perm(); mode != 0700 {
+		t.Errorf("directory permissions not updated: %#o", mode)
+	}
+
+	if _, err := os.Stat(filepath.Join(root, "directory", "file")); err != nil {
+		t.Error("directory contents not retained:", err)
+	}
+}
+
+func createExtendedAttributesTestParent(t *testing.T) string {
+	parent, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary root parent:", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(parent, "probe"), nil, 0600); err != nil {
+		os.RemoveAll(parent)
+		t.Fatal("unable to create probe file:", err)
+	}
+
+	directory, _, err := filesystem.OpenDirectory(parent, false)
+	if err != nil {
+		os.RemoveAll(parent)
+		t.Fatal("unable to open temporary root parent:", err)
+	}
+	defer directory.Close()
+
+	if err := directory.SetContentExtendedAttribute("probe", "user.probe", []byte("probe")); err != nil {
+		os.RemoveAll(parent)
+		t.Skip("extended attributes not supported by temporary directory")
+	}
+
+	return parent
+}
+
+func TestTransitionExtendedAttributes(t *testing.T) {
+	parent := createExtendedAttributesTestParent(t)
+	defer os.RemoveAll(parent)
+
+	root := filepath.Join(parent, "root")
+
+	provider, err := newTestProvider(testFile1ContentMap, newTestHasher())
+	if err != nil {
+		t.Fatal("unable to create test provider:", err)
+	}
+	defer provider.finalize()
+
+	namespaces := []string{"user"}
+	entry := testFile1Entry.Copy()
+	entry.ExtendedAttributes = map[string][]byte{"user.comment": []byte("synchronized")}
+	entry.ExtendedAttributesDigest = extendedAttributesDigest(entry.ExtendedAttributes)
+
+	if _, problems := Transition(
+		root,
+		[]*Change{{New: entry}},
+		nil,
+		provider,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPortable,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+			ExtendedAttributes:             namespaces,
+		},
+	); len(problems) != 0 {
+		t.Fatal("creation transition failed:", problems[0].Error)
+	}
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable, ExtendedAttributes: namespaces})
+	if err != nil {
+		t.Fatal("unable to scan created file:", err)
+	} else if !bytes.Equal(result.Root.ExtendedAttributesDigest, entry.ExtendedAttributesDigest) {
+		t.Error("scanned extended attributes do not match transitioned attributes")
+	} else if !bytes.Equal(result.Root.ExtendedAttributes["user.comment"], []byte("synchronized")) {
+		t.Error("extended attribute value not restored")
+	}
+}
+
+func TestTransitionDirectoryExtendedAttributes(t *testing.T) {
+	parent := createExtendedAttributesTestParent(t)
+	defer os.RemoveAll(parent)
+
+	root := filepath.Join(parent, "root")
+
+	namespaces := []string{"user"}
+	entry := &Entry{
+		Kind:               EntryKind_Directory,
+		ExtendedAttributes: map[string][]byte{"user.comment": []byte("created")},
+	}
+	entry.ExtendedAttributesDigest = extendedAttributesDigest(entry.ExtendedAttributes)
+
+	options := TransitionOptions{
+		SymlinkMode:                    SymlinkMode_SymlinkPortable,
+		DefaultFilePermissionMode:      defaultFilePermissionMode,
+		DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+		ExtendedAttributes:             namespaces,
+	}
+
+	if results, problems := Transition(root, []*Change{{New: entry}}, nil, nil, options); len(problems) != 0 {
+		t.Fatal("creation transition failed:", problems[0].Error)
+	} else if len(results) != 1 || !bytes.Equal(results[0].ExtendedAttributesDigest, entry.ExtendedAttributesDigest) {
+		t.Fatal("created directory result does not reflect applied extended attributes")
+	}
+
+	updated := entry.Copy()
+	updated.ExtendedAttributes = map[string][]byte{"user.comment": []byte("updated")}
+	updated.ExtendedAttributesDigest = extendedAttributesDigest(updated.ExtendedAttributes)
+
+	if results, problems := Transition(root, []*Change{{Old: entry, New: updated}}, nil, nil, options); len(problems) != 0 {
+		t.Fatal("update transition failed:", problems[0].Error)
+	} else if len(results) != 1 || !bytes.Equal(results[0].ExtendedAttributesDigest, updated.ExtendedAttributesDigest) {
+		t.Fatal("updated directory result does not reflect applied extended attributes")
+	}
+
+	result, err := Scan(root, newTestHasher(), nil, nil, ScanOptions{SymlinkMode: SymlinkMode_SymlinkPortable, ExtendedAttributes: namespaces})
+	if err != nil {
+		t.Fatal("unable to scan directory:", err)
+	} else if !bytes.Equal(result.Root.ExtendedAttributes["user.comment"], []byte("updated")) {
+		t.Error("directory extended attribute not updated")
+	}
+}
+
+func TestTransitionCreatesHardLinks(t *testing.T) {
+
+	parent, err := ioutil.TempDir("", "doppelganger_simulated")
+	if err != nil {
+		t.Fatal("unable to create temporary root parent:", err)
+	}
+	defer os.RemoveAll(parent)
+
+	root := filepath.Join(parent, "root")
+
+	provider, err := newTestProvider(map[string][]byte{"first": testFile1Contents}, newTestHasher())
+	if err != nil {
+		t.Fatal("unable to create test provider:", err)
+	}
+	defer provider.finalize()
+
+	first := testFile1Entry.Copy()
+	first.HardLinkGroup = "first"
+	second := testFile1Entry.Copy()
+	second.HardLinkGroup = "first"
+	entry := &Entry{
+		Contents: map[string]*Entry{
+			"first":  first,
+			"second": second,
+		},
+	}
+
+	if _, problems := Transition(
+		root,
+		[]*Change{{New: entry}},
+		nil,
+		provider,
+		TransitionOptions{
+			SymlinkMode:                    SymlinkMode_SymlinkPortable,
+			DefaultFilePermissionMode:      defaultFilePermissionMode,
+			DefaultDirectoryPermissionMode: defaultDirectoryPermissionMode,
+		},
+	); len(problems) != 0 {
+		t.Fatal("creation transition failed:", problems[0].Error)
+	}
+
+	if firstInfo, err := os.Stat(filepath.Join(root, "first")); err != nil {
+		t.Fatal("unable to query first file:", err)
+	} else if secondInfo, err := os.Stat(filepath.Join(root, "second")); err != nil {
+		t.Fatal("unable to query second file:", err)
+	} else if !os.SameFile(firstInfo, secondInfo) {
+		t.Error("hard link not recreated")
+	}
+}
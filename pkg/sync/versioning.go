@@ -0,0 +1,114 @@
+package sync
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+const (
+	hourlyVersionRetention = 24 * time.Hour
+	dailyVersionRetention  = 30 * 24 * time.Hour
+)
+
+func (m VersioningMode) IsDefault() bool {
+	return m == VersioningMode_VersioningModeDefault
+}
+
+func (m *VersioningMode) UnmarshalText(textBytes []byte) error {
+	text := string(textBytes)
+
+	switch text {
+	case "disabled":
+		*m = VersioningMode_VersioningModeDisabled
+	case "staggered":
+		*m = VersioningMode_VersioningModeStaggered
+	default:
+		return errors.Errorf("unknown versioning mode specification: %s", text)
+	}
+
+	return nil
+}
+
+func (m VersioningMode) Supported() bool {
+	switch m {
+	case VersioningMode_VersioningModeDisabled:
+		return true
+	case VersioningMode_VersioningModeStaggered:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m VersioningMode) Description() string {
+	switch m {
+	case VersioningMode_VersioningModeDefault:
+		return "Default"
+	case VersioningMode_VersioningModeDisabled:
+		return "Disabled"
+	case VersioningMode_VersioningModeStaggered:
+		return "Staggered"
+	default:
+		return "Unknown"
+	}
+}
+
+func (h *FileVersionHistory) EnsureValid() error {
+	if h == nil {
+		return errors.New("nil file version history")
+	}
+
+	for _, v := range h.Versions {
+		if v == nil {
+			return errors.New("nil file version detected")
+		} else if v.Time == nil {
+			return errors.New("file version with nil time detected")
+		} else if len(v.Digest) == 0 {
+			return errors.New("file version with empty digest detected")
+		}
+	}
+
+	return nil
+}
+
+func (h *FileVersionHistory) Prune(now time.Time) {
+	sort.SliceStable(h.Versions, func(i, j int) bool {
+		iTime, jTime := h.Versions[i].Time, h.Versions[j].Time
+		return iTime.Seconds > jTime.Seconds ||
+			(iTime.Seconds == jTime.Seconds && iTime.Nanos > jTime.Nanos)
+	})
+
+	hours := make(map[int64]bool)
+	days := make(map[int64]bool)
+	retained := h.Versions[:0]
+	for _, v := range h.Versions {
+		versionTime, err := ptypes.Timestamp(v.Time)
+		if err != nil {
+			continue
+		}
+
+		age := now.Sub(versionTime)
+		if age <= hourlyVersionRetention {
+			hour := versionTime.Truncate(time.Hour).Unix()
+			if hours[hour] {
+				continue
+			}
+			hours[hour] = true
+		} else if age <= dailyVersionRetention {
+			day := versionTime.Truncate(24 * time.Hour).Unix()
+			if days[day] {
+				continue
+			}
+			days[day] = true
+		} else {
+			continue
+		}
+
+		retained = append(retained, v)
+	}
+	h.Versions = retained
+}
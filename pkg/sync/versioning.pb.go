@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sync/versioning.proto
+
+package sync // import "github.com/RokyErickson/doppelganger/pkg/sync"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+const _ = proto.ProtoPackageIsVersion2
+
+type VersioningMode int32
+
+const (
+	VersioningMode_VersioningModeDefault   VersioningMode = 0
+	VersioningMode_VersioningModeDisabled  VersioningMode = 1
+	VersioningMode_VersioningModeStaggered VersioningMode = 2
+)
+
+var VersioningMode_name = map[int32]string{
+	0: "VersioningModeDefault",
+	1: "VersioningModeDisabled",
+	2: "VersioningModeStaggered",
+}
+var VersioningMode_value = map[string]int32{
+	"VersioningModeDefault":   0,
+	"VersioningModeDisabled":  1,
+	"VersioningModeStaggered": 2,
+}
+
+func (x VersioningMode) String() string {
+	return proto.EnumName(VersioningMode_name, int32(x))
+}
+func (VersioningMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_versioning_542c21cd7729f3f9, []int{0}
+}
+
+type FileVersion struct {
+	Time                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Digest               []byte               `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FileVersion) Reset()         { *m = FileVersion{} }
+func (m *FileVersion) String() string { return proto.CompactTextString(m) }
+func (*FileVersion) ProtoMessage()    {}
+func (*FileVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_versioning_542c21cd7729f3f9, []int{0}
+}
+func (m *FileVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileVersion.Unmarshal(m, b)
+}
+func (m *FileVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileVersion.Marshal(b, m, deterministic)
+}
+func (dst *FileVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileVersion.Merge(dst, src)
+}
+func (m *FileVersion) XXX_Size() int {
+	return xxx_messageInfo_FileVersion.Size(m)
+}
+func (m *FileVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileVersion proto.InternalMessageInfo
+
+func (m *FileVersion) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *FileVersion) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+type FileVersionHistory struct {
+	Path                 string         `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Versions             []*FileVersion `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *FileVersionHistory) Reset()         { *m = FileVersionHistory{} }
+func (m *FileVersionHistory) String() string { return proto.CompactTextString(m) }
+func (*FileVersionHistory) ProtoMessage()    {}
+func (*FileVersionHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_versioning_542c21cd7729f3f9, []int{1}
+}
+func (m *FileVersionHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileVersionHistory.Unmarshal(m, b)
+}
+func (m *FileVersionHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileVersionHistory.Marshal(b, m, deterministic)
+}
+func (dst *FileVersionHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileVersionHistory.Merge(dst, src)
+}
+func (m *FileVersionHistory) XXX_Size() int {
+	return xxx_messageInfo_FileVersionHistory.Size(m)
+}
+func (m *FileVersionHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileVersionHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileVersionHistory proto.InternalMessageInfo
+
+func (m *FileVersionHistory) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FileVersionHistory) GetVersions() []*FileVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FileVersion)(nil), "sync.FileVersion")
+	proto.RegisterType((*FileVersionHistory)(nil), "sync.FileVersionHistory")
+	proto.RegisterEnum("sync.VersioningMode", VersioningMode_name, VersioningMode_value)
+}
+
+func init() { proto.RegisterFile("sync/versioning.proto", fileDescriptor_versioning_542c21cd7729f3f9) }
+
+var fileDescriptor_versioning_542c21cd7729f3f9 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x5f, 0x4b, 0xf3, 0x30,
+	0x14, 0xc6, 0xdf, 0xee, 0x2d, 0x43, 0x53, 0x91, 0x1a, 0xd8, 0xac, 0xf5, 0xc2, 0xb2, 0xab, 0x22,
+	0x2c, 0x81, 0xf9, 0x0d, 0x44, 0xc5, 0x1b, 0x6f, 0xaa, 0x4e, 0xf0, 0x2e, 0x6d, 0xce, 0xb2, 0xd0,
+	0x3f, 0x09, 0x49, 0x2a, 0xf4, 0xdb, 0x4b, 0xdb, 0x6d, 0x6c, 0xde, 0x25, 0xcf, 0xf9, 0xfd, 0x0e,
+	0x4f, 0x82, 0x66, 0xb6, 0x6b, 0x0a, 0xfa, 0x03, 0xc6, 0x4a, 0xd5, 0xc8, 0x46, 0x10, 0x6d, 0x94,
+	0x53, 0xd8, 0xef, 0xe3, 0xf8, 0x4e, 0x28, 0x25, 0x2a, 0xa0, 0x43, 0x96, 0xb7, 0x1b, 0xea, 0x64,
+	0x0d, 0xd6, 0xb1, 0x5a, 0x8f, 0xd8, 0xe2, 0x13, 0x05, 0x2f, 0xb2, 0x82, 0xf5, 0xa8, 0x63, 0x82,
+	0xfc, 0x9e, 0x88, 0xbc, 0xc4, 0x4b, 0x83, 0x55, 0x4c, 0x46, 0x9d, 0xec, 0x75, 0xf2, 0xb1, 0xd7,
+	0xb3, 0x81, 0xc3, 0x73, 0x34, 0xe5, 0x52, 0x80, 0x75, 0xd1, 0x24, 0xf1, 0xd2, 0x8b, 0x6c, 0x77,
+	0x5b, 0x7c, 0x21, 0x7c, 0xb4, 0xf6, 0x55, 0x5a, 0xa7, 0x4c, 0x87, 0x31, 0xf2, 0x35, 0x73, 0xdb,
+	0x61, 0xfb, 0x79, 0x36, 0x9c, 0xf1, 0x12, 0x9d, 0xed, 0xba, 0xdb, 0x68, 0x92, 0xfc, 0x4f, 0x83,
+	0xd5, 0x15, 0xe9, 0xab, 0x93, 0x23, 0x3f, 0x3b, 0x20, 0xf7, 0x1c, 0x5d, 0xae, 0x0f, 0x4f, 0x7d,
+	0x53, 0x1c, 0xf0, 0x0d, 0x9a, 0x9d, 0x26, 0x4f, 0xb0, 0x61, 0x6d, 0xe5, 0xc2, 0x7f, 0x38, 0x46,
+	0xf3, 0x3f, 0x23, 0x69, 0x59, 0x5e, 0x01, 0x0f, 0x3d, 0x7c, 0x8b, 0xae, 0x4f, 0x67, 0xef, 0x8e,
+	0x09, 0x01, 0x06, 0x78, 0x38, 0x79, 0xa4, 0xdf, 0x4b, 0x21, 0xdd, 0xb6, 0xcd, 0x49, 0xa1, 0x6a,
+	0x9a, 0xa9, 0xb2, 0x7b, 0x36, 0xb2, 0x28, 0xad, 0x6a, 0x28, 0x57, 0x5a, 0x43, 0x25, 0x58, 0x23,
+	0xc0, 0x50, 0x5d, 0x0a, 0xda, 0x97, 0xcd, 0xa7, 0xc3, 0x0f, 0x3d, 0xfc, 0x0e, 0x00, 0xbc, 0x75,
+	0x0b, 0x5d, 0x8d, 0x01, 0x00, 0x00,
+}
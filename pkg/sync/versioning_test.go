@@ -0,0 +1,137 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestVersioningModeUnmarshal(t *testing.T) {
+	testCases := []struct {
+		Text          string
+		ExpectedMode  VersioningMode
+		ExpectFailure bool
+	}{
+		{"", VersioningMode_VersioningModeDefault, true},
+		{"asdf", VersioningMode_VersioningModeDefault, true},
+		{"disabled", VersioningMode_VersioningModeDisabled, false},
+		{"staggered", VersioningMode_VersioningModeStaggered, false},
+	}
+
+	for _, testCase := range testCases {
+		var mode VersioningMode
+		if err := mode.UnmarshalText([]byte(testCase.Text)); err != nil {
+			if !testCase.ExpectFailure {
+				t.Errorf("unable to unmarshal text (%s): %s", testCase.Text, err)
+			}
+		} else if testCase.ExpectFailure {
+			t.Error("unmarshaling succeeded unexpectedly for text:", testCase.Text)
+		} else if mode != testCase.ExpectedMode {
+			t.Errorf(
+				"unmarshaled mode (%s) does not match expected (%s)",
+				mode,
+				testCase.ExpectedMode,
+			)
+		}
+	}
+}
+
+func TestVersioningModeSupported(t *testing.T) {
+	testCases := []struct {
+		Mode            VersioningMode
+		ExpectSupported bool
+	}{
+		{VersioningMode_VersioningModeDefault, false},
+		{VersioningMode_VersioningModeDisabled, true},
+		{VersioningMode_VersioningModeStaggered, true},
+		{(VersioningMode_VersioningModeStaggered + 1), false},
+	}
+
+	for _, testCase := range testCases {
+		if supported := testCase.Mode.Supported(); supported != testCase.ExpectSupported {
+			t.Errorf(
+				"mode support status (%t) does not match expected (%t)",
+				supported,
+				testCase.ExpectSupported,
+			)
+		}
+	}
+}
+
+func TestVersioningModeDescription(t *testing.T) {
+	testCases := []struct {
+		Mode                VersioningMode
+		ExpectedDescription string
+	}{
+		{VersioningMode_VersioningModeDefault, "Default"},
+		{VersioningMode_VersioningModeDisabled, "Disabled"},
+		{VersioningMode_VersioningModeStaggered, "Staggered"},
+		{(VersioningMode_VersioningModeStaggered + 1), "Unknown"},
+	}
+
+	for _, testCase := range testCases {
+		if description := testCase.Mode.Description(); description != testCase.ExpectedDescription {
+			t.Errorf(
+				"mode description (%s) does not match expected (%s)",
+				description,
+				testCase.ExpectedDescription,
+			)
+		}
+	}
+}
+
+func testFileVersion(t *testing.T, when time.Time, digest byte) *FileVersion {
+	t.Helper()
+
+	timestamp, err := ptypes.TimestampProto(when)
+	if err != nil {
+		t.Fatal("unable to convert timestamp:", err)
+	}
+
+	return &FileVersion{Time: timestamp, Digest: []byte{digest}}
+}
+
+func TestFileVersionHistoryEnsureValid(t *testing.T) {
+	var history *FileVersionHistory
+	if history.EnsureValid() == nil {
+		t.Error("nil history considered valid")
+	}
+
+	history = &FileVersionHistory{Versions: []*FileVersion{{Digest: []byte{0}}}}
+	if history.EnsureValid() == nil {
+		t.Error("history with nil version time considered valid")
+	}
+
+	history = &FileVersionHistory{Versions: []*FileVersion{testFileVersion(t, time.Now(), 0)}}
+	if err := history.EnsureValid(); err != nil {
+		t.Error("valid history considered invalid:", err)
+	}
+}
+
+func TestFileVersionHistoryPrune(t *testing.T) {
+	now := time.Date(2018, time.October, 15, 12, 30, 0, 0, time.UTC)
+
+	history := &FileVersionHistory{
+		Versions: []*FileVersion{
+			testFileVersion(t, now.Add(-40*24*time.Hour), 0),
+			testFileVersion(t, now.Add(-50*time.Hour), 1),
+			testFileVersion(t, now.Add(-49*time.Hour), 2),
+			testFileVersion(t, now.Add(-2*time.Hour), 3),
+			testFileVersion(t, now.Add(-20*time.Minute), 4),
+			testFileVersion(t, now.Add(-10*time.Minute), 5),
+		},
+	}
+
+	history.Prune(now)
+
+	expected := []byte{5, 3, 2}
+	if len(history.Versions) != len(expected) {
+		t.Fatal("unexpected number of retained versions:", len(history.Versions))
+	}
+	for v, version := range history.Versions {
+		if version.Digest[0] != expected[v] {
+			t.Errorf("retained version %d has unexpected digest: %d != %d", v, version.Digest[0], expected[v])
+		}
+	}
+}
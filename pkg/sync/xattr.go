@@ -0,0 +1,27 @@
+package sync
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"sort"
+)
+
+func extendedAttributesDigest(attributes map[string][]byte) []byte {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hasher := sha1.New()
+	var length [8]byte
+	for _, name := range names {
+		hasher.Write([]byte(name))
+		hasher.Write([]byte{0})
+		binary.BigEndian.PutUint64(length[:], uint64(len(attributes[name])))
+		hasher.Write(length[:])
+		hasher.Write(attributes[name])
+	}
+
+	return hasher.Sum(nil)
+}
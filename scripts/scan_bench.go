@@ -57,14 +57,18 @@ func main() {
 		}
 	}
 	start := time.Now()
-	snapshot, preservesExecutability, recomposeUnicode, cache, ignoreCache, err := sync.Scan(
-		path, sha1.New(), nil, ignores, nil, sync.SymlinkMode_SymlinkPortable,
+	result, err := sync.Scan(
+		path, sha1.New(), nil, nil, sync.ScanOptions{
+			Ignores:     ignores,
+			SymlinkMode: sync.SymlinkMode_SymlinkPortable,
+		},
 	)
 	if err != nil {
 		cmd.Fatal(errors.Wrap(err, "unable to create snapshot"))
-	} else if snapshot == nil {
+	} else if result.Root == nil {
 		cmd.Fatal(errors.New("target doesn't exist"))
 	}
+	cache := result.Cache
 	stop := time.Now()
 	if enableProfile {
 		if err = profiler.Finalize(); err != nil {
@@ -73,8 +77,8 @@ func main() {
 		profiler = nil
 	}
 	fmt.Println("Cold scan took", stop.Sub(start))
-	fmt.Println("Root preserves executability:", preservesExecutability)
-	fmt.Println("Root requires Unicode recomposition:", recomposeUnicode)
+	fmt.Println("Root preserves executability:", result.PreservesExecutability)
+	fmt.Println("Root requires Unicode recomposition:", result.RecomposeUnicode)
 
 	if enableProfile {
 		if profiler, err = profile.New("scan_warm"); err != nil {
@@ -82,14 +86,18 @@ func main() {
 		}
 	}
 	start = time.Now()
-	snapshot, preservesExecutability, recomposeUnicode, _, _, err = sync.Scan(
-		path, sha1.New(), cache, ignores, ignoreCache, sync.SymlinkMode_SymlinkPortable,
+	result, err = sync.Scan(
+		path, sha1.New(), cache, result.IgnoreCache, sync.ScanOptions{
+			Ignores:     ignores,
+			SymlinkMode: sync.SymlinkMode_SymlinkPortable,
+		},
 	)
 	if err != nil {
 		cmd.Fatal(errors.Wrap(err, "unable to create snapshot"))
-	} else if snapshot == nil {
+	} else if result.Root == nil {
 		cmd.Fatal(errors.New("target has been deleted since original snapshot"))
 	}
+	snapshot := result.Root
 	stop = time.Now()
 	if enableProfile {
 		if err = profiler.Finalize(); err != nil {
@@ -98,8 +106,8 @@ func main() {
 		profiler = nil
 	}
 	fmt.Println("Warm scan took", stop.Sub(start))
-	fmt.Println("Root preserves executability:", preservesExecutability)
-	fmt.Println("Root requires Unicode recomposition:", recomposeUnicode)
+	fmt.Println("Root preserves executability:", result.PreservesExecutability)
+	fmt.Println("Root requires Unicode recomposition:", result.RecomposeUnicode)
 
 	if enableProfile {
 		if profiler, err = profile.New("serialize_snapshot"); err != nil {